@@ -49,6 +49,13 @@ type GovernanceInstanceSpec struct {
 // GovernanceInstanceStatus defines the observed state of GovernanceInstance.
 type GovernanceInstanceStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The name for the GovernanceInstance.
 	DisplayName string `json:"displayName,omitempty"`
 	// The OCID of the compartment where the GovernanceInstance resides.
@@ -33,6 +33,13 @@ type KnowledgeBaseSpec struct {
 // KnowledgeBaseStatus defines the observed state of KnowledgeBase.
 type KnowledgeBaseStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The Oracle Cloud Identifier (OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm)) of the knowledge base.
 	Id string `json:"id,omitempty"`
 	// The name of the knowledge base.
@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -117,6 +118,13 @@ func (in *KnowledgeBaseSpec) DeepCopy() *KnowledgeBaseSpec {
 func (in *KnowledgeBaseStatus) DeepCopyInto(out *KnowledgeBaseStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -45,6 +45,13 @@ type AiDataPlatformSpec struct {
 // AiDataPlatformStatus defines the observed state of AiDataPlatform.
 type AiDataPlatformStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the AiDataPlatform.
 	Id string `json:"id,omitempty"`
 	// A user-friendly name. Does not have to be unique, and it's changeable.
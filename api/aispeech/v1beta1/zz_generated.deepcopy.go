@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -273,6 +274,13 @@ func (in *TranscriptionJobSpec) DeepCopy() *TranscriptionJobSpec {
 func (in *TranscriptionJobStatus) DeepCopyInto(out *TranscriptionJobStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.ModelDetails.DeepCopyInto(&out.ModelDetails)
 	in.InputLocation.DeepCopyInto(&out.InputLocation)
 	out.OutputLocation = in.OutputLocation
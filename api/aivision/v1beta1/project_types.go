@@ -36,6 +36,13 @@ type ProjectSpec struct {
 // ProjectStatus defines the observed state of Project.
 type ProjectStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// A unique identifier that is immutable after creation.
 	Id string `json:"id,omitempty"`
 	// A compartment identifier.
@@ -175,6 +175,13 @@ type AnalyticsInstanceVanityUrlDetails struct {
 // AnalyticsInstanceStatus defines the observed state of AnalyticsInstance.
 type AnalyticsInstanceStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The resource OCID.
 	Id string `json:"id,omitempty"`
 	// The name of the Analytics instance. This name must be unique in the tenancy and cannot be changed.
@@ -69,6 +69,13 @@ type PrivilegedApiControlPrivilegedOperationList struct {
 // PrivilegedApiControlStatus defines the observed state of PrivilegedApiControl.
 type PrivilegedApiControlStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the PrivilegedApiControl.
 	Id string `json:"id,omitempty"`
 	// Name of the privilegedApi control. The name must be unique.
@@ -39,6 +39,10 @@ type ApiGatewaySpec struct {
 	// CertificateId is the OCID of a certificate resource to use for HTTPS.
 	CertificateId shared.OCID `json:"certificateId,omitempty"`
 
+	// WriteConnectionSecretToRef configures the Secret that OSOK writes the gateway hostname to.
+	// +kubebuilder:validation:Optional
+	WriteConnectionSecretToRef *shared.ConnectionSecretTarget `json:"writeConnectionSecretToRef,omitempty"`
+
 	shared.TagResources `json:",inline,omitempty"`
 }
 
@@ -10,6 +10,7 @@
 package v1beta1
 
 import (
+	"github.com/oracle/oci-service-operator/pkg/shared"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -214,6 +215,11 @@ func (in *ApiGatewaySpec) DeepCopyInto(out *ApiGatewaySpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.WriteConnectionSecretToRef != nil {
+		in, out := &in.WriteConnectionSecretToRef, &out.WriteConnectionSecretToRef
+		*out = new(shared.ConnectionSecretTarget)
+		(*in).DeepCopyInto(*out)
+	}
 	in.TagResources.DeepCopyInto(&out.TagResources)
 }
 
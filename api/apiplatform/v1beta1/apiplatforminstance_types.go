@@ -50,6 +50,13 @@ type ApiPlatformInstanceUris struct {
 // ApiPlatformInstanceStatus defines the observed state of ApiPlatformInstance.
 type ApiPlatformInstanceStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the instance
 	Id string `json:"id,omitempty"`
 	// A regionally unique, non-changeable instance name provided by the user during instance creation
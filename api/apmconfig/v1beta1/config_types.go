@@ -202,7 +202,14 @@ type ConfigInUseBy struct {
 // ConfigStatus defines the observed state of Config.
 type ConfigStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
-	JsonData   string            `json:"jsonData,omitempty"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+	JsonData           string `json:"jsonData,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the configuration item. An OCID is generated
 	// when the item is created.
 	Id string `json:"id,omitempty"`
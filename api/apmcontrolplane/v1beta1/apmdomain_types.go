@@ -39,6 +39,13 @@ type ApmDomainSpec struct {
 // ApmDomainStatus defines the observed state of ApmDomain.
 type ApmDomainStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Unique identifier that is immutable on creation.
 	Id string `json:"id,omitempty"`
 	// Display name of the APM domain, which can be updated.
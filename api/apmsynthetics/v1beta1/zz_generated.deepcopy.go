@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -152,6 +153,13 @@ func (in *ScriptSpec) DeepCopy() *ScriptSpec {
 func (in *ScriptStatus) DeepCopyInto(out *ScriptStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.MonitorStatusCountMap = in.MonitorStatusCountMap
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
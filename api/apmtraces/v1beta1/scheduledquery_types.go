@@ -121,6 +121,13 @@ type ScheduledQueryProcessingConfiguration struct {
 // ScheduledQueryStatus defines the observed state of ScheduledQuery.
 type ScheduledQueryStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the scheduled query . An OCID is generated
 	// when the scheduled query is created.
 	Id string `json:"id,omitempty"`
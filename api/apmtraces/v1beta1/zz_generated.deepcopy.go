@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -181,6 +182,13 @@ func (in *ScheduledQuerySpec) DeepCopy() *ScheduledQuerySpec {
 func (in *ScheduledQueryStatus) DeepCopyInto(out *ScheduledQueryStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.ScheduledQueryProcessingConfiguration = in.ScheduledQueryProcessingConfiguration
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
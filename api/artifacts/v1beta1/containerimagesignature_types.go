@@ -53,6 +53,13 @@ type ContainerImageSignatureSpec struct {
 // ContainerImageSignatureStatus defines the observed state of ContainerImageSignature.
 type ContainerImageSignatureStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the compartment in which the container repository exists.
 	CompartmentId string `json:"compartmentId,omitempty"`
 	// The id of the user or principal that created the resource.
@@ -162,7 +162,14 @@ type AutoScalingPolicyRule struct {
 // AutoScalingPolicyStatus defines the observed state of AutoScalingPolicy.
 type AutoScalingPolicyStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
-	JsonData   string            `json:"jsonData,omitempty"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+	JsonData           string `json:"jsonData,omitempty"`
 	// The capacity requirements of the autoscaling policy.
 	Capacity AutoScalingPolicyCapacity `json:"capacity,omitempty"`
 	// The ID of the autoscaling policy that is assigned after creation.
@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -271,6 +272,13 @@ func (in *AutoScalingConfigurationSpec) DeepCopy() *AutoScalingConfigurationSpec
 func (in *AutoScalingConfigurationStatus) DeepCopyInto(out *AutoScalingConfigurationStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.Resource = in.Resource
 	if in.Policies != nil {
 		in, out := &in.Policies, &out.Policies
@@ -510,6 +518,13 @@ func (in *AutoScalingPolicySpec) DeepCopy() *AutoScalingPolicySpec {
 func (in *AutoScalingPolicyStatus) DeepCopyInto(out *AutoScalingPolicyStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.Capacity = in.Capacity
 	out.ExecutionSchedule = in.ExecutionSchedule
 	out.ResourceAction = in.ResourceAction
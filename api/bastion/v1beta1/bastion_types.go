@@ -59,6 +59,13 @@ type BastionSpec struct {
 // BastionStatus defines the observed state of Bastion.
 type BastionStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The type of bastion.
 	BastionType string `json:"bastionType,omitempty"`
 	// The unique identifier (OCID) of the bastion, which can't be changed after creation.
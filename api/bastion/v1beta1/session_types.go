@@ -65,6 +65,13 @@ type SessionKeyDetails struct {
 // SessionStatus defines the observed state of Session.
 type SessionStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The unique identifier (OCID) of the session, which can't be changed after creation.
 	Id string `json:"id,omitempty"`
 	// The unique identifier (OCID) of the bastion that is hosting this session.
@@ -191,6 +191,13 @@ type BdsInstanceCloudSqlDetails struct {
 // BdsInstanceStatus defines the observed state of BdsInstance.
 type BdsInstanceStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the Big Data Service resource.
 	Id string `json:"id,omitempty"`
 	// The OCID of the compartment.
@@ -145,6 +145,13 @@ type BlockchainPlatformHostOcpuUtilizationInfo struct {
 // BlockchainPlatformStatus defines the observed state of BlockchainPlatform.
 type BlockchainPlatformStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// unique identifier that is immutable on creation
 	Id string `json:"id,omitempty"`
 	// Platform Instance Display name, can be renamed
@@ -31,6 +31,13 @@ type OsnOcpuAllocationParam struct {
 // OsnStatus defines the observed state of Osn.
 type OsnStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// OSN identifier
 	OsnKey string `json:"osnKey,omitempty"`
 	// Availability Domain of OSN
@@ -37,6 +37,13 @@ type PeerOcpuAllocationParam struct {
 // PeerStatus defines the observed state of Peer.
 type PeerStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// peer identifier
 	PeerKey string `json:"peerKey,omitempty"`
 	// Peer role
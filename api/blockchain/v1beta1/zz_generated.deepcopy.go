@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -235,6 +236,13 @@ func (in *BlockchainPlatformSpec) DeepCopy() *BlockchainPlatformSpec {
 func (in *BlockchainPlatformStatus) DeepCopyInto(out *BlockchainPlatformStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.ComponentDetails.DeepCopyInto(&out.ComponentDetails)
 	out.Replicas = in.Replicas
 	if in.HostOcpuUtilizationInfo != nil {
@@ -373,6 +381,13 @@ func (in *OsnSpec) DeepCopy() *OsnSpec {
 func (in *OsnStatus) DeepCopyInto(out *OsnStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.OcpuAllocationParam = in.OcpuAllocationParam
 }
 
@@ -480,6 +495,13 @@ func (in *PeerSpec) DeepCopy() *PeerSpec {
 func (in *PeerStatus) DeepCopyInto(out *PeerStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.OcpuAllocationParam = in.OcpuAllocationParam
 }
 
@@ -110,6 +110,13 @@ type OccCapacityRequestDetail struct {
 // OccCapacityRequestStatus defines the observed state of OccCapacityRequest.
 type OccCapacityRequestStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the capacity request.
 	Id string `json:"id,omitempty"`
 	// The OCID of the tenancy from which the request was made.
@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -159,6 +160,13 @@ func (in *OccCapacityRequestSpec) DeepCopy() *OccCapacityRequestSpec {
 func (in *OccCapacityRequestStatus) DeepCopyInto(out *OccCapacityRequestStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Details != nil {
 		in, out := &in.Details, &out.Details
 		*out = make([]OccCapacityRequestDetail, len(*in))
@@ -58,6 +58,13 @@ type AgentDependencySpec struct {
 // AgentDependencyStatus defines the observed state of AgentDependency.
 type AgentDependencyStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Unique identifier that is immutable on creation.
 	Id string `json:"id,omitempty"`
 	// Display name of the Agent dependency.
@@ -712,7 +712,14 @@ type AssetAttachedEbsVolumesCost struct {
 // AssetStatus defines the observed state of Asset.
 type AssetStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
-	JsonData   string            `json:"jsonData,omitempty"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+	JsonData           string `json:"jsonData,omitempty"`
 	// Asset display name.
 	DisplayName string `json:"displayName,omitempty"`
 	// List of asset source OCID.
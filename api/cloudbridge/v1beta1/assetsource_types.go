@@ -110,7 +110,14 @@ type AssetSourceReplicationCredentials struct {
 // AssetSourceStatus defines the observed state of AssetSource.
 type AssetSourceStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
-	JsonData   string            `json:"jsonData,omitempty"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+	JsonData           string `json:"jsonData,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of an attached discovery schedule.
 	DiscoveryScheduleId string `json:"discoveryScheduleId,omitempty"`
 	// The freeform tags associated with this resource, if any. Each tag is a simple key-value pair with no
@@ -35,6 +35,13 @@ type InventorySpec struct {
 // InventoryStatus defines the observed state of Inventory.
 type InventoryStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Inventory OCID.
 	Id string `json:"id,omitempty"`
 	// Inventory display name.
@@ -87,6 +87,13 @@ type AdhocQueryLock struct {
 // AdhocQueryStatus defines the observed state of AdhocQuery.
 type AdhocQueryStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// OCID for the adhoc query
 	Id string `json:"id,omitempty"`
 	// Compartment OCID of the adhoc query
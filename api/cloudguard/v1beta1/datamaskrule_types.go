@@ -77,6 +77,13 @@ type DataMaskRuleLock struct {
 // DataMaskRuleStatus defines the observed state of DataMaskRule.
 type DataMaskRuleStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Unique identifier that can't be changed after creation
 	Id string `json:"id,omitempty"`
 	// Compartment OCID where the resource is created
@@ -200,6 +200,13 @@ type DataSourceLoggingQueryDetails struct {
 // DataSourceStatus defines the observed state of DataSource.
 type DataSourceStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// OCID for the data source
 	Id string `json:"id,omitempty"`
 	// Display name of the data source
@@ -451,6 +451,13 @@ type DetectorRecipeEffectiveDetectorRule struct {
 // DetectorRecipeStatus defines the observed state of DetectorRecipe.
 type DetectorRecipeStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// OCID for detector recipe
 	Id string `json:"id,omitempty"`
 	// Display name of detector recipe
@@ -339,6 +339,13 @@ type DetectorRecipeDetectorRuleDetectorDetails struct {
 // DetectorRecipeDetectorRuleStatus defines the observed state of DetectorRecipeDetectorRule.
 type DetectorRecipeDetectorRuleStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The unique identifier of the detector rule.
 	DetectorRuleId string `json:"detectorRuleId,omitempty"`
 	// Detector recipe for the rule
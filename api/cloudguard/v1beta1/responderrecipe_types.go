@@ -183,6 +183,13 @@ type ResponderRecipeEffectiveResponderRule struct {
 // ResponderRecipeStatus defines the observed state of ResponderRecipe.
 type ResponderRecipeStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Unique identifier for the responder recip
 	Id string `json:"id,omitempty"`
 	// Compartment OCID
@@ -53,6 +53,13 @@ type SavedQueryLock struct {
 // SavedQueryStatus defines the observed state of SavedQuery.
 type SavedQueryStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// OCID for the saved query
 	Id string `json:"id,omitempty"`
 	// Display name of the saved query
@@ -41,6 +41,13 @@ type SecurityRecipeSpec struct {
 // SecurityRecipeStatus defines the observed state of SecurityRecipe.
 type SecurityRecipeStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Unique identifier that can’t be changed after creation
 	Id string `json:"id,omitempty"`
 	// The OCID of the compartment that contains the recipe
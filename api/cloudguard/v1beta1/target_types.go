@@ -608,6 +608,13 @@ type TargetDetails struct {
 // TargetStatus defines the observed state of Target.
 type TargetStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Unique identifier that can't be changed after creation
 	Id string `json:"id,omitempty"`
 	// Compartment OCID where the resource is created
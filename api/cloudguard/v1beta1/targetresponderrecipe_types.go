@@ -165,6 +165,13 @@ type TargetResponderRecipeLockFields struct {
 // TargetResponderRecipeStatus defines the observed state of TargetResponderRecipe.
 type TargetResponderRecipeStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Unique identifier of target responder recipe that can't be changed after creation
 	Id string `json:"id,omitempty"`
 	// Unique identifier for the Oracle-managed responder recipe from which this recipe was cloned
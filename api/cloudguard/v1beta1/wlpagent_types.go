@@ -53,6 +53,13 @@ type WlpAgentLock struct {
 // WlpAgentStatus defines the observed state of WlpAgent.
 type WlpAgentStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// OCID for WlpAgent
 	Id string `json:"id,omitempty"`
 	// Compartment OCID of WlpAgent.
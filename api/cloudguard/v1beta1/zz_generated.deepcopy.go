@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -190,6 +191,13 @@ func (in *AdhocQuerySpec) DeepCopy() *AdhocQuerySpec {
 func (in *AdhocQueryStatus) DeepCopyInto(out *AdhocQueryStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.AdhocQueryDetails.DeepCopyInto(&out.AdhocQueryDetails)
 	if in.AdhocQueryRegionalDetails != nil {
 		in, out := &in.AdhocQueryRegionalDetails, &out.AdhocQueryRegionalDetails
@@ -380,6 +388,13 @@ func (in *DataMaskRuleSpec) DeepCopy() *DataMaskRuleSpec {
 func (in *DataMaskRuleStatus) DeepCopyInto(out *DataMaskRuleStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.TargetSelected.DeepCopyInto(&out.TargetSelected)
 	if in.DataMaskCategories != nil {
 		in, out := &in.DataMaskCategories, &out.DataMaskCategories
@@ -709,6 +724,13 @@ func (in *DataSourceSpec) DeepCopy() *DataSourceSpec {
 func (in *DataSourceStatus) DeepCopyInto(out *DataSourceStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.DataSourceDetails.DeepCopyInto(&out.DataSourceDetails)
 	if in.DataSourceDetectorMappingInfo != nil {
 		in, out := &in.DataSourceDetectorMappingInfo, &out.DataSourceDetectorMappingInfo
@@ -1613,6 +1635,13 @@ func (in *DetectorRecipeDetectorRuleSpec) DeepCopy() *DetectorRecipeDetectorRule
 func (in *DetectorRecipeDetectorRuleStatus) DeepCopyInto(out *DetectorRecipeDetectorRuleStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.RuleType != nil {
 		in, out := &in.RuleType, &out.RuleType
 		*out = make([]DetectorRecipeDetectorRuleRuleTypeFields, len(*in))
@@ -2017,6 +2046,13 @@ func (in *DetectorRecipeSpec) DeepCopy() *DetectorRecipeSpec {
 func (in *DetectorRecipeStatus) DeepCopyInto(out *DetectorRecipeStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.DetectorRules != nil {
 		in, out := &in.DetectorRules, &out.DetectorRules
 		*out = make([]DetectorRecipeDetectorRuleFields, len(*in))
@@ -2199,6 +2235,13 @@ func (in *ManagedListSpec) DeepCopy() *ManagedListSpec {
 func (in *ManagedListStatus) DeepCopyInto(out *ManagedListStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ListItems != nil {
 		in, out := &in.ListItems, &out.ListItems
 		*out = make([]string, len(*in))
@@ -2544,6 +2587,13 @@ func (in *ResponderRecipeSpec) DeepCopy() *ResponderRecipeSpec {
 func (in *ResponderRecipeStatus) DeepCopyInto(out *ResponderRecipeStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ResponderRules != nil {
 		in, out := &in.ResponderRules, &out.ResponderRules
 		*out = make([]ResponderRecipeResponderRule, len(*in))
@@ -2729,6 +2779,13 @@ func (in *SavedQuerySpec) DeepCopy() *SavedQuerySpec {
 func (in *SavedQueryStatus) DeepCopyInto(out *SavedQueryStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -2897,6 +2954,13 @@ func (in *SecurityRecipeSpec) DeepCopy() *SecurityRecipeSpec {
 func (in *SecurityRecipeStatus) DeepCopyInto(out *SecurityRecipeStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.SecurityPolicies != nil {
 		in, out := &in.SecurityPolicies, &out.SecurityPolicies
 		*out = make([]string, len(*in))
@@ -3060,6 +3124,13 @@ func (in *SecurityZoneSpec) DeepCopy() *SecurityZoneSpec {
 func (in *SecurityZoneStatus) DeepCopyInto(out *SecurityZoneStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.InheritedByCompartments != nil {
 		in, out := &in.InheritedByCompartments, &out.InheritedByCompartments
 		*out = make([]string, len(*in))
@@ -4325,6 +4396,13 @@ func (in *TargetDetectorRecipeSpec) DeepCopy() *TargetDetectorRecipeSpec {
 func (in *TargetDetectorRecipeStatus) DeepCopyInto(out *TargetDetectorRecipeStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.DetectorRules != nil {
 		in, out := &in.DetectorRules, &out.DetectorRules
 		*out = make([]TargetDetectorRecipeDetectorRuleFields, len(*in))
@@ -4821,6 +4899,13 @@ func (in *TargetResponderRecipeSpec) DeepCopy() *TargetResponderRecipeSpec {
 func (in *TargetResponderRecipeStatus) DeepCopyInto(out *TargetResponderRecipeStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ResponderRules != nil {
 		in, out := &in.ResponderRules, &out.ResponderRules
 		*out = make([]TargetResponderRecipeResponderRuleFields, len(*in))
@@ -4910,6 +4995,13 @@ func (in *TargetSpec) DeepCopy() *TargetSpec {
 func (in *TargetStatus) DeepCopyInto(out *TargetStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.TargetDetectorRecipes != nil {
 		in, out := &in.TargetDetectorRecipes, &out.TargetDetectorRecipes
 		*out = make([]TargetDetectorRecipeFields, len(*in))
@@ -5103,6 +5195,13 @@ func (in *WlpAgentSpec) DeepCopy() *WlpAgentSpec {
 func (in *WlpAgentStatus) DeepCopyInto(out *WlpAgentStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Locks != nil {
 		in, out := &in.Locks, &out.Locks
 		*out = make([]WlpAgentLock, len(*in))
@@ -73,6 +73,13 @@ type MigrationAssetDestinationDisks struct {
 // MigrationAssetStatus defines the observed state of MigrationAsset.
 type MigrationAssetStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Asset ID generated by mirgration service. It is used in the mirgration service pipeline.
 	Id string `json:"id,omitempty"`
 	// The type of asset referenced for inventory.
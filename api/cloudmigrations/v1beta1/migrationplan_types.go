@@ -215,6 +215,13 @@ type MigrationPlanStats struct {
 // MigrationPlanStatus defines the observed state of MigrationPlan.
 type MigrationPlanStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The unique Oracle ID (OCID) that is immutable on creation.
 	Id string `json:"id,omitempty"`
 	// The OCID of the compartment containing the migration plan.
@@ -36,6 +36,13 @@ type ReplicationScheduleSpec struct {
 // ReplicationScheduleStatus defines the observed state of ReplicationSchedule.
 type ReplicationScheduleStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the replication schedule.
 	Id string `json:"id,omitempty"`
 	// A name of the replication schedule.
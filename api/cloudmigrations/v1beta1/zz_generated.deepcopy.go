@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -171,6 +172,13 @@ func (in *MigrationAssetSpec) DeepCopy() *MigrationAssetSpec {
 func (in *MigrationAssetStatus) DeepCopyInto(out *MigrationAssetStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Snapshots != nil {
 		in, out := &in.Snapshots, &out.Snapshots
 		*out = make(map[string]MigrationAssetSnapshots, len(*in))
@@ -513,6 +521,13 @@ func (in *MigrationPlanStatsTotalEstimatedCostStorageVolume) DeepCopy() *Migrati
 func (in *MigrationPlanStatus) DeepCopyInto(out *MigrationPlanStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Strategies != nil {
 		in, out := &in.Strategies, &out.Strategies
 		*out = make([]MigrationPlanStrategy, len(*in))
@@ -670,6 +685,13 @@ func (in *MigrationSpec) DeepCopy() *MigrationSpec {
 func (in *MigrationStatus) DeepCopyInto(out *MigrationStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.MigrationConfig = in.MigrationConfig
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
@@ -829,6 +851,13 @@ func (in *ReplicationScheduleSpec) DeepCopy() *ReplicationScheduleSpec {
 func (in *ReplicationScheduleStatus) DeepCopyInto(out *ReplicationScheduleStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -1361,6 +1390,13 @@ func (in *TargetAssetSpec) DeepCopy() *TargetAssetSpec {
 func (in *TargetAssetStatus) DeepCopyInto(out *TargetAssetStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.CompatibilityMessages != nil {
 		in, out := &in.CompatibilityMessages, &out.CompatibilityMessages
 		*out = make([]TargetAssetCompatibilityMessage, len(*in))
@@ -169,6 +169,13 @@ type CccInfrastructureUpgradeInformation struct {
 // CccInfrastructureStatus defines the observed state of CccInfrastructure.
 type CccInfrastructureStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The Compute Cloud@Customer infrastructure OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm).
 	// This cannot be changed once created.
 	Id string `json:"id,omitempty"`
@@ -63,6 +63,13 @@ type CccUpgradeScheduleEvent struct {
 // CccUpgradeScheduleStatus defines the observed state of CccUpgradeSchedule.
 type CccUpgradeScheduleStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Upgrade schedule OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm).
 	// This cannot be changed once created.
 	Id string `json:"id,omitempty"`
@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -229,6 +230,13 @@ func (in *CccInfrastructureSpec) DeepCopy() *CccInfrastructureSpec {
 func (in *CccInfrastructureStatus) DeepCopyInto(out *CccInfrastructureStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.InfrastructureInventory = in.InfrastructureInventory
 	in.InfrastructureNetworkConfiguration.DeepCopyInto(&out.InfrastructureNetworkConfiguration)
 	out.UpgradeInformation = in.UpgradeInformation
@@ -425,6 +433,13 @@ func (in *CccUpgradeScheduleSpec) DeepCopy() *CccUpgradeScheduleSpec {
 func (in *CccUpgradeScheduleStatus) DeepCopyInto(out *CccUpgradeScheduleStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Events != nil {
 		in, out := &in.Events, &out.Events
 		*out = make([]CccUpgradeScheduleEvent, len(*in))
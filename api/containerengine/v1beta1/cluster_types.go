@@ -286,6 +286,13 @@ type ClusterEndpoints struct {
 // ClusterStatus defines the observed state of Cluster.
 type ClusterStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the cluster.
 	Id string `json:"id,omitempty"`
 	// The name of the cluster.
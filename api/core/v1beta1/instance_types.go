@@ -142,8 +142,13 @@ type InstanceSpec struct {
 	// +kubebuilder:validation:Required
 	AvailabilityDomain string `json:"availabilityDomain"`
 	// The OCID of the compartment.
-	// +kubebuilder:validation:Required
-	CompartmentId string `json:"compartmentId"`
+	// Set either compartmentId or compartmentRef.
+	// +kubebuilder:validation:Optional
+	CompartmentId string `json:"compartmentId,omitempty"`
+	// CompartmentRef names the Compartment resource whose status.status.ocid is used for compartmentId.
+	// Reconciliation waits with a DependencyNotReady condition until that Compartment is Active.
+	// +kubebuilder:validation:Optional
+	CompartmentRef *shared.ResourceRef `json:"compartmentRef,omitempty"`
 	// +kubebuilder:validation:Optional
 	CreateVnicDetails InstanceCreateVnicDetails `json:"createVnicDetails,omitempty"`
 	// The OCID of the cluster placement group of the instance.
@@ -193,8 +198,13 @@ type InstanceSpec struct {
 	// Deprecated. Instead use `subnetId` in
 	// CreateVnicDetails.
 	// At least one of them is required; if you provide both, the values must match.
+	// Set either subnetId or subnetRef.
 	// +kubebuilder:validation:Optional
 	SubnetId string `json:"subnetId,omitempty"`
+	// SubnetRef names the Subnet resource whose status.status.ocid is used for subnetId.
+	// Reconciliation waits with a DependencyNotReady condition until that Subnet is Active.
+	// +kubebuilder:validation:Optional
+	SubnetRef *shared.ResourceRef `json:"subnetRef,omitempty"`
 	// Volume attachments to create as part of the launch instance operation.
 	// +kubebuilder:validation:Optional
 	LaunchVolumeAttachments []InstanceLaunchVolumeAttachment `json:"launchVolumeAttachments,omitempty"`
@@ -685,6 +695,13 @@ type InstanceLoadBalancerBackend struct {
 // InstanceStatus defines the observed state of Instance.
 type InstanceStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The availability domain the instance is running in.
 	// Example: `Uocm:PHX-AD-1`
 	AvailabilityDomain string `json:"availabilityDomain,omitempty"`
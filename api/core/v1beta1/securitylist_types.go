@@ -274,6 +274,13 @@ type SecurityListIngressSecurityRule struct {
 // SecurityListStatus defines the observed state of SecurityList.
 type SecurityListStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the compartment containing the security list.
 	CompartmentId string `json:"compartmentId,omitempty"`
 	// A user-friendly name. Does not have to be unique, and it's changeable.
@@ -69,6 +69,13 @@ type ServiceGatewayService struct {
 // ServiceGatewayStatus defines the observed state of ServiceGateway.
 type ServiceGatewayStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Whether the service gateway blocks all traffic through it. The default is `false`. When
 	// this is `true`, traffic is not routed to any services, regardless of route rules.
 	// Example: `true`
@@ -15,11 +15,21 @@ import (
 // SubnetSpec defines the desired state of Subnet.
 type SubnetSpec struct {
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the compartment to contain the subnet.
-	// +kubebuilder:validation:Required
-	CompartmentId string `json:"compartmentId"`
+	// Set either compartmentId or compartmentRef.
+	// +kubebuilder:validation:Optional
+	CompartmentId string `json:"compartmentId,omitempty"`
+	// CompartmentRef names the Compartment resource whose status.status.ocid is used for compartmentId.
+	// Reconciliation waits with a DependencyNotReady condition until that Compartment is Active.
+	// +kubebuilder:validation:Optional
+	CompartmentRef *shared.ResourceRef `json:"compartmentRef,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the VCN to contain the subnet.
-	// +kubebuilder:validation:Required
-	VcnId string `json:"vcnId"`
+	// Set either vcnId or vcnRef.
+	// +kubebuilder:validation:Optional
+	VcnId string `json:"vcnId,omitempty"`
+	// VcnRef names the Vcn resource whose status.status.ocid is used for vcnId.
+	// Reconciliation waits with a DependencyNotReady condition until that Vcn is Active.
+	// +kubebuilder:validation:Optional
+	VcnRef *shared.ResourceRef `json:"vcnRef,omitempty"`
 	// Controls whether the subnet is regional or specific to an availability domain. Oracle
 	// recommends creating regional subnets because they're more flexible and make it easier to
 	// implement failover across availability domains. Originally, AD-specific subnets were the
@@ -111,19 +121,36 @@ type SubnetSpec struct {
 	ProhibitPublicIpOnVnic bool `json:"prohibitPublicIpOnVnic,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the route table the subnet will use. If you don't provide a value,
 	// the subnet uses the VCN's default route table.
+	// Set either routeTableId or routeTableRef.
 	// +kubebuilder:validation:Optional
 	RouteTableId string `json:"routeTableId,omitempty"`
+	// RouteTableRef names the RouteTable resource whose status.status.ocid is used for routeTableId.
+	// Reconciliation waits with a DependencyNotReady condition until that RouteTable is Active.
+	// +kubebuilder:validation:Optional
+	RouteTableRef *shared.ResourceRef `json:"routeTableRef,omitempty"`
 	// The OCIDs of the security list or lists the subnet will use. If you don't
 	// provide a value, the subnet uses the VCN's default security list.
 	// Remember that security lists are associated *with the subnet*, but the
 	// rules are applied to the individual VNICs in the subnet.
+	// Set either securityListIds or securityListRefs.
 	// +kubebuilder:validation:Optional
 	SecurityListIds []string `json:"securityListIds,omitempty"`
+	// SecurityListRefs names the SecurityList resources whose status.status.ocid values are used for securityListIds.
+	// Reconciliation waits with a DependencyNotReady condition until every SecurityList is Active.
+	// +kubebuilder:validation:Optional
+	SecurityListRefs []shared.ResourceRef `json:"securityListRefs,omitempty"`
 }
 
 // SubnetStatus defines the observed state of Subnet.
 type SubnetStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The subnet's CIDR block.
 	// Example: `10.0.1.0/24`
 	CidrBlock string `json:"cidrBlock,omitempty"`
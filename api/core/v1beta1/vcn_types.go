@@ -15,8 +15,13 @@ import (
 // VcnSpec defines the desired state of Vcn.
 type VcnSpec struct {
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the compartment to contain the VCN.
-	// +kubebuilder:validation:Required
-	CompartmentId string `json:"compartmentId"`
+	// Set either compartmentId or compartmentRef.
+	// +kubebuilder:validation:Optional
+	CompartmentId string `json:"compartmentId,omitempty"`
+	// CompartmentRef names the Compartment resource whose status.status.ocid is used for compartmentId.
+	// Reconciliation waits with a DependencyNotReady condition until that Compartment is Active.
+	// +kubebuilder:validation:Optional
+	CompartmentRef *shared.ResourceRef `json:"compartmentRef,omitempty"`
 	// **Deprecated.** Do *not* set this value. Use `cidrBlocks` instead.
 	// Example: `10.0.0.0/16`
 	// +kubebuilder:validation:Optional
@@ -102,6 +107,13 @@ type VcnByoipv6CidrDetail struct {
 // VcnStatus defines the observed state of Vcn.
 type VcnStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Deprecated. The first CIDR IP address from cidrBlocks.
 	// Example: `172.16.0.0/16`
 	CidrBlock string `json:"cidrBlock,omitempty"`
@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -133,6 +134,13 @@ func (in *DrgSpec) DeepCopy() *DrgSpec {
 func (in *DrgStatus) DeepCopyInto(out *DrgStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.DefinedTags != nil {
 		in, out := &in.DefinedTags, &out.DefinedTags
 		*out = make(map[string]shared.MapValue, len(*in))
@@ -650,8 +658,18 @@ func (in *InstanceSpec) DeepCopyInto(out *InstanceSpec) {
 		*out = make([]InstanceLicensingConfig, len(*in))
 		copy(*out, *in)
 	}
+	if in.CompartmentRef != nil {
+		in, out := &in.CompartmentRef, &out.CompartmentRef
+		*out = new(shared.ResourceRef)
+		**out = **in
+	}
 	in.CreateVnicDetails.DeepCopyInto(&out.CreateVnicDetails)
 	out.PreemptibleInstanceConfig = in.PreemptibleInstanceConfig
+	if in.SubnetRef != nil {
+		in, out := &in.SubnetRef, &out.SubnetRef
+		*out = new(shared.ResourceRef)
+		**out = **in
+	}
 	if in.LaunchVolumeAttachments != nil {
 		in, out := &in.LaunchVolumeAttachments, &out.LaunchVolumeAttachments
 		*out = make([]InstanceLaunchVolumeAttachment, len(*in))
@@ -674,6 +692,13 @@ func (in *InstanceSpec) DeepCopy() *InstanceSpec {
 func (in *InstanceStatus) DeepCopyInto(out *InstanceStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.PlacementConstraintDetails = in.PlacementConstraintDetails
 	if in.DefinedTags != nil {
 		in, out := &in.DefinedTags, &out.DefinedTags
@@ -883,6 +908,13 @@ func (in *InternetGatewaySpec) DeepCopy() *InternetGatewaySpec {
 func (in *InternetGatewayStatus) DeepCopyInto(out *InternetGatewayStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.DefinedTags != nil {
 		in, out := &in.DefinedTags, &out.DefinedTags
 		*out = make(map[string]shared.MapValue, len(*in))
@@ -1023,6 +1055,13 @@ func (in *NatGatewaySpec) DeepCopy() *NatGatewaySpec {
 func (in *NatGatewayStatus) DeepCopyInto(out *NatGatewayStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.DefinedTags != nil {
 		in, out := &in.DefinedTags, &out.DefinedTags
 		*out = make(map[string]shared.MapValue, len(*in))
@@ -1163,6 +1202,13 @@ func (in *NetworkSecurityGroupSpec) DeepCopy() *NetworkSecurityGroupSpec {
 func (in *NetworkSecurityGroupStatus) DeepCopyInto(out *NetworkSecurityGroupStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.DefinedTags != nil {
 		in, out := &in.DefinedTags, &out.DefinedTags
 		*out = make(map[string]shared.MapValue, len(*in))
@@ -1323,6 +1369,13 @@ func (in *RouteTableSpec) DeepCopy() *RouteTableSpec {
 func (in *RouteTableStatus) DeepCopyInto(out *RouteTableStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.RouteRules != nil {
 		in, out := &in.RouteRules, &out.RouteRules
 		*out = make([]RouteTableRouteRule, len(*in))
@@ -1732,6 +1785,13 @@ func (in *SecurityListSpec) DeepCopy() *SecurityListSpec {
 func (in *SecurityListStatus) DeepCopyInto(out *SecurityListStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.EgressSecurityRules != nil {
 		in, out := &in.EgressSecurityRules, &out.EgressSecurityRules
 		*out = make([]SecurityListEgressSecurityRule, len(*in))
@@ -1902,6 +1962,13 @@ func (in *ServiceGatewaySpec) DeepCopy() *ServiceGatewaySpec {
 func (in *ServiceGatewayStatus) DeepCopyInto(out *ServiceGatewayStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Services != nil {
 		in, out := &in.Services, &out.Services
 		*out = make([]ServiceGatewayService, len(*in))
@@ -2006,6 +2073,16 @@ func (in *SubnetList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubnetSpec) DeepCopyInto(out *SubnetSpec) {
 	*out = *in
+	if in.CompartmentRef != nil {
+		in, out := &in.CompartmentRef, &out.CompartmentRef
+		*out = new(shared.ResourceRef)
+		**out = **in
+	}
+	if in.VcnRef != nil {
+		in, out := &in.VcnRef, &out.VcnRef
+		*out = new(shared.ResourceRef)
+		**out = **in
+	}
 	if in.Ipv4CidrBlocks != nil {
 		in, out := &in.Ipv4CidrBlocks, &out.Ipv4CidrBlocks
 		*out = make([]string, len(*in))
@@ -2041,11 +2118,21 @@ func (in *SubnetSpec) DeepCopyInto(out *SubnetSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RouteTableRef != nil {
+		in, out := &in.RouteTableRef, &out.RouteTableRef
+		*out = new(shared.ResourceRef)
+		**out = **in
+	}
 	if in.SecurityListIds != nil {
 		in, out := &in.SecurityListIds, &out.SecurityListIds
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.SecurityListRefs != nil {
+		in, out := &in.SecurityListRefs, &out.SecurityListRefs
+		*out = make([]shared.ResourceRef, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetSpec.
@@ -2062,6 +2149,13 @@ func (in *SubnetSpec) DeepCopy() *SubnetSpec {
 func (in *SubnetStatus) DeepCopyInto(out *SubnetStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Ipv4CidrBlocks != nil {
 		in, out := &in.Ipv4CidrBlocks, &out.Ipv4CidrBlocks
 		*out = make([]string, len(*in))
@@ -2191,6 +2285,11 @@ func (in *VcnList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VcnSpec) DeepCopyInto(out *VcnSpec) {
 	*out = *in
+	if in.CompartmentRef != nil {
+		in, out := &in.CompartmentRef, &out.CompartmentRef
+		*out = new(shared.ResourceRef)
+		**out = **in
+	}
 	if in.CidrBlocks != nil {
 		in, out := &in.CidrBlocks, &out.CidrBlocks
 		*out = make([]string, len(*in))
@@ -2265,6 +2364,13 @@ func (in *VcnSpec) DeepCopy() *VcnSpec {
 func (in *VcnStatus) DeepCopyInto(out *VcnStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.CidrBlocks != nil {
 		in, out := &in.CidrBlocks, &out.CidrBlocks
 		*out = make([]string, len(*in))
@@ -38,6 +38,13 @@ type DashboardGroupSpec struct {
 // DashboardGroupStatus defines the observed state of DashboardGroup.
 type DashboardGroupStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the dashboard group.
 	Id string `json:"id,omitempty"`
 	// A user-friendly name for the dashboard. Does not have to be unique, and it can be changed. Avoid entering confidential information.
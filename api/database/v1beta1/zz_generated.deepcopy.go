@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -522,6 +523,13 @@ func (in *AutonomousDatabaseStandbyDb) DeepCopy() *AutonomousDatabaseStandbyDb {
 func (in *AutonomousDatabaseStatus) DeepCopyInto(out *AutonomousDatabaseStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.EncryptionKey = in.EncryptionKey
 	out.EncryptionKeyLocationDetails = in.EncryptionKeyLocationDetails
 	out.LongTermBackupSchedule = in.LongTermBackupSchedule
@@ -145,7 +145,14 @@ type ConnectionIngressIp struct {
 // ConnectionStatus defines the observed state of Connection.
 type ConnectionStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
-	JsonData   string            `json:"jsonData,omitempty"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+	JsonData           string `json:"jsonData,omitempty"`
 	// A user-friendly description. Does not have to be unique, and it's changeable.
 	// Avoid entering confidential information.
 	Description string `json:"description,omitempty"`
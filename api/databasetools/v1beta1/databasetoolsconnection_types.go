@@ -169,7 +169,14 @@ type DatabaseToolsConnectionProxyClient struct {
 // DatabaseToolsConnectionStatus defines the observed state of DatabaseToolsConnection.
 type DatabaseToolsConnectionStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
-	JsonData   string            `json:"jsonData,omitempty"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+	JsonData           string `json:"jsonData,omitempty"`
 	// A message describing the current state in more detail. For example, this message can be used to provide actionable information for a resource in the Failed state.
 	LifecycleDetails string `json:"lifecycleDetails,omitempty"`
 	// Defined tags for this resource. Each key is predefined and scoped to a namespace.
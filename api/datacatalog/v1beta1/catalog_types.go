@@ -46,6 +46,13 @@ type CatalogLock struct {
 // CatalogStatus defines the observed state of Catalog.
 type CatalogStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// OCID of the data catalog instance.
 	Id string `json:"id,omitempty"`
 	// Compartment identifier.
@@ -58,6 +58,13 @@ type CatalogPrivateEndpointLock struct {
 // CatalogPrivateEndpointStatus defines the observed state of CatalogPrivateEndpoint.
 type CatalogPrivateEndpointStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Unique identifier that is immutable
 	Id string `json:"id,omitempty"`
 	// Compartment Identifier.
@@ -74,6 +74,13 @@ type ConnectionCustomPropertyMember struct {
 // ConnectionStatus defines the observed state of Connection.
 type ConnectionStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Unique connection key that is immutable.
 	Key string `json:"key,omitempty"`
 	// A description of the connection.
@@ -84,6 +84,13 @@ type DataAssetDataSelectorPattern struct {
 // DataAssetStatus defines the observed state of DataAsset.
 type DataAssetStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Unique data asset key that is immutable.
 	Key string `json:"key,omitempty"`
 	// A user-friendly display name. Does not have to be unique, and it's changeable.
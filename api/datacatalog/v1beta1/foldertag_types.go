@@ -26,6 +26,13 @@ type FolderTagSpec struct {
 // FolderTagStatus defines the observed state of FolderTag.
 type FolderTagStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Unique tag key that is immutable.
 	Key string `json:"key,omitempty"`
 	// Name of the tag which matches the term name.
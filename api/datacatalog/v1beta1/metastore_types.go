@@ -54,6 +54,13 @@ type MetastoreLock struct {
 // MetastoreStatus defines the observed state of Metastore.
 type MetastoreStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The metastore's OCID.
 	Id string `json:"id,omitempty"`
 	// OCID of the compartment which holds the metastore.
@@ -29,6 +29,13 @@ type NamespaceSpec struct {
 // NamespaceStatus defines the observed state of Namespace.
 type NamespaceStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Unique namespace key that is immutable.
 	Key string `json:"key,omitempty"`
 	// Name of the Namespace
@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -145,6 +146,13 @@ func (in *AttributeSpec) DeepCopy() *AttributeSpec {
 func (in *AttributeStatus) DeepCopyInto(out *AttributeStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ObjectRelationships != nil {
 		in, out := &in.ObjectRelationships, &out.ObjectRelationships
 		*out = make([]AttributeObjectRelationship, len(*in))
@@ -268,6 +276,13 @@ func (in *AttributeTagSpec) DeepCopy() *AttributeTagSpec {
 func (in *AttributeTagStatus) DeepCopyInto(out *AttributeTagStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AttributeTagStatus.
@@ -495,6 +510,13 @@ func (in *CatalogPrivateEndpointSpec) DeepCopy() *CatalogPrivateEndpointSpec {
 func (in *CatalogPrivateEndpointStatus) DeepCopyInto(out *CatalogPrivateEndpointStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.DnsZones != nil {
 		in, out := &in.DnsZones, &out.DnsZones
 		*out = make([]string, len(*in))
@@ -627,6 +649,13 @@ func (in *CatalogSpec) DeepCopy() *CatalogSpec {
 func (in *CatalogStatus) DeepCopyInto(out *CatalogStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -826,6 +855,13 @@ func (in *ConnectionSpec) DeepCopy() *ConnectionSpec {
 func (in *ConnectionStatus) DeepCopyInto(out *ConnectionStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.CustomPropertyMembers != nil {
 		in, out := &in.CustomPropertyMembers, &out.CustomPropertyMembers
 		*out = make([]ConnectionCustomPropertyMember, len(*in))
@@ -992,6 +1028,13 @@ func (in *CustomPropertySpec) DeepCopy() *CustomPropertySpec {
 func (in *CustomPropertyStatus) DeepCopyInto(out *CustomPropertyStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Scope != nil {
 		in, out := &in.Scope, &out.Scope
 		*out = make([]CustomPropertyScope, len(*in))
@@ -1168,6 +1211,13 @@ func (in *DataAssetSpec) DeepCopy() *DataAssetSpec {
 func (in *DataAssetStatus) DeepCopyInto(out *DataAssetStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.CustomPropertyMembers != nil {
 		in, out := &in.CustomPropertyMembers, &out.CustomPropertyMembers
 		*out = make([]DataAssetCustomPropertyMember, len(*in))
@@ -1286,6 +1336,13 @@ func (in *DataAssetTagSpec) DeepCopy() *DataAssetTagSpec {
 func (in *DataAssetTagStatus) DeepCopyInto(out *DataAssetTagStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataAssetTagStatus.
@@ -1429,6 +1486,13 @@ func (in *EntitySpec) DeepCopy() *EntitySpec {
 func (in *EntityStatus) DeepCopyInto(out *EntityStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ObjectRelationships != nil {
 		in, out := &in.ObjectRelationships, &out.ObjectRelationships
 		*out = make([]EntityObjectRelationship, len(*in))
@@ -1547,6 +1611,13 @@ func (in *EntityTagSpec) DeepCopy() *EntityTagSpec {
 func (in *EntityTagStatus) DeepCopyInto(out *EntityTagStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EntityTagStatus.
@@ -1690,6 +1761,13 @@ func (in *FolderSpec) DeepCopy() *FolderSpec {
 func (in *FolderStatus) DeepCopyInto(out *FolderStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ObjectRelationships != nil {
 		in, out := &in.ObjectRelationships, &out.ObjectRelationships
 		*out = make([]FolderObjectRelationship, len(*in))
@@ -1808,6 +1886,13 @@ func (in *FolderTagSpec) DeepCopy() *FolderTagSpec {
 func (in *FolderTagStatus) DeepCopyInto(out *FolderTagStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FolderTagStatus.
@@ -1918,6 +2003,13 @@ func (in *GlossarySpec) DeepCopy() *GlossarySpec {
 func (in *GlossaryStatus) DeepCopyInto(out *GlossaryStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.CustomPropertyMembers != nil {
 		in, out := &in.CustomPropertyMembers, &out.CustomPropertyMembers
 		*out = make([]GlossaryCustomPropertyMember, len(*in))
@@ -2058,6 +2150,13 @@ func (in *JobDefinitionSpec) DeepCopy() *JobDefinitionSpec {
 func (in *JobDefinitionStatus) DeepCopyInto(out *JobDefinitionStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Properties != nil {
 		in, out := &in.Properties, &out.Properties
 		*out = make(map[string]map[string]string, len(*in))
@@ -2154,6 +2253,13 @@ func (in *JobSpec) DeepCopy() *JobSpec {
 func (in *JobStatus) DeepCopyInto(out *JobStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Executions != nil {
 		in, out := &in.Executions, &out.Executions
 		*out = make([]JobExecution, len(*in))
@@ -2289,6 +2395,13 @@ func (in *MetastoreSpec) DeepCopy() *MetastoreSpec {
 func (in *MetastoreStatus) DeepCopyInto(out *MetastoreStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -2427,6 +2540,13 @@ func (in *NamespaceSpec) DeepCopy() *NamespaceSpec {
 func (in *NamespaceStatus) DeepCopyInto(out *NamespaceStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceStatus.
@@ -2540,6 +2660,13 @@ func (in *PatternSpec) DeepCopy() *PatternSpec {
 func (in *PatternStatus) DeepCopyInto(out *PatternStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.CheckFilePathList != nil {
 		in, out := &in.CheckFilePathList, &out.CheckFilePathList
 		*out = make([]string, len(*in))
@@ -2742,6 +2869,13 @@ func (in *TermRelationshipSpec) DeepCopy() *TermRelationshipSpec {
 func (in *TermRelationshipStatus) DeepCopyInto(out *TermRelationshipStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TermRelationshipStatus.
@@ -2778,6 +2912,13 @@ func (in *TermSpec) DeepCopy() *TermSpec {
 func (in *TermStatus) DeepCopyInto(out *TermStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.AssociatedObjects != nil {
 		in, out := &in.AssociatedObjects, &out.AssociatedObjects
 		*out = make([]TermAssociatedObject, len(*in))
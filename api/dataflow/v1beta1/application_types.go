@@ -173,6 +173,13 @@ type ApplicationParameter struct {
 // ApplicationStatus defines the observed state of Application.
 type ApplicationStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of a compartment.
 	CompartmentId string `json:"compartmentId,omitempty"`
 	// A user-friendly name. This name is not necessarily unique.
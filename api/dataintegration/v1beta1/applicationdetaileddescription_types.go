@@ -25,6 +25,13 @@ type ApplicationDetailedDescriptionSpec struct {
 // ApplicationDetailedDescriptionStatus defines the observed state of ApplicationDetailedDescription.
 type ApplicationDetailedDescriptionStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
 
 // +kubebuilder:object:root=true
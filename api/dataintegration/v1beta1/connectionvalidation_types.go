@@ -2855,8 +2855,15 @@ type ConnectionValidationMetadata struct {
 
 // ConnectionValidationStatus defines the observed state of ConnectionValidation.
 type ConnectionValidationStatus struct {
-	OsokStatus        shared.OSOKStatus                     `json:"status"`
-	ValidationMessage ConnectionValidationValidationMessage `json:"validationMessage,omitempty"`
+	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64                                 `json:"observedGeneration,omitempty"`
+	ValidationMessage  ConnectionValidationValidationMessage `json:"validationMessage,omitempty"`
 	// Objects will use a 36 character key as unique ID. It is system generated and cannot be modified.
 	Key string `json:"key,omitempty"`
 	// The type of the object.
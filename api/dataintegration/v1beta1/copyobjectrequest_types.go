@@ -91,6 +91,13 @@ type CopyObjectRequestReferencedItem struct {
 // CopyObjectRequestStatus defines the observed state of CopyObjectRequest.
 type CopyObjectRequestStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Copy object request key.
 	Key string `json:"key,omitempty"`
 	// The workspace id of the source from where we need to copy object.
@@ -3323,7 +3323,14 @@ type DataAssetStagingConnectionStatus struct {
 // DataAssetStatus defines the observed state of DataAsset.
 type DataAssetStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
-	JsonData   string            `json:"jsonData,omitempty"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+	JsonData           string `json:"jsonData,omitempty"`
 	// Generated key that can be used in API calls to identify data asset.
 	Key string `json:"key,omitempty"`
 	// The model version of an object.
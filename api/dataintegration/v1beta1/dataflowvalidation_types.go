@@ -164,6 +164,13 @@ type DataFlowValidationValidationMessages struct {
 // DataFlowValidationStatus defines the observed state of DataFlowValidation.
 type DataFlowValidationStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The total number of validation messages.
 	TotalMessageCount int `json:"totalMessageCount,omitempty"`
 	// The total number of validation error messages.
@@ -229,6 +229,13 @@ type DisApplicationPublishedObjectMetadata struct {
 // DisApplicationStatus defines the observed state of DisApplication.
 type DisApplicationStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Generated key that can be used in API calls to identify application.
 	Key string `json:"key,omitempty"`
 	// The object type.
@@ -66,6 +66,13 @@ type ExportRequestExportedItem struct {
 // ExportRequestStatus defines the observed state of ExportRequest.
 type ExportRequestStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Export object request key
 	Key string `json:"key,omitempty"`
 	// The list of the objects to be exported
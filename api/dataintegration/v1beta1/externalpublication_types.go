@@ -2551,6 +2551,13 @@ type ExternalPublicationMetadata struct {
 // ExternalPublicationStatus defines the observed state of ExternalPublication.
 type ExternalPublicationStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The unique OCID of the identifier that is returned after creating the Oracle Cloud Infrastructure Data Flow application.
 	ApplicationId string `json:"applicationId,omitempty"`
 	// The OCID of the compartment where the application is created in the Oracle Cloud Infrastructure Data Flow Service.
@@ -79,6 +79,13 @@ type ImportRequestImportedObject struct {
 // ImportRequestStatus defines the observed state of ImportRequest.
 type ImportRequestStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Import object request key
 	Key string `json:"key,omitempty"`
 	// The name of the Object Storage Bucket where the objects will be imported from
@@ -158,6 +158,13 @@ type PipelineValidationValidationMessages struct {
 // PipelineValidationStatus defines the observed state of PipelineValidation.
 type PipelineValidationStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The total number of validation messages.
 	TotalMessageCount int `json:"totalMessageCount,omitempty"`
 	// The total number of validation error messages.
@@ -457,6 +457,13 @@ type TaskRunInputs struct {
 // TaskRunStatus defines the observed state of TaskRun.
 type TaskRunStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The key of the object.
 	Key string `json:"key,omitempty"`
 	// The type of the object.
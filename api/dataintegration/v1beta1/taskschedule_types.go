@@ -390,6 +390,13 @@ type TaskScheduleMetadata struct {
 // TaskScheduleStatus defines the observed state of TaskSchedule.
 type TaskScheduleStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Generated key that can be used in API calls to identify taskSchedule. On scenarios where reference to the taskSchedule is needed, a value can be passed in create.
 	Key string `json:"key,omitempty"`
 	// This is a version number that is used by the service to upgrade objects if needed through releases of the service.
@@ -2911,6 +2911,13 @@ type TaskValidationValidationMessages struct {
 // TaskValidationStatus defines the observed state of TaskValidation.
 type TaskValidationStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Total number of validation messages.
 	TotalMessageCount int `json:"totalMessageCount,omitempty"`
 	// Total number of validation error messages.
@@ -1929,6 +1929,13 @@ type UserDefinedFunctionMetadata struct {
 // UserDefinedFunctionStatus defines the observed state of UserDefinedFunction.
 type UserDefinedFunctionStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Generated key that can be used in API calls to identify user defined function. On scenarios where reference to the user defined function is needed, a value can be passed in create.
 	Key string `json:"key,omitempty"`
 	// The type of the object.
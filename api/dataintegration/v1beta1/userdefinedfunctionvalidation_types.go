@@ -1948,6 +1948,13 @@ type UserDefinedFunctionValidationValidationMessages struct {
 // UserDefinedFunctionValidationStatus defines the observed state of UserDefinedFunctionValidation.
 type UserDefinedFunctionValidationStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The total number of validation messages.
 	TotalMessageCount int `json:"totalMessageCount,omitempty"`
 	// The total number of validation error messages.
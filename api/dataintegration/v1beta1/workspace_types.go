@@ -72,6 +72,13 @@ type WorkspaceSpec struct {
 // WorkspaceStatus defines the observed state of Workspace.
 type WorkspaceStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// A user-friendly display name for the workspace. Does not have to be unique, and can be modified. Avoid entering confidential information.
 	DisplayName string `json:"displayName,omitempty"`
 	// A system-generated and immutable identifier assigned to the workspace upon creation.
@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -134,6 +135,13 @@ func (in *ApplicationDetailedDescriptionSpec) DeepCopy() *ApplicationDetailedDes
 func (in *ApplicationDetailedDescriptionStatus) DeepCopyInto(out *ApplicationDetailedDescriptionStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationDetailedDescriptionStatus.
@@ -370,6 +378,13 @@ func (in *ApplicationSpec) DeepCopy() *ApplicationSpec {
 func (in *ApplicationStatus) DeepCopyInto(out *ApplicationStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.ParentRef = in.ParentRef
 	if in.DependentObjectMetadata != nil {
 		in, out := &in.DependentObjectMetadata, &out.DependentObjectMetadata
@@ -935,6 +950,13 @@ func (in *ConnectionSpec) DeepCopy() *ConnectionSpec {
 func (in *ConnectionStatus) DeepCopyInto(out *ConnectionStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.ParentRef = in.ParentRef
 	in.PrimarySchema.DeepCopyInto(&out.PrimarySchema)
 	if in.ConnectionProperties != nil {
@@ -3614,6 +3636,13 @@ func (in *ConnectionValidationSpec) DeepCopy() *ConnectionValidationSpec {
 func (in *ConnectionValidationStatus) DeepCopyInto(out *ConnectionValidationStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.ValidationMessage = in.ValidationMessage
 	out.ParentRef = in.ParentRef
 	in.Metadata.DeepCopyInto(&out.Metadata)
@@ -3773,6 +3802,13 @@ func (in *CopyObjectRequestSpec) DeepCopy() *CopyObjectRequestSpec {
 func (in *CopyObjectRequestStatus) DeepCopyInto(out *CopyObjectRequestStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ObjectKeys != nil {
 		in, out := &in.ObjectKeys, &out.ObjectKeys
 		*out = make([]string, len(*in))
@@ -6998,6 +7034,13 @@ func (in *DataAssetStagingDataAssetWalletSecretSecretConfig) DeepCopy() *DataAss
 func (in *DataAssetStatus) DeepCopyInto(out *DataAssetStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.AssetProperties != nil {
 		in, out := &in.AssetProperties, &out.AssetProperties
 		*out = make(map[string]string, len(*in))
@@ -7230,6 +7273,13 @@ func (in *DataFlowSpec) DeepCopy() *DataFlowSpec {
 func (in *DataFlowStatus) DeepCopyInto(out *DataFlowStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.ParentRef = in.ParentRef
 	if in.Nodes != nil {
 		in, out := &in.Nodes, &out.Nodes
@@ -7490,6 +7540,13 @@ func (in *DataFlowValidationSpec) DeepCopy() *DataFlowValidationSpec {
 func (in *DataFlowValidationStatus) DeepCopyInto(out *DataFlowValidationStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ValidationMessages != nil {
 		in, out := &in.ValidationMessages, &out.ValidationMessages
 		*out = make(map[string][]DataFlowValidationValidationMessages, len(*in))
@@ -7655,6 +7712,13 @@ func (in *DisApplicationDetailedDescriptionSpec) DeepCopy() *DisApplicationDetai
 func (in *DisApplicationDetailedDescriptionStatus) DeepCopyInto(out *DisApplicationDetailedDescriptionStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DisApplicationDetailedDescriptionStatus.
@@ -7891,6 +7955,13 @@ func (in *DisApplicationSpec) DeepCopy() *DisApplicationSpec {
 func (in *DisApplicationStatus) DeepCopyInto(out *DisApplicationStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.ParentRef = in.ParentRef
 	if in.DependentObjectMetadata != nil {
 		in, out := &in.DependentObjectMetadata, &out.DependentObjectMetadata
@@ -8053,6 +8124,13 @@ func (in *ExportRequestSpec) DeepCopy() *ExportRequestSpec {
 func (in *ExportRequestStatus) DeepCopyInto(out *ExportRequestStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ObjectKeys != nil {
 		in, out := &in.ObjectKeys, &out.ObjectKeys
 		*out = make([]string, len(*in))
@@ -10415,6 +10493,13 @@ func (in *ExternalPublicationSpec) DeepCopy() *ExternalPublicationSpec {
 func (in *ExternalPublicationStatus) DeepCopyInto(out *ExternalPublicationStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.ResourceConfiguration = in.ResourceConfiguration
 	in.ConfigurationDetails.DeepCopyInto(&out.ConfigurationDetails)
 	out.ParentRef = in.ParentRef
@@ -10516,6 +10601,13 @@ func (in *ExternalPublicationValidationSpec) DeepCopy() *ExternalPublicationVali
 func (in *ExternalPublicationValidationStatus) DeepCopyInto(out *ExternalPublicationValidationStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ValidationMessages != nil {
 		in, out := &in.ValidationMessages, &out.ValidationMessages
 		*out = make(map[string][]ExternalPublicationValidationValidationMessages, len(*in))
@@ -10753,6 +10845,13 @@ func (in *FolderSpec) DeepCopy() *FolderSpec {
 func (in *FolderStatus) DeepCopyInto(out *FolderStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.ParentRef = in.ParentRef
 	in.Metadata.DeepCopyInto(&out.Metadata)
 	if in.KeyMap != nil {
@@ -10967,6 +11066,13 @@ func (in *FunctionLibrarySpec) DeepCopy() *FunctionLibrarySpec {
 func (in *FunctionLibraryStatus) DeepCopyInto(out *FunctionLibraryStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.ParentRef = in.ParentRef
 	in.Metadata.DeepCopyInto(&out.Metadata)
 	if in.KeyMap != nil {
@@ -11097,6 +11203,13 @@ func (in *ImportRequestSpec) DeepCopy() *ImportRequestSpec {
 func (in *ImportRequestStatus) DeepCopyInto(out *ImportRequestStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.ImportConflictResolution = in.ImportConflictResolution
 	if in.ErrorMessages != nil {
 		in, out := &in.ErrorMessages, &out.ErrorMessages
@@ -11350,6 +11463,13 @@ func (in *PatchSpec) DeepCopy() *PatchSpec {
 func (in *PatchStatus) DeepCopyInto(out *PatchStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ErrorMessages != nil {
 		in, out := &in.ErrorMessages, &out.ErrorMessages
 		*out = make(map[string]string, len(*in))
@@ -11525,6 +11645,13 @@ func (in *PipelineSpec) DeepCopy() *PipelineSpec {
 func (in *PipelineStatus) DeepCopyInto(out *PipelineStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.ParentRef = in.ParentRef
 	if in.Nodes != nil {
 		in, out := &in.Nodes, &out.Nodes
@@ -11757,6 +11884,13 @@ func (in *PipelineValidationSpec) DeepCopy() *PipelineValidationSpec {
 func (in *PipelineValidationStatus) DeepCopyInto(out *PipelineValidationStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ValidationMessages != nil {
 		in, out := &in.ValidationMessages, &out.ValidationMessages
 		*out = make(map[string][]PipelineValidationValidationMessages, len(*in))
@@ -11996,6 +12130,13 @@ func (in *ProjectSpec) DeepCopy() *ProjectSpec {
 func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.ParentRef = in.ParentRef
 	in.Metadata.DeepCopyInto(&out.Metadata)
 	if in.KeyMap != nil {
@@ -12248,6 +12389,13 @@ func (in *ScheduleSpec) DeepCopy() *ScheduleSpec {
 func (in *ScheduleStatus) DeepCopyInto(out *ScheduleStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.ParentRef = in.ParentRef
 	in.FrequencyDetails.DeepCopyInto(&out.FrequencyDetails)
 	in.Metadata.DeepCopyInto(&out.Metadata)
@@ -12604,6 +12752,13 @@ func (in *TaskRunSpec) DeepCopy() *TaskRunSpec {
 func (in *TaskRunStatus) DeepCopyInto(out *TaskRunStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.ParentRef = in.ParentRef
 	in.ConfigProvider.DeepCopyInto(&out.ConfigProvider)
 	in.TaskSchedule.DeepCopyInto(&out.TaskSchedule)
@@ -13404,6 +13559,13 @@ func (in *TaskScheduleSpec) DeepCopy() *TaskScheduleSpec {
 func (in *TaskScheduleStatus) DeepCopyInto(out *TaskScheduleStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.ParentRef = in.ParentRef
 	in.ScheduleRef.DeepCopyInto(&out.ScheduleRef)
 	in.ConfigProviderDelegate.DeepCopyInto(&out.ConfigProviderDelegate)
@@ -13488,6 +13650,13 @@ func (in *TaskSpec) DeepCopy() *TaskSpec {
 func (in *TaskStatus) DeepCopyInto(out *TaskStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.ParentRef = in.ParentRef
 	if in.InputPorts != nil {
 		in, out := &in.InputPorts, &out.InputPorts
@@ -16527,6 +16696,13 @@ func (in *TaskValidationSpec) DeepCopy() *TaskValidationSpec {
 func (in *TaskValidationStatus) DeepCopyInto(out *TaskValidationStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ValidationMessages != nil {
 		in, out := &in.ValidationMessages, &out.ValidationMessages
 		*out = make(map[string][]TaskValidationValidationMessages, len(*in))
@@ -18557,6 +18733,13 @@ func (in *UserDefinedFunctionSpec) DeepCopy() *UserDefinedFunctionSpec {
 func (in *UserDefinedFunctionStatus) DeepCopyInto(out *UserDefinedFunctionStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.ParentRef = in.ParentRef
 	if in.Signatures != nil {
 		in, out := &in.Signatures, &out.Signatures
@@ -20558,6 +20741,13 @@ func (in *UserDefinedFunctionValidationSpec) DeepCopy() *UserDefinedFunctionVali
 func (in *UserDefinedFunctionValidationStatus) DeepCopyInto(out *UserDefinedFunctionValidationStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ValidationMessages != nil {
 		in, out := &in.ValidationMessages, &out.ValidationMessages
 		*out = make(map[string][]UserDefinedFunctionValidationValidationMessages, len(*in))
@@ -20713,6 +20903,13 @@ func (in *WorkspaceSpec) DeepCopy() *WorkspaceSpec {
 func (in *WorkspaceStatus) DeepCopyInto(out *WorkspaceStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
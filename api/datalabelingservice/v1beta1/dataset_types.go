@@ -158,6 +158,13 @@ type DatasetInitialImportDatasetConfiguration struct {
 // DatasetStatus defines the observed state of Dataset.
 type DatasetStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the Dataset.
 	Id string `json:"id,omitempty"`
 	// The OCID of the compartment of the resource.
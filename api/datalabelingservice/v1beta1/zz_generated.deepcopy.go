@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -352,6 +353,13 @@ func (in *DatasetSpec) DeepCopy() *DatasetSpec {
 func (in *DatasetStatus) DeepCopyInto(out *DatasetStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.DatasetSourceDetails = in.DatasetSourceDetails
 	out.DatasetFormatDetails = in.DatasetFormatDetails
 	in.LabelSet.DeepCopyInto(&out.LabelSet)
@@ -110,6 +110,13 @@ type AnnotationEntity struct {
 // AnnotationStatus defines the observed state of Annotation.
 type AnnotationStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the annotation.
 	Id string `json:"id,omitempty"`
 	// The date and time the annotation was created, in the timestamp format defined by RFC3339.
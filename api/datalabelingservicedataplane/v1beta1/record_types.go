@@ -77,6 +77,13 @@ type RecordMetadata struct {
 // RecordStatus defines the observed state of Record.
 type RecordStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the record.
 	Id string `json:"id,omitempty"`
 	// The name is created by the user. It is unique and immutable.
@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -234,6 +235,13 @@ func (in *AnnotationSpec) DeepCopy() *AnnotationSpec {
 func (in *AnnotationStatus) DeepCopyInto(out *AnnotationStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Entities != nil {
 		in, out := &in.Entities, &out.Entities
 		*out = make([]AnnotationEntity, len(*in))
@@ -413,6 +421,13 @@ func (in *RecordSpec) DeepCopy() *RecordSpec {
 func (in *RecordStatus) DeepCopyInto(out *RecordStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.SourceDetails = in.SourceDetails
 	out.RecordMetadata = in.RecordMetadata
 	if in.FreeformTags != nil {
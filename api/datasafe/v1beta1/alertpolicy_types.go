@@ -58,6 +58,13 @@ type AlertPolicyRuleDetail struct {
 // AlertPolicyStatus defines the observed state of AlertPolicy.
 type AlertPolicyStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the alert policy.
 	Id string `json:"id,omitempty"`
 	// The display name of the alert policy.
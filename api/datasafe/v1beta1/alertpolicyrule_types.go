@@ -28,6 +28,13 @@ type AlertPolicyRuleSpec struct {
 // AlertPolicyRuleStatus defines the observed state of AlertPolicyRule.
 type AlertPolicyRuleStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The unique key of the alert policy rule.
 	Key string `json:"key,omitempty"`
 	// The conditional expression of the alert policy rule which evaluates to boolean value.
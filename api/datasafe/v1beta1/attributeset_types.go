@@ -42,6 +42,13 @@ type AttributeSetSpec struct {
 // AttributeSetStatus defines the observed state of AttributeSet.
 type AttributeSetStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of an attribute set.
 	Id string `json:"id,omitempty"`
 	// The OCID of the compartment where the attribute set is stored.
@@ -45,6 +45,13 @@ type AuditArchiveRetrievalSpec struct {
 // AuditArchiveRetrievalStatus defines the observed state of AuditArchiveRetrieval.
 type AuditArchiveRetrievalStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the archive retrieval.
 	Id string `json:"id,omitempty"`
 	// The OCID of the compartment that contains archive retrieval.
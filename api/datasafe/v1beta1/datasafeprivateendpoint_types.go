@@ -48,6 +48,13 @@ type DataSafePrivateEndpointSpec struct {
 // DataSafePrivateEndpointStatus defines the observed state of DataSafePrivateEndpoint.
 type DataSafePrivateEndpointStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the Data Safe private endpoint.
 	Id string `json:"id,omitempty"`
 	// The display name of the private endpoint.
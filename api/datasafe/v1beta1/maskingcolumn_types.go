@@ -195,6 +195,13 @@ type MaskingColumnMaskingFormat struct {
 // MaskingColumnStatus defines the observed state of MaskingColumn.
 type MaskingColumnStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The unique key that identifies the masking column. It's numeric and unique within a masking policy.
 	Key string `json:"key,omitempty"`
 	// The OCID of the masking policy that contains the masking column.
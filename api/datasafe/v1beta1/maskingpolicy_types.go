@@ -90,6 +90,13 @@ type MaskingPolicyColumnSource struct {
 // MaskingPolicyStatus defines the observed state of MaskingPolicy.
 type MaskingPolicyStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the masking policy.
 	Id string `json:"id,omitempty"`
 	// The OCID of the compartment that contains the masking policy.
@@ -36,6 +36,13 @@ type OnPremConnectorSpec struct {
 // OnPremConnectorStatus defines the observed state of OnPremConnector.
 type OnPremConnectorStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the on-premises connector.
 	Id string `json:"id,omitempty"`
 	// The display name of the on-premises connector.
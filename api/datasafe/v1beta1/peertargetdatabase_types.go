@@ -86,6 +86,13 @@ type PeerTargetDatabaseTlsConfig struct {
 // PeerTargetDatabaseStatus defines the observed state of PeerTargetDatabase.
 type PeerTargetDatabaseStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The display name of the peer target database in Data Safe.
 	DisplayName string `json:"displayName,omitempty"`
 	// The secondary key assigned for the peer target database in Data Safe.
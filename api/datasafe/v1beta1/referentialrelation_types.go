@@ -82,6 +82,13 @@ type ReferentialRelationChild struct {
 // ReferentialRelationStatus defines the observed state of ReferentialRelation.
 type ReferentialRelationStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The unique key that identifies the referential relation. It's numeric and unique within a sensitive data model.
 	Key string `json:"key,omitempty"`
 	// The current state of the referential relation.
@@ -130,6 +130,13 @@ type ReportDefinitionSummary struct {
 // ReportDefinitionStatus defines the observed state of ReportDefinition.
 type ReportDefinitionStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Name of the report definition.
 	DisplayName string `json:"displayName,omitempty"`
 	// The OCID of the report definition.
@@ -44,6 +44,13 @@ type SdmMaskingPolicyDifferenceSpec struct {
 // SdmMaskingPolicyDifferenceStatus defines the observed state of SdmMaskingPolicyDifference.
 type SdmMaskingPolicyDifferenceStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the Sensitive data model and masking policy difference resource.
 	Id string `json:"id,omitempty"`
 	// The OCID of the compartment that contains the Sensitive data model and masking policy difference resource.
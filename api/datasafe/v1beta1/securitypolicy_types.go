@@ -36,6 +36,13 @@ type SecurityPolicySpec struct {
 // SecurityPolicyStatus defines the observed state of SecurityPolicy.
 type SecurityPolicyStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the security policy.
 	Id string `json:"id,omitempty"`
 	// The OCID of the compartment containing the security policy.
@@ -45,6 +45,13 @@ type SecurityPolicyDeploymentSpec struct {
 // SecurityPolicyDeploymentStatus defines the observed state of SecurityPolicyDeployment.
 type SecurityPolicyDeploymentStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the security policy deployment.
 	Id string `json:"id,omitempty"`
 	// The OCID of the compartment containing the security policy deployment.
@@ -71,6 +71,13 @@ type SensitiveColumnSpec struct {
 // SensitiveColumnStatus defines the observed state of SensitiveColumn.
 type SensitiveColumnStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The unique key that identifies the sensitive column. It's numeric and unique within a sensitive data model.
 	Key string `json:"key,omitempty"`
 	// The OCID of the sensitive data model that contains the sensitive column.
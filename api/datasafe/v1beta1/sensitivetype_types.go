@@ -62,7 +62,14 @@ type SensitiveTypeSpec struct {
 // SensitiveTypeStatus defines the observed state of SensitiveType.
 type SensitiveTypeStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
-	JsonData   string            `json:"jsonData,omitempty"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+	JsonData           string `json:"jsonData,omitempty"`
 	// The short name of the sensitive type.
 	ShortName string `json:"shortName,omitempty"`
 	// The description of the sensitive type.
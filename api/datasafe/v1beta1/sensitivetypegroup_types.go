@@ -36,6 +36,13 @@ type SensitiveTypeGroupSpec struct {
 // SensitiveTypeGroupStatus defines the observed state of SensitiveTypeGroup.
 type SensitiveTypeGroupStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the sensitive type group.
 	Id string `json:"id,omitempty"`
 	// The display name of the sensitive type group.
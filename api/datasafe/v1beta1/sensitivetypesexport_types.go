@@ -43,6 +43,13 @@ type SensitiveTypesExportSpec struct {
 // SensitiveTypesExportStatus defines the observed state of SensitiveTypesExport.
 type SensitiveTypesExportStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the sensitive types export.
 	Id string `json:"id,omitempty"`
 	// The display name of the sensitive types export.
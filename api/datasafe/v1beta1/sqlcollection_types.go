@@ -50,6 +50,13 @@ type SqlCollectionSpec struct {
 // SqlCollectionStatus defines the observed state of SqlCollection.
 type SqlCollectionStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the SQL collection.
 	Id string `json:"id,omitempty"`
 	// The OCID of the compartment containing the SQL collection.
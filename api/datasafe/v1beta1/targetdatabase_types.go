@@ -264,6 +264,13 @@ type TargetDatabasePeerTargetDatabase struct {
 // TargetDatabaseStatus defines the observed state of TargetDatabase.
 type TargetDatabaseStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the compartment which contains the Data Safe target database.
 	CompartmentId string `json:"compartmentId,omitempty"`
 	// The OCID of the Data Safe target database.
@@ -81,6 +81,13 @@ type TargetDatabaseGroupMatchingCriteria struct {
 // TargetDatabaseGroupStatus defines the observed state of TargetDatabaseGroup.
 type TargetDatabaseGroupStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID for the compartment containing the target database group.
 	CompartmentId string `json:"compartmentId,omitempty"`
 	// The OCID of the specified target database group.
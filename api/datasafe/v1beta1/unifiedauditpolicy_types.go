@@ -71,6 +71,13 @@ type UnifiedAuditPolicyCondition struct {
 // UnifiedAuditPolicyStatus defines the observed state of UnifiedAuditPolicy.
 type UnifiedAuditPolicyStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the unified audit policy.
 	Id string `json:"id,omitempty"`
 	// The OCID of the compartment containing the unified audit policy.
@@ -97,7 +104,8 @@ type UnifiedAuditPolicyStatus struct {
 	// Indicates on whom the audit policy is enabled.
 	EnabledEntities string `json:"enabledEntities,omitempty"`
 	// Lists the audit policy provisioning conditions.
-	Conditions []UnifiedAuditPolicyCondition `json:"conditions,omitempty"`
+	// This uses a distinct JSON name so it can coexist with the OSOK status envelope.
+	SdkConditions []UnifiedAuditPolicyCondition `json:"sdkConditions,omitempty"`
 	// The last date and time the unified audit policy was updated, in the format defined by RFC3339.
 	TimeUpdated string `json:"timeUpdated,omitempty"`
 	// Free-form tags for this resource. Each tag is a simple key-value pair with no predefined name, type, or namespace. For more information, see Resource Tags (https://docs.oracle.com/iaas/Content/General/Concepts/resourcetags.htm)
@@ -62,6 +62,13 @@ type UserAssessmentSpec struct {
 // UserAssessmentStatus defines the observed state of UserAssessment.
 type UserAssessmentStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the compartment that contains the user assessment.
 	CompartmentId string `json:"compartmentId,omitempty"`
 	// The display name of the user assessment.
@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -166,6 +167,13 @@ func (in *AlertPolicyRuleSpec) DeepCopy() *AlertPolicyRuleSpec {
 func (in *AlertPolicyRuleStatus) DeepCopyInto(out *AlertPolicyRuleStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertPolicyRuleStatus.
@@ -227,6 +235,13 @@ func (in *AlertPolicySpec) DeepCopy() *AlertPolicySpec {
 func (in *AlertPolicyStatus) DeepCopyInto(out *AlertPolicyStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -390,6 +405,13 @@ func (in *AttributeSetSpec) DeepCopy() *AttributeSetSpec {
 func (in *AttributeSetStatus) DeepCopyInto(out *AttributeSetStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.AttributeSetValues != nil {
 		in, out := &in.AttributeSetValues, &out.AttributeSetValues
 		*out = make([]string, len(*in))
@@ -553,6 +575,13 @@ func (in *AuditArchiveRetrievalSpec) DeepCopy() *AuditArchiveRetrievalSpec {
 func (in *AuditArchiveRetrievalStatus) DeepCopyInto(out *AuditArchiveRetrievalStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -769,6 +798,13 @@ func (in *AuditProfileSpec) DeepCopy() *AuditProfileSpec {
 func (in *AuditProfileStatus) DeepCopyInto(out *AuditProfileStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.AuditTrails != nil {
 		in, out := &in.AuditTrails, &out.AuditTrails
 		*out = make([]AuditProfileAuditTrail, len(*in))
@@ -939,6 +975,13 @@ func (in *DataSafePrivateEndpointSpec) DeepCopy() *DataSafePrivateEndpointSpec {
 func (in *DataSafePrivateEndpointStatus) DeepCopyInto(out *DataSafePrivateEndpointStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.NsgIds != nil {
 		in, out := &in.NsgIds, &out.NsgIds
 		*out = make([]string, len(*in))
@@ -1124,6 +1167,13 @@ func (in *DiscoveryJobSpec) DeepCopy() *DiscoveryJobSpec {
 func (in *DiscoveryJobStatus) DeepCopyInto(out *DiscoveryJobStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.SchemasForDiscovery != nil {
 		in, out := &in.SchemasForDiscovery, &out.SchemasForDiscovery
 		*out = make([]string, len(*in))
@@ -1361,6 +1411,13 @@ func (in *LibraryMaskingFormatSpec) DeepCopy() *LibraryMaskingFormatSpec {
 func (in *LibraryMaskingFormatStatus) DeepCopyInto(out *LibraryMaskingFormatStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.SensitiveTypeIds != nil {
 		in, out := &in.SensitiveTypeIds, &out.SensitiveTypeIds
 		*out = make([]string, len(*in))
@@ -1542,6 +1599,13 @@ func (in *MaskingColumnSpec) DeepCopy() *MaskingColumnSpec {
 func (in *MaskingColumnStatus) DeepCopyInto(out *MaskingColumnStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ChildColumns != nil {
 		in, out := &in.ChildColumns, &out.ChildColumns
 		*out = make([]string, len(*in))
@@ -1685,6 +1749,13 @@ func (in *MaskingPolicySpec) DeepCopy() *MaskingPolicySpec {
 func (in *MaskingPolicyStatus) DeepCopyInto(out *MaskingPolicyStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.ColumnSource = in.ColumnSource
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
@@ -1826,6 +1897,13 @@ func (in *OnPremConnectorSpec) DeepCopy() *OnPremConnectorSpec {
 func (in *OnPremConnectorStatus) DeepCopyInto(out *OnPremConnectorStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -1981,6 +2059,13 @@ func (in *PeerTargetDatabaseSpec) DeepCopy() *PeerTargetDatabaseSpec {
 func (in *PeerTargetDatabaseStatus) DeepCopyInto(out *PeerTargetDatabaseStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.DatabaseDetails.DeepCopyInto(&out.DatabaseDetails)
 	out.TlsConfig = in.TlsConfig
 }
@@ -2140,6 +2225,13 @@ func (in *ReferentialRelationSpec) DeepCopy() *ReferentialRelationSpec {
 func (in *ReferentialRelationStatus) DeepCopyInto(out *ReferentialRelationStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.Parent.DeepCopyInto(&out.Parent)
 	in.Child.DeepCopyInto(&out.Child)
 }
@@ -2336,6 +2428,13 @@ func (in *ReportDefinitionSpec) DeepCopy() *ReportDefinitionSpec {
 func (in *ReportDefinitionStatus) DeepCopyInto(out *ReportDefinitionStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ColumnInfo != nil {
 		in, out := &in.ColumnInfo, &out.ColumnInfo
 		*out = make([]ReportDefinitionColumnInfo, len(*in))
@@ -2538,6 +2637,13 @@ func (in *SdmMaskingPolicyDifferenceSpec) DeepCopy() *SdmMaskingPolicyDifference
 func (in *SdmMaskingPolicyDifferenceStatus) DeepCopyInto(out *SdmMaskingPolicyDifferenceStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -2854,6 +2960,13 @@ func (in *SecurityAssessmentStatisticsPass) DeepCopy() *SecurityAssessmentStatis
 func (in *SecurityAssessmentStatus) DeepCopyInto(out *SecurityAssessmentStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.TargetIds != nil {
 		in, out := &in.TargetIds, &out.TargetIds
 		*out = make([]string, len(*in))
@@ -3088,6 +3201,13 @@ func (in *SecurityPolicyConfigSpec) DeepCopy() *SecurityPolicyConfigSpec {
 func (in *SecurityPolicyConfigStatus) DeepCopyInto(out *SecurityPolicyConfigStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.FirewallConfig = in.FirewallConfig
 	out.UnifiedAuditPolicyConfig = in.UnifiedAuditPolicyConfig
 	if in.FreeformTags != nil {
@@ -3263,6 +3383,13 @@ func (in *SecurityPolicyDeploymentSpec) DeepCopy() *SecurityPolicyDeploymentSpec
 func (in *SecurityPolicyDeploymentStatus) DeepCopyInto(out *SecurityPolicyDeploymentStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -3394,6 +3521,13 @@ func (in *SecurityPolicySpec) DeepCopy() *SecurityPolicySpec {
 func (in *SecurityPolicyStatus) DeepCopyInto(out *SecurityPolicyStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -3542,6 +3676,13 @@ func (in *SensitiveColumnSpec) DeepCopy() *SensitiveColumnSpec {
 func (in *SensitiveColumnStatus) DeepCopyInto(out *SensitiveColumnStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ParentColumnKeys != nil {
 		in, out := &in.ParentColumnKeys, &out.ParentColumnKeys
 		*out = make([]string, len(*in))
@@ -3711,6 +3852,13 @@ func (in *SensitiveDataModelSpec) DeepCopy() *SensitiveDataModelSpec {
 func (in *SensitiveDataModelStatus) DeepCopyInto(out *SensitiveDataModelStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.SchemasForDiscovery != nil {
 		in, out := &in.SchemasForDiscovery, &out.SchemasForDiscovery
 		*out = make([]string, len(*in))
@@ -3938,6 +4086,13 @@ func (in *SensitiveTypeGroupSpec) DeepCopy() *SensitiveTypeGroupSpec {
 func (in *SensitiveTypeGroupStatus) DeepCopyInto(out *SensitiveTypeGroupStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -4069,6 +4224,13 @@ func (in *SensitiveTypeSpec) DeepCopy() *SensitiveTypeSpec {
 func (in *SensitiveTypeStatus) DeepCopyInto(out *SensitiveTypeStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -4232,6 +4394,13 @@ func (in *SensitiveTypesExportSpec) DeepCopy() *SensitiveTypesExportSpec {
 func (in *SensitiveTypesExportStatus) DeepCopyInto(out *SensitiveTypesExportStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.SensitiveTypeIdsForExport != nil {
 		in, out := &in.SensitiveTypeIdsForExport, &out.SensitiveTypeIdsForExport
 		*out = make([]string, len(*in))
@@ -4395,6 +4564,13 @@ func (in *SqlCollectionSpec) DeepCopy() *SqlCollectionSpec {
 func (in *SqlCollectionStatus) DeepCopyInto(out *SqlCollectionStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -4553,6 +4729,13 @@ func (in *TargetAlertPolicyAssociationSpec) DeepCopy() *TargetAlertPolicyAssocia
 func (in *TargetAlertPolicyAssociationStatus) DeepCopyInto(out *TargetAlertPolicyAssociationStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -4906,6 +5089,13 @@ func (in *TargetDatabaseGroupSpec) DeepCopy() *TargetDatabaseGroupSpec {
 func (in *TargetDatabaseGroupStatus) DeepCopyInto(out *TargetDatabaseGroupStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.MatchingCriteria.DeepCopyInto(&out.MatchingCriteria)
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
@@ -5153,6 +5343,13 @@ func (in *TargetDatabaseSpec) DeepCopy() *TargetDatabaseSpec {
 func (in *TargetDatabaseStatus) DeepCopyInto(out *TargetDatabaseStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.DatabaseDetails.DeepCopyInto(&out.DatabaseDetails)
 	out.Credentials = in.Credentials
 	out.TlsConfig = in.TlsConfig
@@ -5376,6 +5573,13 @@ func (in *UnifiedAuditPolicyStatus) DeepCopyInto(out *UnifiedAuditPolicyStatus)
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SdkConditions != nil {
+		in, out := &in.SdkConditions, &out.SdkConditions
 		*out = make([]UnifiedAuditPolicyCondition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
@@ -5539,6 +5743,13 @@ func (in *UserAssessmentSpec) DeepCopy() *UserAssessmentSpec {
 func (in *UserAssessmentStatus) DeepCopyInto(out *UserAssessmentStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.IgnoredTargets != nil {
 		in, out := &in.IgnoredTargets, &out.IgnoredTargets
 		*out = make([]shared.JSONValue, len(*in))
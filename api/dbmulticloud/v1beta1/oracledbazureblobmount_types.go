@@ -41,6 +41,13 @@ type OracleDbAzureBlobMountSpec struct {
 // OracleDbAzureBlobMountStatus defines the observed state of OracleDbAzureBlobMount.
 type OracleDbAzureBlobMountStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the Oracle DB Azure Blob Mount resource.
 	Id string `json:"id,omitempty"`
 	// Oracle DB Azure Blob Mount resource name.
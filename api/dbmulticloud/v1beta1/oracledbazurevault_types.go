@@ -53,6 +53,13 @@ type OracleDbAzureVaultSpec struct {
 // OracleDbAzureVaultStatus defines the observed state of OracleDbAzureVault.
 type OracleDbAzureVaultStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the oracle DB Azure Vault resource.
 	Id string `json:"id,omitempty"`
 	// Oracle DB Azure Vault resource name.
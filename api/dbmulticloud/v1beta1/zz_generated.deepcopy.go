@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -146,6 +147,13 @@ func (in *MultiCloudResourceDiscoverySpec) DeepCopy() *MultiCloudResourceDiscove
 func (in *MultiCloudResourceDiscoveryStatus) DeepCopyInto(out *MultiCloudResourceDiscoveryStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ResourcesFilter != nil {
 		in, out := &in.ResourcesFilter, &out.ResourcesFilter
 		*out = make(map[string]string, len(*in))
@@ -353,6 +361,13 @@ func (in *OracleDbAwsIdentityConnectorSpec) DeepCopy() *OracleDbAwsIdentityConne
 func (in *OracleDbAwsIdentityConnectorStatus) DeepCopyInto(out *OracleDbAwsIdentityConnectorStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ServiceRoleDetails != nil {
 		in, out := &in.ServiceRoleDetails, &out.ServiceRoleDetails
 		*out = make([]OracleDbAwsIdentityConnectorServiceRoleDetail, len(*in))
@@ -523,6 +538,13 @@ func (in *OracleDbAwsKeySpec) DeepCopy() *OracleDbAwsKeySpec {
 func (in *OracleDbAwsKeyStatus) DeepCopyInto(out *OracleDbAwsKeyStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Properties != nil {
 		in, out := &in.Properties, &out.Properties
 		*out = make(map[string]string, len(*in))
@@ -688,6 +710,13 @@ func (in *OracleDbAzureBlobContainerSpec) DeepCopy() *OracleDbAzureBlobContainer
 func (in *OracleDbAzureBlobContainerStatus) DeepCopyInto(out *OracleDbAzureBlobContainerStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -846,6 +875,13 @@ func (in *OracleDbAzureBlobMountSpec) DeepCopy() *OracleDbAzureBlobMountSpec {
 func (in *OracleDbAzureBlobMountStatus) DeepCopyInto(out *OracleDbAzureBlobMountStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -1019,6 +1055,13 @@ func (in *OracleDbAzureConnectorSpec) DeepCopy() *OracleDbAzureConnectorSpec {
 func (in *OracleDbAzureConnectorStatus) DeepCopyInto(out *OracleDbAzureConnectorStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ArcAgentNodes != nil {
 		in, out := &in.ArcAgentNodes, &out.ArcAgentNodes
 		*out = make([]OracleDbAzureConnectorArcAgentNode, len(*in))
@@ -1209,6 +1252,13 @@ func (in *OracleDbAzureVaultAssociationSpec) DeepCopy() *OracleDbAzureVaultAssoc
 func (in *OracleDbAzureVaultAssociationStatus) DeepCopyInto(out *OracleDbAzureVaultAssociationStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -1347,6 +1397,13 @@ func (in *OracleDbAzureVaultSpec) DeepCopy() *OracleDbAzureVaultSpec {
 func (in *OracleDbAzureVaultStatus) DeepCopyInto(out *OracleDbAzureVaultStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Properties != nil {
 		in, out := &in.Properties, &out.Properties
 		*out = make(map[string]string, len(*in))
@@ -1527,6 +1584,13 @@ func (in *OracleDbGcpIdentityConnectorSpec) DeepCopy() *OracleDbGcpIdentityConne
 func (in *OracleDbGcpIdentityConnectorStatus) DeepCopyInto(out *OracleDbGcpIdentityConnectorStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.GcpNodes != nil {
 		in, out := &in.GcpNodes, &out.GcpNodes
 		*out = make([]OracleDbGcpIdentityConnectorGcpNode, len(*in))
@@ -1697,6 +1761,13 @@ func (in *OracleDbGcpKeyRingSpec) DeepCopy() *OracleDbGcpKeyRingSpec {
 func (in *OracleDbGcpKeyRingStatus) DeepCopyInto(out *OracleDbGcpKeyRingStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Properties != nil {
 		in, out := &in.Properties, &out.Properties
 		*out = make(map[string]string, len(*in))
@@ -69,6 +69,13 @@ type DelegationControlSpec struct {
 // DelegationControlStatus defines the observed state of DelegationControl.
 type DelegationControlStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the Delegation Control.
 	Id string `json:"id,omitempty"`
 	// The OCID of the compartment that contains the Delegation Control.
@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -132,6 +133,13 @@ func (in *DelegationControlSpec) DeepCopy() *DelegationControlSpec {
 func (in *DelegationControlStatus) DeepCopyInto(out *DelegationControlStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.PreApprovedServiceProviderActionNames != nil {
 		in, out := &in.PreApprovedServiceProviderActionNames, &out.PreApprovedServiceProviderActionNames
 		*out = make([]string, len(*in))
@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -144,6 +145,13 @@ func (in *OccDemandSignalSpec) DeepCopy() *OccDemandSignalSpec {
 func (in *OccDemandSignalStatus) DeepCopyInto(out *OccDemandSignalStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.OccDemandSignals != nil {
 		in, out := &in.OccDemandSignals, &out.OccDemandSignals
 		*out = make([]OccDemandSignalFields, len(*in))
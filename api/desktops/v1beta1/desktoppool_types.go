@@ -252,6 +252,13 @@ type DesktopPoolPrivateAccessDetails struct {
 // DesktopPoolStatus defines the observed state of DesktopPool.
 type DesktopPoolStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the desktop pool.
 	Id string `json:"id,omitempty"`
 	// The OCID of the compartment of the desktop pool.
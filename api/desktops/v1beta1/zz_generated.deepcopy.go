@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -303,6 +304,13 @@ func (in *DesktopPoolSpec) DeepCopy() *DesktopPoolSpec {
 func (in *DesktopPoolStatus) DeepCopyInto(out *DesktopPoolStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.DevicePolicy = in.DevicePolicy
 	out.AvailabilityPolicy = in.AvailabilityPolicy
 	out.Image = in.Image
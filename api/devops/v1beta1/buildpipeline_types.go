@@ -57,6 +57,13 @@ type BuildPipelineParameters struct {
 // BuildPipelineStatus defines the observed state of BuildPipeline.
 type BuildPipelineStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Unique identifier that is immutable on creation.
 	Id string `json:"id,omitempty"`
 	// The OCID of the compartment where the build pipeline is created.
@@ -75,6 +75,13 @@ type RepositoryMirrorRepositoryConfig struct {
 // RepositoryStatus defines the observed state of Repository.
 type RepositoryStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the repository. This value is unique and immutable.
 	Id string `json:"id,omitempty"`
 	// The OCID of the repository's compartment.
@@ -44,7 +44,14 @@ type TriggerSpec struct {
 // TriggerStatus defines the observed state of Trigger.
 type TriggerStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
-	JsonData   string            `json:"jsonData,omitempty"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+	JsonData           string `json:"jsonData,omitempty"`
 	// Trigger display name. Avoid entering confidential information.
 	DisplayName string `json:"displayName,omitempty"`
 	// Description about the trigger.
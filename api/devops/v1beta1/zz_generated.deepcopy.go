@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -153,6 +154,13 @@ func (in *BuildPipelineSpec) DeepCopy() *BuildPipelineSpec {
 func (in *BuildPipelineStatus) DeepCopyInto(out *BuildPipelineStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.BuildPipelineParameters.DeepCopyInto(&out.BuildPipelineParameters)
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
@@ -344,6 +352,13 @@ func (in *DeployArtifactSpec) DeepCopy() *DeployArtifactSpec {
 func (in *DeployArtifactStatus) DeepCopyInto(out *DeployArtifactStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.DeployArtifactSource = in.DeployArtifactSource
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
@@ -685,6 +700,13 @@ func (in *DeployPipelineSpec) DeepCopy() *DeployPipelineSpec {
 func (in *DeployPipelineStatus) DeepCopyInto(out *DeployPipelineStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.DeployPipelineParameters.DeepCopyInto(&out.DeployPipelineParameters)
 	in.DeployPipelineArtifacts.DeepCopyInto(&out.DeployPipelineArtifacts)
 	in.DeployPipelineEnvironments.DeepCopyInto(&out.DeployPipelineEnvironments)
@@ -862,6 +884,13 @@ func (in *ProjectSpec) DeepCopy() *ProjectSpec {
 func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.NotificationConfig = in.NotificationConfig
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
@@ -1053,6 +1082,13 @@ func (in *RepositorySpec) DeepCopy() *RepositorySpec {
 func (in *RepositoryStatus) DeepCopyInto(out *RepositoryStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.MirrorRepositoryConfig = in.MirrorRepositoryConfig
 	if in.TriggerBuildEvents != nil {
 		in, out := &in.TriggerBuildEvents, &out.TriggerBuildEvents
@@ -1217,6 +1253,13 @@ func (in *TriggerSpec) DeepCopy() *TriggerSpec {
 func (in *TriggerStatus) DeepCopyInto(out *TriggerStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
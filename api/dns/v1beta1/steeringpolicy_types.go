@@ -228,6 +228,13 @@ type SteeringPolicyRule struct {
 // SteeringPolicyStatus defines the observed state of SteeringPolicy.
 type SteeringPolicyStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the compartment containing the steering policy.
 	CompartmentId string `json:"compartmentId,omitempty"`
 	// A user-friendly name for the steering policy. Does not have to be unique and can be changed.
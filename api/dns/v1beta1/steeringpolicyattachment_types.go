@@ -33,6 +33,13 @@ type SteeringPolicyAttachmentSpec struct {
 // SteeringPolicyAttachmentStatus defines the observed state of SteeringPolicyAttachment.
 type SteeringPolicyAttachmentStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the attached steering policy.
 	SteeringPolicyId string `json:"steeringPolicyId,omitempty"`
 	// The OCID of the attached zone. Must be a public zone.
@@ -46,6 +46,13 @@ type TsigKeySpec struct {
 // TsigKeyStatus defines the observed state of TsigKey.
 type TsigKeyStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// TSIG key algorithms are encoded as domain names, but most consist of only one
 	// non-empty label, which is not required to be explicitly absolute.
 	// Applicable algorithms include: hmac-sha1, hmac-sha224, hmac-sha256,
@@ -37,6 +37,13 @@ type ViewSpec struct {
 // ViewStatus defines the observed state of View.
 type ViewStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the owning compartment.
 	CompartmentId string `json:"compartmentId,omitempty"`
 	// The display name of the view.
@@ -49,6 +49,13 @@ type DkimSpec struct {
 // DkimStatus defines the observed state of Dkim.
 type DkimStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The DKIM selector.
 	// If the same domain is managed in more than one region, each region must use different selectors.
 	Name string `json:"name,omitempty"`
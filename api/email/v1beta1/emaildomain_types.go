@@ -62,6 +62,13 @@ type EmailDomainLock struct {
 // EmailDomainStatus defines the observed state of EmailDomain.
 type EmailDomainStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The name of the email domain in the Internet Domain Name System (DNS).
 	// Example: `mydomain.example.com`
 	Name string `json:"name,omitempty"`
@@ -53,6 +53,13 @@ type SenderLock struct {
 // SenderStatus defines the observed state of Sender.
 type SenderStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID for the compartment.
 	CompartmentId string `json:"compartmentId,omitempty"`
 	// Email address of the sender.
@@ -27,6 +27,13 @@ type SuppressionSpec struct {
 // SuppressionStatus defines the observed state of Suppression.
 type SuppressionStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the compartment to contain the suppression. Since
 	// suppressions are at the customer level, this must be the tenancy
 	// OCID.
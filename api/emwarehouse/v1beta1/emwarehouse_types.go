@@ -39,6 +39,13 @@ type EmWarehouseSpec struct {
 // EmWarehouseStatus defines the observed state of EmWarehouse.
 type EmWarehouseStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// operations Insights Warehouse Identifier
 	OperationsInsightsWarehouseId string `json:"operationsInsightsWarehouseId,omitempty"`
 	// Unique identifier that is immutable on creation
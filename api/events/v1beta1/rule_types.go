@@ -97,6 +97,13 @@ type RuleActions struct {
 // RuleStatus defines the observed state of Rule.
 type RuleStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// A string that describes the rule. It does not have to be unique, and you can change it. Avoid entering
 	// confidential information.
 	// Example: `"This rule sends a notification upon completion of DbaaS backup."`
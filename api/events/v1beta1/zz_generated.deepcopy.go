@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -153,6 +154,13 @@ func (in *RuleSpec) DeepCopy() *RuleSpec {
 func (in *RuleStatus) DeepCopyInto(out *RuleStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.Actions.DeepCopyInto(&out.Actions)
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
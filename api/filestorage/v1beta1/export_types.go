@@ -126,6 +126,13 @@ type ExportLock struct {
 // ExportStatus defines the observed state of Export.
 type ExportStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Policies that apply to NFS requests made through this
 	// export. `exportOptions` contains a sequential list of
 	// `ClientOptions`. Each `ClientOptions` item defines the
@@ -92,6 +92,13 @@ type FileSystemSourceDetails struct {
 // FileSystemStatus defines the observed state of FileSystem.
 type FileSystemStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The number of bytes consumed by the file system, including
 	// any snapshots. This number reflects the metered size of the file
 	// system and is updated asynchronously with respect to
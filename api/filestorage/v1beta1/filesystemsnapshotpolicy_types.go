@@ -117,6 +117,13 @@ type FilesystemSnapshotPolicyLock struct {
 // FilesystemSnapshotPolicyStatus defines the observed state of FilesystemSnapshotPolicy.
 type FilesystemSnapshotPolicyStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the compartment that contains the file system snapshot policy.
 	CompartmentId string `json:"compartmentId,omitempty"`
 	// The availability domain that the file system snapshot policy is in. May be unset using a blank or NULL value.
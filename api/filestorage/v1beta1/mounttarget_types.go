@@ -171,6 +171,13 @@ type MountTargetLock struct {
 // MountTargetStatus defines the observed state of MountTarget.
 type MountTargetStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the compartment that contains the mount target.
 	CompartmentId string `json:"compartmentId,omitempty"`
 	// A user-friendly name. It does not have to be unique, and it is changeable.
@@ -95,7 +95,14 @@ type OutboundConnectorEndpoint struct {
 // OutboundConnectorStatus defines the observed state of OutboundConnector.
 type OutboundConnectorStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
-	JsonData   string            `json:"jsonData,omitempty"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+	JsonData           string `json:"jsonData,omitempty"`
 	// The availability domain the outbound connector is in. May be unset
 	// as a blank or NULL value.
 	// Example: `Uocm:PHX-AD-1`
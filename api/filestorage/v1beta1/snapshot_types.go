@@ -63,6 +63,13 @@ type SnapshotLock struct {
 // SnapshotStatus defines the observed state of Snapshot.
 type SnapshotStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the file system from which the snapshot
 	// was created.
 	FileSystemId string `json:"fileSystemId,omitempty"`
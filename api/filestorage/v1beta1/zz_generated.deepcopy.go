@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -139,6 +140,13 @@ func (in *ExportSpec) DeepCopy() *ExportSpec {
 func (in *ExportStatus) DeepCopyInto(out *ExportStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ExportOptions != nil {
 		in, out := &in.ExportOptions, &out.ExportOptions
 		*out = make([]ExportOption, len(*in))
@@ -301,6 +309,13 @@ func (in *FileSystemSpec) DeepCopy() *FileSystemSpec {
 func (in *FileSystemStatus) DeepCopyInto(out *FileSystemStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -505,6 +520,13 @@ func (in *FilesystemSnapshotPolicySpec) DeepCopy() *FilesystemSnapshotPolicySpec
 func (in *FilesystemSnapshotPolicyStatus) DeepCopyInto(out *FilesystemSnapshotPolicyStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Schedules != nil {
 		in, out := &in.Schedules, &out.Schedules
 		*out = make([]FilesystemSnapshotPolicySchedule, len(*in))
@@ -748,6 +770,13 @@ func (in *MountTargetSpec) DeepCopy() *MountTargetSpec {
 func (in *MountTargetStatus) DeepCopyInto(out *MountTargetStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.PrivateIpIds != nil {
 		in, out := &in.PrivateIpIds, &out.PrivateIpIds
 		*out = make([]string, len(*in))
@@ -986,6 +1015,13 @@ func (in *OutboundConnectorSpec) DeepCopy() *OutboundConnectorSpec {
 func (in *OutboundConnectorStatus) DeepCopyInto(out *OutboundConnectorStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Locks != nil {
 		in, out := &in.Locks, &out.Locks
 		*out = make([]OutboundConnectorLock, len(*in))
@@ -1129,6 +1165,13 @@ func (in *QuotaRuleSpec) DeepCopy() *QuotaRuleSpec {
 func (in *QuotaRuleStatus) DeepCopyInto(out *QuotaRuleStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaRuleStatus.
@@ -1264,6 +1307,13 @@ func (in *ReplicationSpec) DeepCopy() *ReplicationSpec {
 func (in *ReplicationStatus) DeepCopyInto(out *ReplicationStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Locks != nil {
 		in, out := &in.Locks, &out.Locks
 		*out = make([]ReplicationLock, len(*in))
@@ -1447,6 +1497,13 @@ func (in *SnapshotSpec) DeepCopy() *SnapshotSpec {
 func (in *SnapshotStatus) DeepCopyInto(out *SnapshotStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Locks != nil {
 		in, out := &in.Locks, &out.Locks
 		*out = make([]SnapshotLock, len(*in))
@@ -353,6 +353,13 @@ type FleetCredentialObservedState struct {
 // FleetStatus defines the observed state of Fleet.
 type FleetStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the resource.
 	Id string `json:"id,omitempty"`
 	// Compartment OCID
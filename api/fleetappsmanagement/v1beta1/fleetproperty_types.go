@@ -25,6 +25,13 @@ type FleetPropertySpec struct {
 // FleetPropertyStatus defines the observed state of FleetProperty.
 type FleetPropertyStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The unique id of the resource.
 	Id string `json:"id,omitempty"`
 	// Compartment OCID
@@ -34,6 +34,13 @@ type FleetResourceSpec struct {
 // FleetResourceStatus defines the observed state of FleetResource.
 type FleetResourceStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The unique id of the resource.
 	Id string `json:"id,omitempty"`
 	// OCID of the tenancy to which the resource belongs to.
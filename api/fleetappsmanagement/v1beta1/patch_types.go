@@ -122,6 +122,13 @@ type PatchDependentPatch struct {
 // PatchStatus defines the observed state of Patch.
 type PatchStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the resource.
 	Id string `json:"id,omitempty"`
 	// A user-friendly name. Should be unique within the tenancy, and cannot be changed after creation.
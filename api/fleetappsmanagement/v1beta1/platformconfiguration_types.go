@@ -192,6 +192,13 @@ type PlatformConfigurationConfigCategoryDetails struct {
 // PlatformConfigurationStatus defines the observed state of PlatformConfiguration.
 type PlatformConfigurationStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the resource.
 	Id string `json:"id,omitempty"`
 	// Compartment OCID
@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -148,6 +149,13 @@ func (in *CatalogItemSpec) DeepCopy() *CatalogItemSpec {
 func (in *CatalogItemStatus) DeepCopyInto(out *CatalogItemStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.CatalogSourcePayload = in.CatalogSourcePayload
 	out.CatalogResultPayload = in.CatalogResultPayload
 	if in.FreeformTags != nil {
@@ -350,6 +358,13 @@ func (in *CompliancePolicyRuleSpec) DeepCopy() *CompliancePolicyRuleSpec {
 func (in *CompliancePolicyRuleStatus) DeepCopyInto(out *CompliancePolicyRuleStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.ProductVersion = in.ProductVersion
 	if in.PatchTypeId != nil {
 		in, out := &in.PatchTypeId, &out.PatchTypeId
@@ -660,6 +675,13 @@ func (in *FleetCredentialSpec) DeepCopy() *FleetCredentialSpec {
 func (in *FleetCredentialStatus) DeepCopyInto(out *FleetCredentialStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.EntitySpecifics.DeepCopyInto(&out.EntitySpecifics)
 	out.User = in.User
 	if in.SystemTags != nil {
@@ -909,6 +931,13 @@ func (in *FleetPropertySpec) DeepCopy() *FleetPropertySpec {
 func (in *FleetPropertyStatus) DeepCopyInto(out *FleetPropertyStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.AllowedValues != nil {
 		in, out := &in.AllowedValues, &out.AllowedValues
 		*out = make([]string, len(*in))
@@ -1110,6 +1139,13 @@ func (in *FleetResourceSpec) DeepCopy() *FleetResourceSpec {
 func (in *FleetResourceStatus) DeepCopyInto(out *FleetResourceStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.SystemTags != nil {
 		in, out := &in.SystemTags, &out.SystemTags
 		*out = make(map[string]shared.MapValue, len(*in))
@@ -1213,6 +1249,13 @@ func (in *FleetSpec) DeepCopy() *FleetSpec {
 func (in *FleetStatus) DeepCopyInto(out *FleetStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -1400,6 +1443,13 @@ func (in *MaintenanceWindowSpec) DeepCopy() *MaintenanceWindowSpec {
 func (in *MaintenanceWindowStatus) DeepCopyInto(out *MaintenanceWindowStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -1596,6 +1646,13 @@ func (in *OnboardingSpec) DeepCopy() *OnboardingSpec {
 func (in *OnboardingStatus) DeepCopyInto(out *OnboardingStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.AppliedPolicies.DeepCopyInto(&out.AppliedPolicies)
 	if in.SystemTags != nil {
 		in, out := &in.SystemTags, &out.SystemTags
@@ -1845,6 +1902,13 @@ func (in *PatchSpec) DeepCopy() *PatchSpec {
 func (in *PatchStatus) DeepCopyInto(out *PatchStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.PatchType = in.PatchType
 	in.ArtifactDetails.DeepCopyInto(&out.ArtifactDetails)
 	out.Product = in.Product
@@ -2198,6 +2262,13 @@ func (in *PlatformConfigurationSpec) DeepCopy() *PlatformConfigurationSpec {
 func (in *PlatformConfigurationStatus) DeepCopyInto(out *PlatformConfigurationStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.ConfigCategoryDetails.DeepCopyInto(&out.ConfigCategoryDetails)
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
@@ -2362,6 +2433,13 @@ func (in *PropertySpec) DeepCopy() *PropertySpec {
 func (in *PropertyStatus) DeepCopyInto(out *PropertyStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Values != nil {
 		in, out := &in.Values, &out.Values
 		*out = make([]string, len(*in))
@@ -2560,6 +2638,13 @@ func (in *ProvisionSpec) DeepCopy() *ProvisionSpec {
 func (in *ProvisionStatus) DeepCopyInto(out *ProvisionStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -2746,6 +2831,13 @@ func (in *RunbookSpec) DeepCopy() *RunbookSpec {
 func (in *RunbookStatus) DeepCopyInto(out *RunbookStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.RunbookVersion.DeepCopyInto(&out.RunbookVersion)
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
@@ -3438,6 +3530,13 @@ func (in *RunbookVersionSpec) DeepCopy() *RunbookVersionSpec {
 func (in *RunbookVersionStatus) DeepCopyInto(out *RunbookVersionStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Tasks != nil {
 		in, out := &in.Tasks, &out.Tasks
 		*out = make([]RunbookVersionTaskFields, len(*in))
@@ -4290,6 +4389,13 @@ func (in *SchedulerDefinitionSpec) DeepCopy() *SchedulerDefinitionSpec {
 func (in *SchedulerDefinitionStatus) DeepCopyInto(out *SchedulerDefinitionStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.Schedule = in.Schedule
 	if in.Products != nil {
 		in, out := &in.Products, &out.Products
@@ -4601,6 +4707,13 @@ func (in *TaskRecordSpec) DeepCopy() *TaskRecordSpec {
 func (in *TaskRecordStatus) DeepCopyInto(out *TaskRecordStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.Details.DeepCopyInto(&out.Details)
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
@@ -81,7 +81,14 @@ type FsuActionProgress struct {
 // FsuActionStatus defines the observed state of FsuAction.
 type FsuActionStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
-	JsonData   string            `json:"jsonData,omitempty"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+	JsonData           string `json:"jsonData,omitempty"`
 	// Exadata Fleet Update Action display name.
 	DisplayName string `json:"displayName,omitempty"`
 	// The date and time the Action was started,
@@ -198,9 +198,16 @@ type FsuCollectionActiveFsuCycle struct {
 
 // FsuCollectionStatus defines the observed state of FsuCollection.
 type FsuCollectionStatus struct {
-	OsokStatus     shared.OSOKStatus           `json:"status"`
-	JsonData       string                      `json:"jsonData,omitempty"`
-	ActiveFsuCycle FsuCollectionActiveFsuCycle `json:"activeFsuCycle,omitempty"`
+	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64                       `json:"observedGeneration,omitempty"`
+	JsonData           string                      `json:"jsonData,omitempty"`
+	ActiveFsuCycle     FsuCollectionActiveFsuCycle `json:"activeFsuCycle,omitempty"`
 	// Number of targets that are members of this Collection.
 	TargetCount int `json:"targetCount,omitempty"`
 	// The time the Exadata Fleet Update Collection was updated. An RFC3339 formatted datetime string.
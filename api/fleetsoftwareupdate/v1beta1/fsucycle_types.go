@@ -168,7 +168,14 @@ type FsuCycleGoalVersionDetailsComponent struct {
 // FsuCycleStatus defines the observed state of FsuCycle.
 type FsuCycleStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
-	JsonData   string            `json:"jsonData,omitempty"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+	JsonData           string `json:"jsonData,omitempty"`
 	// The user-friendly name for the Exadata Fleet Update Cycle.
 	DisplayName string `json:"displayName,omitempty"`
 	// Type of the Exadata Fleet Update Collection being updated by this Exadata Fleet Update Cycle.
@@ -194,6 +194,13 @@ type FsuDiscoveryDetails struct {
 // FsuDiscoveryStatus defines the observed state of FsuDiscovery.
 type FsuDiscoveryStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// OCID identifier for the Exadata Fleet Update Discovery.
 	Id string `json:"id,omitempty"`
 	// Exadata Fleet Update Discovery display name.
@@ -63,7 +63,14 @@ type FsuReadinessCheckIssue struct {
 // FsuReadinessCheckStatus defines the observed state of FsuReadinessCheck.
 type FsuReadinessCheckStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
-	JsonData   string            `json:"jsonData,omitempty"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+	JsonData           string `json:"jsonData,omitempty"`
 	// Issues found during the Exadata Fleet Update Readiness Check run.
 	Issues []FsuReadinessCheckIssue `json:"issues,omitempty"`
 	// The date and time the Exadata Fleet Update Readiness Check was updated,
@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -169,6 +170,13 @@ func (in *FsuActionSpec) DeepCopy() *FsuActionSpec {
 func (in *FsuActionStatus) DeepCopyInto(out *FsuActionStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -518,6 +526,13 @@ func (in *FsuCollectionSpec) DeepCopy() *FsuCollectionSpec {
 func (in *FsuCollectionStatus) DeepCopyInto(out *FsuCollectionStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.ActiveFsuCycle = in.ActiveFsuCycle
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
@@ -817,6 +832,13 @@ func (in *FsuCycleStageActionSchedule) DeepCopy() *FsuCycleStageActionSchedule {
 func (in *FsuCycleStatus) DeepCopyInto(out *FsuCycleStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.NextActionToExecute != nil {
 		in, out := &in.NextActionToExecute, &out.NextActionToExecute
 		*out = make([]FsuCycleNextActionToExecute, len(*in))
@@ -1195,6 +1217,13 @@ func (in *FsuDiscoverySpec) DeepCopy() *FsuDiscoverySpec {
 func (in *FsuDiscoveryStatus) DeepCopyInto(out *FsuDiscoveryStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.Details.DeepCopyInto(&out.Details)
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
@@ -1374,6 +1403,13 @@ func (in *FsuReadinessCheckSpec) DeepCopy() *FsuReadinessCheckSpec {
 func (in *FsuReadinessCheckStatus) DeepCopyInto(out *FsuReadinessCheckStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Issues != nil {
 		in, out := &in.Issues, &out.Issues
 		*out = make([]FsuReadinessCheckIssue, len(*in))
@@ -64,6 +64,9 @@ type FunctionSpec struct {
 	// Example: `{"Operations": {"CostCenter": "42"}}`
 	// +kubebuilder:validation:Optional
 	DefinedTags map[string]shared.MapValue `json:"definedTags,omitempty"`
+	// WriteConnectionSecretToRef configures the Secret that OSOK writes the connection details to.
+	// +kubebuilder:validation:Optional
+	WriteConnectionSecretToRef *shared.ConnectionSecretTarget `json:"writeConnectionSecretToRef,omitempty"`
 }
 
 // FunctionSourceDetails defines nested fields for Function.SourceDetails.
@@ -139,6 +142,13 @@ type FunctionTraceConfig struct {
 // FunctionStatus defines the observed state of Function.
 type FunctionStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the function.
 	Id string `json:"id,omitempty"`
 	// The display name of the function. The display name is unique within the application containing the function.
@@ -51,6 +51,13 @@ type FusionEnvironmentFamilyFamilyMaintenancePolicy struct {
 // FusionEnvironmentFamilyStatus defines the observed state of FusionEnvironmentFamily.
 type FusionEnvironmentFamilyStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The unique identifier (OCID) of the environment family. Can't be changed after creation.
 	Id string `json:"id,omitempty"`
 	// A friendly name for the environment family. The name must contain only letters, numbers, dashes, and underscores. Can be changed later.
@@ -34,6 +34,13 @@ type RefreshActivityRefreshIssueDetailsList struct {
 // RefreshActivityStatus defines the observed state of RefreshActivity.
 type RefreshActivityStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The unique identifier (OCID) of the refresh activity. Can't be changed after creation.
 	Id string `json:"id,omitempty"`
 	// A friendly name for the refresh activity. Can be changed later.
@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -195,6 +196,13 @@ func (in *FusionEnvironmentFamilySpec) DeepCopy() *FusionEnvironmentFamilySpec {
 func (in *FusionEnvironmentFamilyStatus) DeepCopyInto(out *FusionEnvironmentFamilyStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.SubscriptionIds != nil {
 		in, out := &in.SubscriptionIds, &out.SubscriptionIds
 		*out = make([]string, len(*in))
@@ -408,6 +416,13 @@ func (in *FusionEnvironmentSpec) DeepCopy() *FusionEnvironmentSpec {
 func (in *FusionEnvironmentStatus) DeepCopyInto(out *FusionEnvironmentStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.MaintenancePolicy = in.MaintenancePolicy
 	if in.SubscriptionIds != nil {
 		in, out := &in.SubscriptionIds, &out.SubscriptionIds
@@ -564,6 +579,13 @@ func (in *RefreshActivitySpec) DeepCopy() *RefreshActivitySpec {
 func (in *RefreshActivityStatus) DeepCopyInto(out *RefreshActivityStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.RefreshIssueDetailsList != nil {
 		in, out := &in.RefreshIssueDetailsList, &out.RefreshIssueDetailsList
 		*out = make([]RefreshActivityRefreshIssueDetailsList, len(*in))
@@ -659,6 +681,13 @@ func (in *ServiceAttachmentSpec) DeepCopy() *ServiceAttachmentSpec {
 func (in *ServiceAttachmentStatus) DeepCopyInto(out *ServiceAttachmentStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -112,6 +112,13 @@ type DedicatedAiClusterCapacity struct {
 // DedicatedAiClusterStatus defines the observed state of DedicatedAiCluster.
 type DedicatedAiClusterStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the dedicated AI cluster.
 	Id string `json:"id,omitempty"`
 	// The dedicated AI cluster type indicating whether this is a fine-tuning/training processor or hosting/inference processor.
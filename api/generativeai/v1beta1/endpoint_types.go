@@ -92,6 +92,13 @@ type EndpointPiiDetectionConfig struct {
 // EndpointStatus defines the observed state of Endpoint.
 type EndpointStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// An OCID that uniquely identifies this endpoint resource.
 	Id string `json:"id,omitempty"`
 	// The OCID of the model that's used to create this endpoint.
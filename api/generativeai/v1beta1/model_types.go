@@ -126,6 +126,13 @@ type ModelMetrics struct {
 // ModelStatus defines the observed state of Model.
 type ModelStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// An ID that uniquely identifies a pretrained or fine-tuned model.
 	Id string `json:"id,omitempty"`
 	// The compartment OCID for fine-tuned models. For pretrained models, this value is null.
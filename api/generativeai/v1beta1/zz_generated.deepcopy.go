@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -132,6 +133,13 @@ func (in *DedicatedAiClusterSpec) DeepCopy() *DedicatedAiClusterSpec {
 func (in *DedicatedAiClusterStatus) DeepCopyInto(out *DedicatedAiClusterStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.Capacity = in.Capacity
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
@@ -339,6 +347,13 @@ func (in *EndpointSpec) DeepCopy() *EndpointSpec {
 func (in *EndpointStatus) DeepCopyInto(out *EndpointStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.ContentModerationConfig = in.ContentModerationConfig
 	out.PromptInjectionConfig = in.PromptInjectionConfig
 	out.PiiDetectionConfig = in.PiiDetectionConfig
@@ -563,6 +578,13 @@ func (in *ModelSpec) DeepCopy() *ModelSpec {
 func (in *ModelStatus) DeepCopyInto(out *ModelStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Capabilities != nil {
 		in, out := &in.Capabilities, &out.Capabilities
 		*out = make([]string, len(*in))
@@ -26,6 +26,13 @@ type CertificateSpec struct {
 // CertificateStatus defines the observed state of Certificate.
 type CertificateStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The identifier key (unique name in the scope of the deployment) of the certificate being referenced.
 	// It must be 1 to 32 characters long, must contain only alphanumeric characters and must start with a letter.
 	Key string `json:"key,omitempty"`
@@ -602,7 +602,14 @@ type ConnectionIngressIp struct {
 // ConnectionStatus defines the observed state of Connection.
 type ConnectionStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
-	JsonData   string            `json:"jsonData,omitempty"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+	JsonData           string `json:"jsonData,omitempty"`
 	// Metadata about this specific object.
 	Description string `json:"description,omitempty"`
 	// A simple key-value pair that is applied without any predefined name, type, or scope. Exists
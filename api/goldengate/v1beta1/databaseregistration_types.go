@@ -90,6 +90,13 @@ type DatabaseRegistrationSpec struct {
 // DatabaseRegistrationStatus defines the observed state of DatabaseRegistration.
 type DatabaseRegistrationStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the databaseRegistration being
 	// referenced.
 	Id string `json:"id,omitempty"`
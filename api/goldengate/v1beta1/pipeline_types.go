@@ -164,7 +164,14 @@ type PipelineIngressIp struct {
 // PipelineStatus defines the observed state of Pipeline.
 type PipelineStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
-	JsonData   string            `json:"jsonData,omitempty"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+	JsonData           string `json:"jsonData,omitempty"`
 	// Metadata about this specific object.
 	Description            string                 `json:"description,omitempty"`
 	PipelineDiagnosticData PipelineDiagnosticData `json:"pipelineDiagnosticData,omitempty"`
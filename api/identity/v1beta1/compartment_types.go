@@ -39,6 +39,13 @@ type CompartmentSpec struct {
 // CompartmentStatus defines the observed state of Compartment.
 type CompartmentStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the compartment.
 	Id string `json:"id,omitempty"`
 	// The OCID of the parent compartment containing the compartment.
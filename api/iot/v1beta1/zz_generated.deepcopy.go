@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -224,6 +225,13 @@ func (in *DigitalTwinAdapterSpec) DeepCopy() *DigitalTwinAdapterSpec {
 func (in *DigitalTwinAdapterStatus) DeepCopyInto(out *DigitalTwinAdapterStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.InboundEnvelope.DeepCopyInto(&out.InboundEnvelope)
 	if in.InboundRoutes != nil {
 		in, out := &in.InboundRoutes, &out.InboundRoutes
@@ -390,6 +398,13 @@ func (in *DigitalTwinInstanceSpec) DeepCopy() *DigitalTwinInstanceSpec {
 func (in *DigitalTwinInstanceStatus) DeepCopyInto(out *DigitalTwinInstanceStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -555,6 +570,13 @@ func (in *DigitalTwinModelSpec) DeepCopy() *DigitalTwinModelSpec {
 func (in *DigitalTwinModelStatus) DeepCopyInto(out *DigitalTwinModelStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -720,6 +742,13 @@ func (in *DigitalTwinRelationshipSpec) DeepCopy() *DigitalTwinRelationshipSpec {
 func (in *DigitalTwinRelationshipStatus) DeepCopyInto(out *DigitalTwinRelationshipStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Content != nil {
 		in, out := &in.Content, &out.Content
 		*out = make(map[string]shared.JSONValue, len(*in))
@@ -927,6 +956,13 @@ func (in *IotDomainGroupSpec) DeepCopy() *IotDomainGroupSpec {
 func (in *IotDomainGroupStatus) DeepCopyInto(out *IotDomainGroupStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.DbAllowListedVcnIds != nil {
 		in, out := &in.DbAllowListedVcnIds, &out.DbAllowListedVcnIds
 		*out = make([]string, len(*in))
@@ -1063,6 +1099,13 @@ func (in *IotDomainSpec) DeepCopy() *IotDomainSpec {
 func (in *IotDomainStatus) DeepCopyInto(out *IotDomainStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.DbAllowListedIdentityGroupNames != nil {
 		in, out := &in.DbAllowListedIdentityGroupNames, &out.DbAllowListedIdentityGroupNames
 		*out = make([]string, len(*in))
@@ -97,6 +97,13 @@ type VaultExternalKeyManagerMetadataSummary struct {
 // VaultStatus defines the observed state of Vault.
 type VaultStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the compartment that contains this vault.
 	CompartmentId string `json:"compartmentId,omitempty"`
 	// The service endpoint to perform cryptographic operations against. Cryptographic operations include
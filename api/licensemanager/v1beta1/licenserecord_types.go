@@ -53,6 +53,13 @@ type LicenseRecordSpec struct {
 // LicenseRecordStatus defines the observed state of LicenseRecord.
 type LicenseRecordStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The license record OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm).
 	Id string `json:"id,omitempty"`
 	// The license record display name. Avoid entering confidential information.
@@ -55,6 +55,13 @@ type ProductLicenseImage struct {
 // ProductLicenseStatus defines the observed state of ProductLicense.
 type ProductLicenseStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The product license OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm).
 	Id string `json:"id,omitempty"`
 	// The compartment OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) where the product license is created.
@@ -59,6 +59,13 @@ type QuotaLock struct {
 // QuotaStatus defines the observed state of Quota.
 type QuotaStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the quota.
 	Id string `json:"id,omitempty"`
 	// The OCID of the compartment containing the resource this quota applies to.
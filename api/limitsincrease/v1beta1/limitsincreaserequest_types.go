@@ -87,6 +87,13 @@ type LimitsIncreaseRequestCustomerComment struct {
 // LimitsIncreaseRequestStatus defines the observed state of LimitsIncreaseRequest.
 type LimitsIncreaseRequestStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the limit increase request.
 	Id string `json:"id,omitempty"`
 	// The user-friendly name of the limit increase request. Avoid entering confidential information.
@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -174,6 +175,13 @@ func (in *LimitsIncreaseRequestSpec) DeepCopy() *LimitsIncreaseRequestSpec {
 func (in *LimitsIncreaseRequestStatus) DeepCopyInto(out *LimitsIncreaseRequestStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.LimitsIncreaseItemRequests != nil {
 		in, out := &in.LimitsIncreaseItemRequests, &out.LimitsIncreaseItemRequests
 		*out = make([]LimitsIncreaseRequestLimitsIncreaseItemRequest, len(*in))
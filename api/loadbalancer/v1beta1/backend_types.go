@@ -66,6 +66,13 @@ type BackendSpec struct {
 // BackendStatus defines the observed state of Backend.
 type BackendStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// A read-only field showing the IP address and port that uniquely identify this backend server in the backend set.
 	// Example: `10.0.0.3:8080`
 	Name string `json:"name,omitempty"`
@@ -29,6 +29,13 @@ type HostnameSpec struct {
 // HostnameStatus defines the observed state of Hostname.
 type HostnameStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// A friendly name for the hostname resource. It must be unique and it cannot be changed. Avoid entering confidential
 	// information.
 	// Example: `example_hostname_001`
@@ -60,6 +60,13 @@ type PathRouteSetPathRoute struct {
 // PathRouteSetStatus defines the observed state of PathRouteSet.
 type PathRouteSetStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The unique name for this set of path route rules. Avoid entering confidential information.
 	// Example: `example_path_route_set`
 	Name string `json:"name,omitempty"`
@@ -55,6 +55,13 @@ type RoutingPolicyRule struct {
 // RoutingPolicyStatus defines the observed state of RoutingPolicy.
 type RoutingPolicyStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The unique name for this list of routing rules. Avoid entering confidential information.
 	// Example: `example_routing_policy`
 	Name string `json:"name,omitempty"`
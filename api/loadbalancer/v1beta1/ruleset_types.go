@@ -235,6 +235,13 @@ type RuleSetItem struct {
 // RuleSetStatus defines the observed state of RuleSet.
 type RuleSetStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The name for this set of rules. It must be unique and it cannot be changed. Avoid entering
 	// confidential information.
 	// Example: `example_rule_set`
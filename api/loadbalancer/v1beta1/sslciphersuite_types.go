@@ -144,6 +144,13 @@ type SSLCipherSuiteSpec struct {
 // SSLCipherSuiteStatus defines the observed state of SSLCipherSuite.
 type SSLCipherSuiteStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// A friendly name for the SSL cipher suite. It must be unique and it cannot be changed.
 	// **Note:** The name of your user-defined cipher suite must not be the same as any of Oracle's predefined or
 	//           reserved SSL cipher suite names:
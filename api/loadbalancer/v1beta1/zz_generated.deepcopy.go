@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -250,6 +251,13 @@ func (in *BackendSetSslConfiguration) DeepCopy() *BackendSetSslConfiguration {
 func (in *BackendSetStatus) DeepCopyInto(out *BackendSetStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Backends != nil {
 		in, out := &in.Backends, &out.Backends
 		*out = make([]BackendSetBackend, len(*in))
@@ -290,6 +298,13 @@ func (in *BackendSpec) DeepCopy() *BackendSpec {
 func (in *BackendStatus) DeepCopyInto(out *BackendStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendStatus.
@@ -380,6 +395,13 @@ func (in *CertificateSpec) DeepCopy() *CertificateSpec {
 func (in *CertificateStatus) DeepCopyInto(out *CertificateStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateStatus.
@@ -470,6 +492,13 @@ func (in *HostnameSpec) DeepCopy() *HostnameSpec {
 func (in *HostnameStatus) DeepCopyInto(out *HostnameStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostnameStatus.
@@ -622,6 +651,13 @@ func (in *ListenerSslConfiguration) DeepCopy() *ListenerSslConfiguration {
 func (in *ListenerStatus) DeepCopyInto(out *ListenerStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.HostnameNames != nil {
 		in, out := &in.HostnameNames, &out.HostnameNames
 		*out = make([]string, len(*in))
@@ -1347,6 +1383,13 @@ func (in *LoadBalancerSslCipherSuites) DeepCopy() *LoadBalancerSslCipherSuites {
 func (in *LoadBalancerStatus) DeepCopyInto(out *LoadBalancerStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.IpAddresses != nil {
 		in, out := &in.IpAddresses, &out.IpAddresses
 		*out = make([]LoadBalancerIpAddress, len(*in))
@@ -1606,6 +1649,13 @@ func (in *PathRouteSetSpec) DeepCopy() *PathRouteSetSpec {
 func (in *PathRouteSetStatus) DeepCopyInto(out *PathRouteSetStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.PathRoutes != nil {
 		in, out := &in.PathRoutes, &out.PathRoutes
 		*out = make([]PathRouteSetPathRoute, len(*in))
@@ -1743,6 +1793,13 @@ func (in *RoutingPolicySpec) DeepCopy() *RoutingPolicySpec {
 func (in *RoutingPolicyStatus) DeepCopyInto(out *RoutingPolicyStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Rules != nil {
 		in, out := &in.Rules, &out.Rules
 		*out = make([]RoutingPolicyRule, len(*in))
@@ -1930,6 +1987,13 @@ func (in *RuleSetSpec) DeepCopy() *RuleSetSpec {
 func (in *RuleSetStatus) DeepCopyInto(out *RuleSetStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
 		*out = make([]RuleSetItem, len(*in))
@@ -2032,6 +2096,13 @@ func (in *SSLCipherSuiteSpec) DeepCopy() *SSLCipherSuiteSpec {
 func (in *SSLCipherSuiteStatus) DeepCopyInto(out *SSLCipherSuiteStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Ciphers != nil {
 		in, out := &in.Ciphers, &out.Ciphers
 		*out = make([]string, len(*in))
@@ -121,6 +121,13 @@ type IngestTimeRuleAction struct {
 // IngestTimeRuleStatus defines the observed state of IngestTimeRule.
 type IngestTimeRuleStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The log analytics entity OCID. This ID is a reference used by log analytics features and it represents
 	// a resource that is provisioned and managed by the customer on their premises or on the cloud.
 	Id string `json:"id,omitempty"`
@@ -143,8 +150,9 @@ type IngestTimeRuleStatus struct {
 	// The current state of the ingest time rule.
 	LifecycleState string `json:"lifecycleState,omitempty"`
 	// A flag indicating whether or not the ingest time rule is enabled.
-	IsEnabled  bool                     `json:"isEnabled,omitempty"`
-	Conditions IngestTimeRuleConditions `json:"conditions,omitempty"`
+	IsEnabled bool `json:"isEnabled,omitempty"`
+	// This uses a distinct JSON name so it can coexist with the OSOK status envelope.
+	SdkConditions IngestTimeRuleConditions `json:"sdkConditions,omitempty"`
 	// The action(s) to be performed if the ingest time rule condition(s) are satisfied.
 	Actions []IngestTimeRuleAction `json:"actions,omitempty"`
 	// The ingest time rule condition kind.
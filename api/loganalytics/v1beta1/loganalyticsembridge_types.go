@@ -42,6 +42,13 @@ type LogAnalyticsEmBridgeSpec struct {
 // LogAnalyticsEmBridgeStatus defines the observed state of LogAnalyticsEmBridge.
 type LogAnalyticsEmBridgeStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The enterprise manager bridge OCID.
 	Id string `json:"id,omitempty"`
 	// Log analytics enterprise manager bridge display name.
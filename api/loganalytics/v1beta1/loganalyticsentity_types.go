@@ -92,6 +92,13 @@ type LogAnalyticsEntityCreationSource struct {
 // LogAnalyticsEntityStatus defines the observed state of LogAnalyticsEntity.
 type LogAnalyticsEntityStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The log analytics entity OCID. This ID is a reference used by log analytics features and it represents
 	// a resource that is provisioned and managed by the customer on their premises or on the cloud.
 	Id string `json:"id,omitempty"`
@@ -38,6 +38,13 @@ type LogAnalyticsEntityTypeProperty struct {
 // LogAnalyticsEntityTypeStatus defines the observed state of LogAnalyticsEntityType.
 type LogAnalyticsEntityTypeStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Log analytics entity type name.
 	Name string `json:"name,omitempty"`
 	// Internal name for the log analytics entity type.
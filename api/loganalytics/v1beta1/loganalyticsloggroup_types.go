@@ -39,6 +39,13 @@ type LogAnalyticsLogGroupSpec struct {
 // LogAnalyticsLogGroupStatus defines the observed state of LogAnalyticsLogGroup.
 type LogAnalyticsLogGroupStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// A user-friendly name that is changeable and that does not have to be unique.
 	// Format: a leading alphanumeric, followed by zero or more
 	// alphanumerics, underscores, spaces, backslashes, or hyphens in any order).
@@ -138,6 +138,13 @@ type LogAnalyticsObjectCollectionRuleOverrides struct {
 // LogAnalyticsObjectCollectionRuleStatus defines the observed state of LogAnalyticsObjectCollectionRule.
 type LogAnalyticsObjectCollectionRuleStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of this rule.
 	Id string `json:"id,omitempty"`
 	// A unique name to the rule. The name must be unique, within the tenancy, and cannot be changed.
@@ -222,7 +222,14 @@ type ScheduledTaskSchedule struct {
 // ScheduledTaskStatus defines the observed state of ScheduledTask.
 type ScheduledTaskStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
-	JsonData   string            `json:"jsonData,omitempty"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+	JsonData           string `json:"jsonData,omitempty"`
 	// Description for this resource.
 	Description string `json:"description,omitempty"`
 	// Status of the scheduled task.
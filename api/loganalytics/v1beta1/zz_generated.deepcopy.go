@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -180,6 +181,13 @@ func (in *IngestTimeRuleSpec) DeepCopy() *IngestTimeRuleSpec {
 func (in *IngestTimeRuleStatus) DeepCopyInto(out *IngestTimeRuleStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -205,7 +213,7 @@ func (in *IngestTimeRuleStatus) DeepCopyInto(out *IngestTimeRuleStatus) {
 			(*out)[key] = outVal
 		}
 	}
-	in.Conditions.DeepCopyInto(&out.Conditions)
+	in.SdkConditions.DeepCopyInto(&out.SdkConditions)
 	if in.Actions != nil {
 		in, out := &in.Actions, &out.Actions
 		*out = make([]IngestTimeRuleAction, len(*in))
@@ -328,6 +336,13 @@ func (in *LogAnalyticsEmBridgeSpec) DeepCopy() *LogAnalyticsEmBridgeSpec {
 func (in *LogAnalyticsEmBridgeStatus) DeepCopyInto(out *LogAnalyticsEmBridgeStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -526,6 +541,13 @@ func (in *LogAnalyticsEntitySpec) DeepCopy() *LogAnalyticsEntitySpec {
 func (in *LogAnalyticsEntityStatus) DeepCopyInto(out *LogAnalyticsEntityStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Properties != nil {
 		in, out := &in.Properties, &out.Properties
 		*out = make(map[string]string, len(*in))
@@ -670,6 +692,13 @@ func (in *LogAnalyticsEntityTypeSpec) DeepCopy() *LogAnalyticsEntityTypeSpec {
 func (in *LogAnalyticsEntityTypeStatus) DeepCopyInto(out *LogAnalyticsEntityTypeStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Properties != nil {
 		in, out := &in.Properties, &out.Properties
 		*out = make([]LogAnalyticsEntityTypeProperty, len(*in))
@@ -790,6 +819,13 @@ func (in *LogAnalyticsLogGroupSpec) DeepCopy() *LogAnalyticsLogGroupSpec {
 func (in *LogAnalyticsLogGroupStatus) DeepCopyInto(out *LogAnalyticsLogGroupStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -966,6 +1002,13 @@ func (in *LogAnalyticsObjectCollectionRuleSpec) DeepCopy() *LogAnalyticsObjectCo
 func (in *LogAnalyticsObjectCollectionRuleStatus) DeepCopyInto(out *LogAnalyticsObjectCollectionRuleStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Overrides != nil {
 		in, out := &in.Overrides, &out.Overrides
 		*out = make(map[string][]LogAnalyticsObjectCollectionRuleOverrides, len(*in))
@@ -1257,6 +1300,13 @@ func (in *ScheduledTaskSpec) DeepCopy() *ScheduledTaskSpec {
 func (in *ScheduledTaskStatus) DeepCopyInto(out *ScheduledTaskStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -198,6 +199,13 @@ func (in *LogGroupSpec) DeepCopy() *LogGroupSpec {
 func (in *LogGroupStatus) DeepCopyInto(out *LogGroupStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.DefinedTags != nil {
 		in, out := &in.DefinedTags, &out.DefinedTags
 		*out = make(map[string]shared.MapValue, len(*in))
@@ -370,6 +378,13 @@ func (in *LogSavedSearchSpec) DeepCopy() *LogSavedSearchSpec {
 func (in *LogSavedSearchStatus) DeepCopyInto(out *LogSavedSearchStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.DefinedTags != nil {
 		in, out := &in.DefinedTags, &out.DefinedTags
 		*out = make(map[string]shared.MapValue, len(*in))
@@ -452,6 +467,13 @@ func (in *LogSpec) DeepCopy() *LogSpec {
 func (in *LogStatus) DeepCopyInto(out *LogStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.DefinedTags != nil {
 		in, out := &in.DefinedTags, &out.DefinedTags
 		*out = make(map[string]shared.MapValue, len(*in))
@@ -1309,6 +1331,13 @@ func (in *UnifiedAgentConfigurationSpec) DeepCopy() *UnifiedAgentConfigurationSp
 func (in *UnifiedAgentConfigurationStatus) DeepCopyInto(out *UnifiedAgentConfigurationStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.ServiceConfiguration.DeepCopyInto(&out.ServiceConfiguration)
 	in.GroupAssociation.DeepCopyInto(&out.GroupAssociation)
 	if in.DefinedTags != nil {
@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -202,6 +203,13 @@ func (in *KafkaClusterConfigSpec) DeepCopy() *KafkaClusterConfigSpec {
 func (in *KafkaClusterConfigStatus) DeepCopyInto(out *KafkaClusterConfigStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.LatestConfig.DeepCopyInto(&out.LatestConfig)
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
@@ -357,6 +365,13 @@ func (in *KafkaClusterSpec) DeepCopy() *KafkaClusterSpec {
 func (in *KafkaClusterStatus) DeepCopyInto(out *KafkaClusterStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.AccessSubnets != nil {
 		in, out := &in.AccessSubnets, &out.AccessSubnets
 		*out = make([]KafkaClusterAccessSubnet, len(*in))
@@ -69,7 +69,14 @@ type DataSourceMetricDimension struct {
 // DataSourceStatus defines the observed state of DataSource.
 type DataSourceStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
-	JsonData   string            `json:"jsonData,omitempty"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+	JsonData           string `json:"jsonData,omitempty"`
 	// Identifier for DataSource. This represents the type and name for the data source associated with the Management Agent.
 	Key string `json:"key,omitempty"`
 	// Unique name of the DataSource.
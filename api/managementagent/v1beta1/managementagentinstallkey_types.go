@@ -37,6 +37,13 @@ type ManagementAgentInstallKeySpec struct {
 // ManagementAgentInstallKeyStatus defines the observed state of ManagementAgentInstallKey.
 type ManagementAgentInstallKeyStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Agent install Key identifier
 	Id string `json:"id,omitempty"`
 	// Compartment Identifier
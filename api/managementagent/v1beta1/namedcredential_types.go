@@ -59,6 +59,13 @@ type NamedCredentialProperty struct {
 // NamedCredentialStatus defines the observed state of NamedCredential.
 type NamedCredentialStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Identifier for Named Credential.
 	Id string `json:"id,omitempty"`
 	// Name for Named Credential. This is unique for the Management Agent.
@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -112,6 +113,13 @@ func (in *DataSourceSpec) DeepCopy() *DataSourceSpec {
 func (in *DataSourceStatus) DeepCopyInto(out *DataSourceStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.MetricDimensions != nil {
 		in, out := &in.MetricDimensions, &out.MetricDimensions
 		*out = make([]DataSourceMetricDimension, len(*in))
@@ -207,6 +215,13 @@ func (in *ManagementAgentInstallKeySpec) DeepCopy() *ManagementAgentInstallKeySp
 func (in *ManagementAgentInstallKeyStatus) DeepCopyInto(out *ManagementAgentInstallKeyStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -385,6 +400,13 @@ func (in *NamedCredentialSpec) DeepCopy() *NamedCredentialSpec {
 func (in *NamedCredentialStatus) DeepCopyInto(out *NamedCredentialStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Properties != nil {
 		in, out := &in.Properties, &out.Properties
 		*out = make([]NamedCredentialProperty, len(*in))
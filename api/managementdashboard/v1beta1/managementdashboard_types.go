@@ -194,6 +194,13 @@ type ManagementDashboardSavedSearch struct {
 // ManagementDashboardStatus defines the observed state of ManagementDashboard.
 type ManagementDashboardStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// ID of the dashboard.  Same as id.
 	DashboardId string `json:"dashboardId,omitempty"`
 	// ID of the dashboard.  Same as dashboardId.
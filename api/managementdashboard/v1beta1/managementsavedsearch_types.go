@@ -84,6 +84,13 @@ type ManagementSavedSearchSpec struct {
 // ManagementSavedSearchStatus defines the observed state of ManagementSavedSearch.
 type ManagementSavedSearchStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// ID of the saved search.
 	Id string `json:"id,omitempty"`
 	// Display name of the saved search.
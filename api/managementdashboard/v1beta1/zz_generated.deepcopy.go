@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -230,6 +231,13 @@ func (in *ManagementDashboardSpec) DeepCopy() *ManagementDashboardSpec {
 func (in *ManagementDashboardStatus) DeepCopyInto(out *ManagementDashboardStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Tiles != nil {
 		in, out := &in.Tiles, &out.Tiles
 		*out = make([]ManagementDashboardTile, len(*in))
@@ -476,6 +484,13 @@ func (in *ManagementSavedSearchSpec) DeepCopy() *ManagementSavedSearchSpec {
 func (in *ManagementSavedSearchStatus) DeepCopyInto(out *ManagementSavedSearchStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.Nls.DeepCopyInto(&out.Nls)
 	in.UiConfig.DeepCopyInto(&out.UiConfig)
 	if in.DataConfig != nil {
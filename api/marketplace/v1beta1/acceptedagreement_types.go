@@ -48,6 +48,13 @@ type AcceptedAgreementSpec struct {
 // AcceptedAgreementStatus defines the observed state of AcceptedAgreement.
 type AcceptedAgreementStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The unique identifier for the acceptance of the agreement within a specific compartment.
 	Id string `json:"id,omitempty"`
 	// A display name for the accepted agreement.
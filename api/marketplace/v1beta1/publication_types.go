@@ -109,6 +109,13 @@ type PublicationSupportedOperatingSystem struct {
 // PublicationStatus defines the observed state of Publication.
 type PublicationStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the compartment where the publication exists.
 	CompartmentId string `json:"compartmentId,omitempty"`
 	// The unique identifier for the publication in Marketplace.
@@ -39,6 +39,13 @@ type ListingSpec struct {
 // ListingStatus defines the observed state of Listing.
 type ListingStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Unique OCID identifier for the listing.
 	Id string `json:"id,omitempty"`
 	// The unique identifier for the compartment.
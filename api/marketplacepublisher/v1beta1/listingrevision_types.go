@@ -271,7 +271,14 @@ type ListingRevisionIcon struct {
 // ListingRevisionStatus defines the observed state of ListingRevision.
 type ListingRevisionStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
-	JsonData   string            `json:"jsonData,omitempty"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+	JsonData           string `json:"jsonData,omitempty"`
 	// The unique identifier for the compartment.
 	CompartmentId string `json:"compartmentId,omitempty"`
 	// The revision number for the listing revision. This is an internal attribute
@@ -33,6 +33,13 @@ type ListingRevisionNoteSpec struct {
 // ListingRevisionNoteStatus defines the observed state of ListingRevisionNote.
 type ListingRevisionNoteStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the listing revision note.
 	Id string `json:"id,omitempty"`
 	// The unique identifier of the listing revision that the specified note belongs to.
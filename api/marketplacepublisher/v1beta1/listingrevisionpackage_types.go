@@ -61,7 +61,14 @@ type ListingRevisionPackageMachineImageDetails struct {
 // ListingRevisionPackageStatus defines the observed state of ListingRevisionPackage.
 type ListingRevisionPackageStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
-	JsonData   string            `json:"jsonData,omitempty"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+	JsonData           string `json:"jsonData,omitempty"`
 	// The OCID for the listing revision package in Marketplace Publisher.
 	Id string `json:"id,omitempty"`
 	// The description of this package.
@@ -33,6 +33,13 @@ type TermSpec struct {
 // TermStatus defines the observed state of Term.
 type TermStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The name for the term.
 	Name string `json:"name,omitempty"`
 	// Who authored the term. Publisher terms will be defaulted to 'PARTNER'.
@@ -38,6 +38,13 @@ type TermVersionAttachment struct {
 // TermVersionStatus defines the observed state of TermVersion.
 type TermVersionStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The unique identifier for the term.
 	TermId string `json:"termId,omitempty"`
 	// Who authored the term. Publisher terms will be defaulted to 'PARTNER'.
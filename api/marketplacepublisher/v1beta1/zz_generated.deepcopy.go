@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -248,6 +249,13 @@ func (in *ArtifactStack) DeepCopy() *ArtifactStack {
 func (in *ArtifactStatus) DeepCopyInto(out *ArtifactStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -507,6 +515,13 @@ func (in *ListingRevisionAttachmentSpec) DeepCopy() *ListingRevisionAttachmentSp
 func (in *ListingRevisionAttachmentStatus) DeepCopyInto(out *ListingRevisionAttachmentStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -777,6 +792,13 @@ func (in *ListingRevisionNoteSpec) DeepCopy() *ListingRevisionNoteSpec {
 func (in *ListingRevisionNoteStatus) DeepCopyInto(out *ListingRevisionNoteStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -950,6 +972,13 @@ func (in *ListingRevisionPackageSpec) DeepCopy() *ListingRevisionPackageSpec {
 func (in *ListingRevisionPackageStatus) DeepCopyInto(out *ListingRevisionPackageStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ExtendedMetadata != nil {
 		in, out := &in.ExtendedMetadata, &out.ExtendedMetadata
 		*out = make(map[string]string, len(*in))
@@ -1203,6 +1232,13 @@ func (in *ListingRevisionSpec) DeepCopy() *ListingRevisionSpec {
 func (in *ListingRevisionStatus) DeepCopyInto(out *ListingRevisionStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.ContentLanguage = in.ContentLanguage
 	if in.Supportedlanguages != nil {
 		in, out := &in.Supportedlanguages, &out.Supportedlanguages
@@ -1440,6 +1476,13 @@ func (in *ListingSpec) DeepCopy() *ListingSpec {
 func (in *ListingStatus) DeepCopyInto(out *ListingStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ExtendedMetadata != nil {
 		in, out := &in.ExtendedMetadata, &out.ExtendedMetadata
 		*out = make(map[string]string, len(*in))
@@ -1605,6 +1648,13 @@ func (in *TermSpec) DeepCopy() *TermSpec {
 func (in *TermStatus) DeepCopyInto(out *TermStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -1778,6 +1828,13 @@ func (in *TermVersionSpec) DeepCopy() *TermVersionSpec {
 func (in *TermVersionStatus) DeepCopyInto(out *TermVersionStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.Attachment = in.Attachment
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
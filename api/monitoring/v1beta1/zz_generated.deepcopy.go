@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -143,6 +144,13 @@ func (in *AlarmSpec) DeepCopy() *AlarmSpec {
 func (in *AlarmStatus) DeepCopyInto(out *AlarmStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Destinations != nil {
 		in, out := &in.Destinations, &out.Destinations
 		*out = make([]string, len(*in))
@@ -322,6 +330,13 @@ func (in *AlarmSuppressionSpec) DeepCopy() *AlarmSuppressionSpec {
 func (in *AlarmSuppressionStatus) DeepCopyInto(out *AlarmSuppressionStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.AlarmSuppressionTarget = in.AlarmSuppressionTarget
 	if in.SuppressionConditions != nil {
 		in, out := &in.SuppressionConditions, &out.SuppressionConditions
@@ -156,6 +156,9 @@ type DbSystemSpec struct {
 	ReadEndpoint DbSystemReadEndpoint `json:"readEndpoint,omitempty"`
 	// +kubebuilder:validation:Optional
 	TelemetryConfiguration DbSystemTelemetryConfiguration `json:"telemetryConfiguration,omitempty"`
+	// WriteConnectionSecretToRef configures the Secret that OSOK writes the connection details to.
+	// +kubebuilder:validation:Optional
+	WriteConnectionSecretToRef *shared.ConnectionSecretTarget `json:"writeConnectionSecretToRef,omitempty"`
 }
 
 // DbSystemRest defines nested fields for DbSystem.Rest.
@@ -722,6 +725,13 @@ type DbSystemPointInTimeRecoveryDetails struct {
 // DbSystemStatus defines the observed state of DbSystem.
 type DbSystemStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the DB System.
 	Id string `json:"id,omitempty"`
 	// The user-friendly name for the DB System. It does not have to be unique.
@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -711,6 +712,11 @@ func (in *DbSystemSpec) DeepCopyInto(out *DbSystemSpec) {
 	}
 	in.ReadEndpoint.DeepCopyInto(&out.ReadEndpoint)
 	in.TelemetryConfiguration.DeepCopyInto(&out.TelemetryConfiguration)
+	if in.WriteConnectionSecretToRef != nil {
+		in, out := &in.WriteConnectionSecretToRef, &out.WriteConnectionSecretToRef
+		*out = new(shared.ConnectionSecretTarget)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DbSystemSpec.
@@ -727,6 +733,13 @@ func (in *DbSystemSpec) DeepCopy() *DbSystemSpec {
 func (in *DbSystemStatus) DeepCopyInto(out *DbSystemStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.DataStorage = in.DataStorage
 	in.Maintenance.DeepCopyInto(&out.Maintenance)
 	out.DeletionPolicy = in.DeletionPolicy
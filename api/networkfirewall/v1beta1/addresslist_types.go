@@ -33,6 +33,13 @@ type AddressListSpec struct {
 // AddressListStatus defines the observed state of AddressList.
 type AddressListStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Unique name to identify the group of addresses to be used in the policy rules.
 	Name string `json:"name,omitempty"`
 	// Type of address List. The accepted values are - * FQDN * IP
@@ -57,7 +57,14 @@ type DecryptionProfileSpec struct {
 // DecryptionProfileStatus defines the observed state of DecryptionProfile.
 type DecryptionProfileStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
-	JsonData   string            `json:"jsonData,omitempty"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+	JsonData           string `json:"jsonData,omitempty"`
 	// The description of the decryption profile. This field can be used to add additional info.
 	Description string `json:"description,omitempty"`
 	// Unique Name of the decryption profile.
@@ -60,6 +60,13 @@ type DecryptionRulePosition struct {
 // DecryptionRuleStatus defines the observed state of DecryptionRule.
 type DecryptionRuleStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Name for the decryption rule, must be unique within the policy.
 	Name      string                  `json:"name,omitempty"`
 	Condition DecryptionRuleCondition `json:"condition,omitempty"`
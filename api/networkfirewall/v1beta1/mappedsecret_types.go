@@ -40,7 +40,14 @@ type MappedSecretSpec struct {
 // MappedSecretStatus defines the observed state of MappedSecret.
 type MappedSecretStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
-	JsonData   string            `json:"jsonData,omitempty"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+	JsonData           string `json:"jsonData,omitempty"`
 	// The description of the mapped secret. This field can be used to add additional info.
 	Description string `json:"description,omitempty"`
 	// Name of the secret.
@@ -60,7 +60,14 @@ type NatRuleCondition struct {
 // NatRuleStatus defines the observed state of NatRule.
 type NatRuleStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
-	JsonData   string            `json:"jsonData,omitempty"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+	JsonData           string `json:"jsonData,omitempty"`
 	// Description of a NAT rule. This field can be used to add additional info.
 	Description string `json:"description,omitempty"`
 	// The priority order in which this rule should be evaluated
@@ -70,6 +70,13 @@ type SecurityRulePosition struct {
 // SecurityRuleStatus defines the observed state of SecurityRule.
 type SecurityRuleStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Name for the Security rule, must be unique within the policy.
 	Name      string                `json:"name,omitempty"`
 	Condition SecurityRuleCondition `json:"condition,omitempty"`
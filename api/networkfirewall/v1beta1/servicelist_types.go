@@ -28,6 +28,13 @@ type ServiceListSpec struct {
 // ServiceListStatus defines the observed state of ServiceList.
 type ServiceListStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Name of the service Group.
 	Name string `json:"name,omitempty"`
 	// List of services in the group.
@@ -67,7 +67,14 @@ type TunnelInspectionRuleProfile struct {
 // TunnelInspectionRuleStatus defines the observed state of TunnelInspectionRule.
 type TunnelInspectionRuleStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
-	JsonData   string            `json:"jsonData,omitempty"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+	JsonData           string `json:"jsonData,omitempty"`
 	// Types of Inspect Action on the Traffic flow.
 	//   * INSPECT - Inspect the traffic.
 	//   * INSPECT_AND_CAPTURE_LOG - Inspect and capture logs for the traffic.
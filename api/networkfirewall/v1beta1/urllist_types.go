@@ -43,6 +43,13 @@ type UrlListUrl struct {
 // UrlListStatus defines the observed state of UrlList.
 type UrlListStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Unique name identifier for the URL list.
 	Name string `json:"name,omitempty"`
 	// List of urls.
@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -97,6 +98,13 @@ func (in *AddressListSpec) DeepCopy() *AddressListSpec {
 func (in *AddressListStatus) DeepCopyInto(out *AddressListStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Addresses != nil {
 		in, out := &in.Addresses, &out.Addresses
 		*out = make([]string, len(*in))
@@ -224,6 +232,13 @@ func (in *ApplicationGroupSpec) DeepCopy() *ApplicationGroupSpec {
 func (in *ApplicationGroupStatus) DeepCopyInto(out *ApplicationGroupStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Apps != nil {
 		in, out := &in.Apps, &out.Apps
 		*out = make([]string, len(*in))
@@ -292,6 +307,13 @@ func (in *ApplicationSpec) DeepCopy() *ApplicationSpec {
 func (in *ApplicationStatus) DeepCopyInto(out *ApplicationStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationStatus.
@@ -382,6 +404,13 @@ func (in *DecryptionProfileSpec) DeepCopy() *DecryptionProfileSpec {
 func (in *DecryptionProfileStatus) DeepCopyInto(out *DecryptionProfileStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DecryptionProfileStatus.
@@ -514,6 +543,13 @@ func (in *DecryptionRuleSpec) DeepCopy() *DecryptionRuleSpec {
 func (in *DecryptionRuleStatus) DeepCopyInto(out *DecryptionRuleStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.Condition.DeepCopyInto(&out.Condition)
 	out.Position = in.Position
 }
@@ -606,6 +642,13 @@ func (in *MappedSecretSpec) DeepCopy() *MappedSecretSpec {
 func (in *MappedSecretStatus) DeepCopyInto(out *MappedSecretStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MappedSecretStatus.
@@ -738,6 +781,13 @@ func (in *NatRuleSpec) DeepCopy() *NatRuleSpec {
 func (in *NatRuleStatus) DeepCopyInto(out *NatRuleStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.Position = in.Position
 	in.Condition.DeepCopyInto(&out.Condition)
 }
@@ -929,6 +979,13 @@ func (in *NetworkFirewallPolicySpec) DeepCopy() *NetworkFirewallPolicySpec {
 func (in *NetworkFirewallPolicyStatus) DeepCopyInto(out *NetworkFirewallPolicyStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -1034,6 +1091,13 @@ func (in *NetworkFirewallSpec) DeepCopy() *NetworkFirewallSpec {
 func (in *NetworkFirewallStatus) DeepCopyInto(out *NetworkFirewallStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -1230,6 +1294,13 @@ func (in *SecurityRuleSpec) DeepCopy() *SecurityRuleSpec {
 func (in *SecurityRuleStatus) DeepCopyInto(out *SecurityRuleStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.Condition.DeepCopyInto(&out.Condition)
 	out.Position = in.Position
 }
@@ -1354,6 +1425,13 @@ func (in *ServiceListSpec) DeepCopy() *ServiceListSpec {
 func (in *ServiceListStatus) DeepCopyInto(out *ServiceListStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Services != nil {
 		in, out := &in.Services, &out.Services
 		*out = make([]string, len(*in))
@@ -1442,6 +1520,13 @@ func (in *ServiceSpec) DeepCopy() *ServiceSpec {
 func (in *ServiceStatus) DeepCopyInto(out *ServiceStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.PortRanges != nil {
 		in, out := &in.PortRanges, &out.PortRanges
 		*out = make([]ServicePortRange, len(*in))
@@ -1595,6 +1680,13 @@ func (in *TunnelInspectionRuleSpec) DeepCopy() *TunnelInspectionRuleSpec {
 func (in *TunnelInspectionRuleStatus) DeepCopyInto(out *TunnelInspectionRuleStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.Position = in.Position
 	in.Condition.DeepCopyInto(&out.Condition)
 	out.Profile = in.Profile
@@ -1693,6 +1785,13 @@ func (in *UrlListSpec) DeepCopy() *UrlListSpec {
 func (in *UrlListStatus) DeepCopyInto(out *UrlListStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Urls != nil {
 		in, out := &in.Urls, &out.Urls
 		*out = make([]UrlListUrl, len(*in))
@@ -65,6 +65,13 @@ type BackendSpec struct {
 // BackendStatus defines the observed state of Backend.
 type BackendStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The communication port for the backend server.
 	// Example: `8080`
 	Port int `json:"port,omitempty"`
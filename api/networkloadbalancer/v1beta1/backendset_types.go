@@ -171,6 +171,13 @@ type BackendSetBackend struct {
 // BackendSetStatus defines the observed state of BackendSet.
 type BackendSetStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// A user-friendly name for the backend set that must be unique and cannot be changed.
 	// Valid backend set names include only alphanumeric characters, dashes, and underscores. Backend set names cannot
 	// contain spaces. Avoid entering confidential information.
@@ -56,6 +56,13 @@ type ListenerSpec struct {
 // ListenerStatus defines the observed state of Listener.
 type ListenerStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// A friendly name for the listener. It must be unique and it cannot be changed.
 	// Example: `example_listener`
 	Name string `json:"name,omitempty"`
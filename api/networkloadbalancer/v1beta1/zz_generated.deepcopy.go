@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -208,6 +209,13 @@ func (in *BackendSetSpec) DeepCopy() *BackendSetSpec {
 func (in *BackendSetStatus) DeepCopyInto(out *BackendSetStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.HealthChecker.DeepCopyInto(&out.HealthChecker)
 	if in.Backends != nil {
 		in, out := &in.Backends, &out.Backends
@@ -245,6 +253,13 @@ func (in *BackendSpec) DeepCopy() *BackendSpec {
 func (in *BackendStatus) DeepCopyInto(out *BackendStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendStatus.
@@ -335,6 +350,13 @@ func (in *ListenerSpec) DeepCopy() *ListenerSpec {
 func (in *ListenerStatus) DeepCopyInto(out *ListenerStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerStatus.
@@ -625,6 +647,13 @@ func (in *NetworkLoadBalancerSpec) DeepCopy() *NetworkLoadBalancerSpec {
 func (in *NetworkLoadBalancerStatus) DeepCopyInto(out *NetworkLoadBalancerStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.IpAddresses != nil {
 		in, out := &in.IpAddresses, &out.IpAddresses
 		*out = make([]NetworkLoadBalancerIpAddress, len(*in))
@@ -74,6 +74,13 @@ type BucketSpec struct {
 // BucketStatus defines the observed state of Bucket.
 type BucketStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The Object Storage namespace in which the bucket resides.
 	Namespace string `json:"namespace,omitempty"`
 	// The name of the bucket. Avoid entering confidential information.
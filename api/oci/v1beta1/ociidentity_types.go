@@ -0,0 +1,125 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OCIIdentitySpec selects the OCI auth type and the inputs OSOK uses to build
+// OCI clients for the resources that use this identity. The fields mirror the
+// operator-wide AUTH_TYPE settings; sensitive values are read from Secrets.
+type OCIIdentitySpec struct {
+	// The OCI auth type. Defaults to user_principal when user fields or a config
+	// file are set, and to instance_principal otherwise.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=user_principal;instance_principal;instance_principal_with_certs;instance_principal_delegation_token;resource_principal;resource_principal_delegation_token;oke_workload_identity;security_token;workload_identity_federation;oauth_delegation_token
+	AuthType string `json:"authType,omitempty"`
+	// The OCI region, for example us-ashburn-1.
+	// +kubebuilder:validation:Optional
+	Region string `json:"region,omitempty"`
+	// The OCID of the tenancy.
+	// +kubebuilder:validation:Optional
+	Tenancy string `json:"tenancy,omitempty"`
+	// The OCID of the user, for user_principal.
+	// +kubebuilder:validation:Optional
+	User string `json:"user,omitempty"`
+	// The fingerprint of the API signing key, for user_principal.
+	// +kubebuilder:validation:Optional
+	Fingerprint string `json:"fingerprint,omitempty"`
+	// The Secret key that holds the PEM API signing key, for user_principal.
+	// +kubebuilder:validation:Optional
+	PrivateKey *SecretKeySelector `json:"privateKey,omitempty"`
+	// The Secret key that holds the passphrase of the API signing key, or of the
+	// leaf private key for instance_principal_with_certs.
+	// +kubebuilder:validation:Optional
+	Passphrase *SecretKeySelector `json:"passphrase,omitempty"`
+	// The path of an OCI config file mounted in the manager pod, for
+	// user_principal and security_token.
+	// +kubebuilder:validation:Optional
+	ConfigFilePath string `json:"configFilePath,omitempty"`
+	// The profile to read from the OCI config file.
+	// +kubebuilder:validation:Optional
+	ConfigFileProfile string `json:"configFileProfile,omitempty"`
+	// The Secret key that holds the delegation token, for
+	// instance_principal_delegation_token and resource_principal_delegation_token.
+	// +kubebuilder:validation:Optional
+	DelegationToken *SecretKeySelector `json:"delegationToken,omitempty"`
+	// The path of the leaf certificate mounted in the manager pod, for
+	// instance_principal_with_certs.
+	// +kubebuilder:validation:Optional
+	InstancePrincipalLeafCertificatePath string `json:"instancePrincipalLeafCertificatePath,omitempty"`
+	// The path of the leaf private key mounted in the manager pod, for
+	// instance_principal_with_certs.
+	// +kubebuilder:validation:Optional
+	InstancePrincipalLeafPrivateKeyPath string `json:"instancePrincipalLeafPrivateKeyPath,omitempty"`
+	// The paths of intermediate certificates mounted in the manager pod, for
+	// instance_principal_with_certs.
+	// +kubebuilder:validation:Optional
+	InstancePrincipalIntermediateCertificatePaths []string `json:"instancePrincipalIntermediateCertificatePaths,omitempty"`
+}
+
+// SecretKeySelector names one key of a Secret. Namespace is only honoured for
+// ClusterOCIIdentity; an OCIIdentity always reads Secrets from its own
+// namespace.
+type SecretKeySelector struct {
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// +kubebuilder:validation:Required
+	Key string `json:"key"`
+	// +kubebuilder:validation:Optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=ociid
+// +kubebuilder:printcolumn:name="AuthType",type="string",JSONPath=".spec.authType",priority=0
+// +kubebuilder:printcolumn:name="Region",type="string",JSONPath=".spec.region",priority=0
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",priority=0
+// OCIIdentity holds the OCI credentials used for OSOK resources in its
+// namespace. Resources select it with the oci.oracle.com/identity annotation;
+// an OCIIdentity named "default" applies to every resource in the namespace
+// that does not select one.
+type OCIIdentity struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec OCIIdentitySpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// OCIIdentityList contains a list of OCIIdentity.
+type OCIIdentityList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OCIIdentity `json:"items"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=clusterociid
+// +kubebuilder:printcolumn:name="AuthType",type="string",JSONPath=".spec.authType",priority=0
+// +kubebuilder:printcolumn:name="Region",type="string",JSONPath=".spec.region",priority=0
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",priority=0
+// ClusterOCIIdentity holds OCI credentials that resources in any namespace
+// select with the oci.oracle.com/cluster-identity annotation.
+type ClusterOCIIdentity struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec OCIIdentitySpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// ClusterOCIIdentityList contains a list of ClusterOCIIdentity.
+type ClusterOCIIdentityList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterOCIIdentity `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OCIIdentity{}, &OCIIdentityList{}, &ClusterOCIIdentity{}, &ClusterOCIIdentityList{})
+}
@@ -95,6 +95,13 @@ type EsxiHostDatastoreAttachment struct {
 // EsxiHostStatus defines the observed state of EsxiHost.
 type EsxiHostStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the ESXi host.
 	Id string `json:"id,omitempty"`
 	// A descriptive name for the ESXi host. Does not have to be unique, and it's changeable.
@@ -299,6 +299,13 @@ type SddcHcxOnPremLicense struct {
 // SddcStatus defines the observed state of Sddc.
 type SddcStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the SDDC.
 	Id string `json:"id,omitempty"`
 	// A descriptive name for the SDDC. It must be unique, start with a letter, and contain only letters, digits,
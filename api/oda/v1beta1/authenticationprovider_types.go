@@ -77,6 +77,13 @@ type AuthenticationProviderSpec struct {
 // AuthenticationProviderStatus defines the observed state of AuthenticationProvider.
 type AuthenticationProviderStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Unique immutable identifier that was assigned when the Authentication Provider was created.
 	Id string `json:"id,omitempty"`
 	// The grant type for the Authentication Provider.
@@ -33,6 +33,13 @@ type ImportedPackageSpec struct {
 // ImportedPackageStatus defines the observed state of ImportedPackage.
 type ImportedPackageStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// ID of the host instance.
 	OdaInstanceId string `json:"odaInstanceId,omitempty"`
 	// ID of the package.
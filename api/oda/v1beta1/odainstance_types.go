@@ -53,6 +53,13 @@ type OdaInstanceRestrictedOperation struct {
 // OdaInstanceStatus defines the observed state of OdaInstance.
 type OdaInstanceStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Unique immutable identifier that was assigned when the instance was created.
 	Id string `json:"id,omitempty"`
 	// Identifier of the compartment that the instance belongs to.
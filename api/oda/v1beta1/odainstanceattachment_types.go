@@ -51,6 +51,13 @@ type OdaInstanceAttachmentOwner struct {
 // OdaInstanceAttachmentStatus defines the observed state of OdaInstanceAttachment.
 type OdaInstanceAttachmentStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Unique immutable identifier that was assigned when the ODA instance attachment was created.
 	Id string `json:"id,omitempty"`
 	// The OCID of the ODA instance to which the attachment applies.
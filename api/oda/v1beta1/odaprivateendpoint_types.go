@@ -43,6 +43,13 @@ type OdaPrivateEndpointSpec struct {
 // OdaPrivateEndpointStatus defines the observed state of OdaPrivateEndpoint.
 type OdaPrivateEndpointStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) that was assigned when the ODA private endpoint was created.
 	Id string `json:"id,omitempty"`
 	// User-defined name for the ODA private endpoint. Avoid entering confidential information.
@@ -41,6 +41,13 @@ type OdaPrivateEndpointScanProxyScanListenerInfo struct {
 // OdaPrivateEndpointScanProxyStatus defines the observed state of OdaPrivateEndpointScanProxy.
 type OdaPrivateEndpointScanProxyStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the ODA Private Endpoint Scan Proxy.
 	Id string `json:"id,omitempty"`
 	// Type indicating whether Scan listener is specified by its FQDN or list of IPs
@@ -64,6 +64,13 @@ type SkillSpec struct {
 // SkillStatus defines the observed state of Skill.
 type SkillStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Unique immutable identifier that was assigned when the resource was created.
 	Id string `json:"id,omitempty"`
 	// The reource's name. The name can contain only letters, numbers, periods, and underscores. The name must begin with a letter.
@@ -34,6 +34,13 @@ type SkillParameterSpec struct {
 // SkillParameterStatus defines the observed state of SkillParameter.
 type SkillParameterStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The Parameter name.  This must be unique within the parent resource.
 	Name string `json:"name,omitempty"`
 	// The display name for the Parameter.
@@ -40,6 +40,13 @@ type TranslatorSpec struct {
 // TranslatorStatus defines the observed state of Translator.
 type TranslatorStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Unique immutable identifier that was assigned when the Translator was created.
 	Id string `json:"id,omitempty"`
 	// The Translation Service to use for this Translator.
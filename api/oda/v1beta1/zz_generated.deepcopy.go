@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -117,6 +118,13 @@ func (in *AuthenticationProviderSpec) DeepCopy() *AuthenticationProviderSpec {
 func (in *AuthenticationProviderStatus) DeepCopyInto(out *AuthenticationProviderStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -262,6 +270,13 @@ func (in *ChannelSpec) DeepCopy() *ChannelSpec {
 func (in *ChannelStatus) DeepCopyInto(out *ChannelStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -412,6 +427,13 @@ func (in *DigitalAssistantSpec) DeepCopy() *DigitalAssistantSpec {
 func (in *DigitalAssistantStatus) DeepCopyInto(out *DigitalAssistantStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.NativeLanguageTags != nil {
 		in, out := &in.NativeLanguageTags, &out.NativeLanguageTags
 		*out = make([]string, len(*in))
@@ -564,6 +586,13 @@ func (in *ImportedPackageSpec) DeepCopy() *ImportedPackageSpec {
 func (in *ImportedPackageStatus) DeepCopyInto(out *ImportedPackageStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ParameterValues != nil {
 		in, out := &in.ParameterValues, &out.ParameterValues
 		*out = make(map[string]string, len(*in))
@@ -759,6 +788,13 @@ func (in *OdaInstanceAttachmentSpec) DeepCopy() *OdaInstanceAttachmentSpec {
 func (in *OdaInstanceAttachmentStatus) DeepCopyInto(out *OdaInstanceAttachmentStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.RestrictedOperations != nil {
 		in, out := &in.RestrictedOperations, &out.RestrictedOperations
 		*out = make([]string, len(*in))
@@ -893,6 +929,13 @@ func (in *OdaInstanceSpec) DeepCopy() *OdaInstanceSpec {
 func (in *OdaInstanceStatus) DeepCopyInto(out *OdaInstanceStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -1060,6 +1103,13 @@ func (in *OdaPrivateEndpointAttachmentSpec) DeepCopy() *OdaPrivateEndpointAttach
 func (in *OdaPrivateEndpointAttachmentStatus) DeepCopyInto(out *OdaPrivateEndpointAttachmentStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OdaPrivateEndpointAttachmentStatus.
@@ -1202,6 +1252,13 @@ func (in *OdaPrivateEndpointScanProxySpec) DeepCopy() *OdaPrivateEndpointScanPro
 func (in *OdaPrivateEndpointScanProxyStatus) DeepCopyInto(out *OdaPrivateEndpointScanProxyStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ScanListenerInfos != nil {
 		in, out := &in.ScanListenerInfos, &out.ScanListenerInfos
 		*out = make([]OdaPrivateEndpointScanProxyScanListenerInfo, len(*in))
@@ -1268,6 +1325,13 @@ func (in *OdaPrivateEndpointSpec) DeepCopy() *OdaPrivateEndpointSpec {
 func (in *OdaPrivateEndpointStatus) DeepCopyInto(out *OdaPrivateEndpointStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.NsgIds != nil {
 		in, out := &in.NsgIds, &out.NsgIds
 		*out = make([]string, len(*in))
@@ -1447,6 +1511,13 @@ func (in *SkillParameterSpec) DeepCopy() *SkillParameterSpec {
 func (in *SkillParameterStatus) DeepCopyInto(out *SkillParameterStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SkillParameterStatus.
@@ -1508,6 +1579,13 @@ func (in *SkillSpec) DeepCopy() *SkillSpec {
 func (in *SkillStatus) DeepCopyInto(out *SkillStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.NativeLanguageTags != nil {
 		in, out := &in.NativeLanguageTags, &out.NativeLanguageTags
 		*out = make([]string, len(*in))
@@ -1660,6 +1738,13 @@ func (in *TranslatorSpec) DeepCopy() *TranslatorSpec {
 func (in *TranslatorStatus) DeepCopyInto(out *TranslatorStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Properties != nil {
 		in, out := &in.Properties, &out.Properties
 		*out = make(map[string]string, len(*in))
@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -299,6 +300,13 @@ func (in *OpensearchClusterSpec) DeepCopy() *OpensearchClusterSpec {
 func (in *OpensearchClusterStatus) DeepCopyInto(out *OpensearchClusterStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.AvailabilityDomains != nil {
 		in, out := &in.AvailabilityDomains, &out.AvailabilityDomains
 		*out = make([]string, len(*in))
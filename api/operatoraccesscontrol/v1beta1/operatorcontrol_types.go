@@ -60,6 +60,13 @@ type OperatorControlSpec struct {
 // OperatorControlStatus defines the observed state of OperatorControl.
 type OperatorControlStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the operator control.
 	Id string `json:"id,omitempty"`
 	// Name of the operator control. The name must be unique.
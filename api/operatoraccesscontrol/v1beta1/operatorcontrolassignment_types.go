@@ -73,6 +73,13 @@ type OperatorControlAssignmentSpec struct {
 // OperatorControlAssignmentStatus defines the observed state of OperatorControlAssignment.
 type OperatorControlAssignmentStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the operator control assignment.
 	Id string `json:"id,omitempty"`
 	// The OCID of the operator control.
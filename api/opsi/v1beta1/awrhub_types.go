@@ -39,6 +39,13 @@ type AwrHubSpec struct {
 // AwrHubStatus defines the observed state of AwrHub.
 type AwrHubStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// OPSI Warehouse OCID
 	OperationsInsightsWarehouseId string `json:"operationsInsightsWarehouseId,omitempty"`
 	// AWR Hub OCID
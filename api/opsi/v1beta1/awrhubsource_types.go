@@ -45,6 +45,13 @@ type AwrHubSourceSpec struct {
 // AwrHubSourceStatus defines the observed state of AwrHubSource.
 type AwrHubSourceStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The name of the Awr Hub source database.
 	Name string `json:"name,omitempty"`
 	// AWR Hub OCID
@@ -42,6 +42,13 @@ type ChargebackPlanReportReportProperties struct {
 // ChargebackPlanReportStatus defines the observed state of ChargebackPlanReport.
 type ChargebackPlanReportStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the Chargeback plan report.
 	ReportId string `json:"reportId,omitempty"`
 	// The chargeback plan report name.
@@ -151,7 +151,14 @@ type DatabaseInsightCredentialDetails struct {
 // DatabaseInsightStatus defines the observed state of DatabaseInsight.
 type DatabaseInsightStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
-	JsonData   string            `json:"jsonData,omitempty"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+	JsonData           string `json:"jsonData,omitempty"`
 	// Ops Insights internal representation of the database type.
 	DatabaseType string `json:"databaseType,omitempty"`
 	// The version of the database.
@@ -39,6 +39,13 @@ type EnterpriseManagerBridgeSpec struct {
 // EnterpriseManagerBridgeStatus defines the observed state of EnterpriseManagerBridge.
 type EnterpriseManagerBridgeStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Enterprise Manager bridge identifier
 	Id string `json:"id,omitempty"`
 	// Compartment identifier of the Enterprise Manager bridge
@@ -243,7 +243,14 @@ type ExadataInsightChargebackPlanDetails struct {
 // ExadataInsightStatus defines the observed state of ExadataInsight.
 type ExadataInsightStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
-	JsonData   string            `json:"jsonData,omitempty"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+	JsonData           string `json:"jsonData,omitempty"`
 	// The user-friendly name for the Exadata system. The name does not have to be unique.
 	ExadataDisplayName string `json:"exadataDisplayName,omitempty"`
 	// Operations Insights internal representation of the the Exadata system type.
@@ -52,7 +52,14 @@ type HostInsightSpec struct {
 // HostInsightStatus defines the observed state of HostInsight.
 type HostInsightStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
-	JsonData   string            `json:"jsonData,omitempty"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+	JsonData           string `json:"jsonData,omitempty"`
 	// The user-friendly name for the host. The name does not have to be unique.
 	HostDisplayName string `json:"hostDisplayName,omitempty"`
 	// Ops Insights internal representation of the host type. Possible value is EXTERNAL-HOST.
@@ -92,6 +92,13 @@ type NewsReportContentTypes struct {
 // NewsReportStatus defines the observed state of NewsReport.
 type NewsReportStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// News report frequency.
 	NewsFrequency string                 `json:"newsFrequency,omitempty"`
 	ContentTypes  NewsReportContentTypes `json:"contentTypes,omitempty"`
@@ -49,6 +49,13 @@ type OperationsInsightsPrivateEndpointSpec struct {
 // OperationsInsightsPrivateEndpointStatus defines the observed state of OperationsInsightsPrivateEndpoint.
 type OperationsInsightsPrivateEndpointStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the Private service accessed database.
 	Id string `json:"id,omitempty"`
 	// The display name of the private endpoint.
@@ -48,6 +48,13 @@ type OperationsInsightsWarehouseUserSpec struct {
 // OperationsInsightsWarehouseUserStatus defines the observed state of OperationsInsightsWarehouseUser.
 type OperationsInsightsWarehouseUserStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// OPSI Warehouse OCID
 	OperationsInsightsWarehouseId string `json:"operationsInsightsWarehouseId,omitempty"`
 	// Hub User OCID
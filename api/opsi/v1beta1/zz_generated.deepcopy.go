@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -176,6 +177,13 @@ func (in *AwrHubSourceSpec) DeepCopy() *AwrHubSourceSpec {
 func (in *AwrHubSourceStatus) DeepCopyInto(out *AwrHubSourceStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -275,6 +283,13 @@ func (in *AwrHubSpec) DeepCopy() *AwrHubSpec {
 func (in *AwrHubStatus) DeepCopyInto(out *AwrHubStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -499,6 +514,13 @@ func (in *ChargebackPlanReportSpec) DeepCopy() *ChargebackPlanReportSpec {
 func (in *ChargebackPlanReportStatus) DeepCopyInto(out *ChargebackPlanReportStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.ReportProperties.DeepCopyInto(&out.ReportProperties)
 }
 
@@ -561,6 +583,13 @@ func (in *ChargebackPlanSpec) DeepCopy() *ChargebackPlanSpec {
 func (in *ChargebackPlanStatus) DeepCopyInto(out *ChargebackPlanStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -790,6 +819,13 @@ func (in *DatabaseInsightSpec) DeepCopy() *DatabaseInsightSpec {
 func (in *DatabaseInsightStatus) DeepCopyInto(out *DatabaseInsightStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.SystemTags != nil {
 		in, out := &in.SystemTags, &out.SystemTags
 		*out = make(map[string]shared.MapValue, len(*in))
@@ -957,6 +993,13 @@ func (in *EnterpriseManagerBridgeSpec) DeepCopy() *EnterpriseManagerBridgeSpec {
 func (in *EnterpriseManagerBridgeStatus) DeepCopyInto(out *EnterpriseManagerBridgeStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -1366,6 +1409,13 @@ func (in *ExadataInsightSpec) DeepCopy() *ExadataInsightSpec {
 func (in *ExadataInsightStatus) DeepCopyInto(out *ExadataInsightStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.ChargebackPlanDetails = in.ChargebackPlanDetails
 	if in.SystemTags != nil {
 		in, out := &in.SystemTags, &out.SystemTags
@@ -1525,6 +1575,13 @@ func (in *HostInsightSpec) DeepCopy() *HostInsightSpec {
 func (in *HostInsightStatus) DeepCopyInto(out *HostInsightStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.SystemTags != nil {
 		in, out := &in.SystemTags, &out.SystemTags
 		*out = make(map[string]shared.MapValue, len(*in))
@@ -1744,6 +1801,13 @@ func (in *NewsReportSpec) DeepCopy() *NewsReportSpec {
 func (in *NewsReportStatus) DeepCopyInto(out *NewsReportStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.ContentTypes.DeepCopyInto(&out.ContentTypes)
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
@@ -1913,6 +1977,13 @@ func (in *OperationsInsightsPrivateEndpointSpec) DeepCopy() *OperationsInsightsP
 func (in *OperationsInsightsPrivateEndpointStatus) DeepCopyInto(out *OperationsInsightsPrivateEndpointStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.NsgIds != nil {
 		in, out := &in.NsgIds, &out.NsgIds
 		*out = make([]string, len(*in))
@@ -2076,6 +2147,13 @@ func (in *OperationsInsightsWarehouseSpec) DeepCopy() *OperationsInsightsWarehou
 func (in *OperationsInsightsWarehouseStatus) DeepCopyInto(out *OperationsInsightsWarehouseStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -2234,6 +2312,13 @@ func (in *OperationsInsightsWarehouseUserSpec) DeepCopy() *OperationsInsightsWar
 func (in *OperationsInsightsWarehouseUserStatus) DeepCopyInto(out *OperationsInsightsWarehouseUserStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -2410,6 +2495,13 @@ func (in *OpsiConfigurationSpec) DeepCopy() *OpsiConfigurationSpec {
 func (in *OpsiConfigurationStatus) DeepCopyInto(out *OpsiConfigurationStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -102,6 +102,13 @@ type ProfileTargetTags struct {
 // ProfileStatus defines the observed state of Profile.
 type ProfileStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The unique OCID of the profile.
 	Id string `json:"id,omitempty"`
 	// The OCID of the tenancy. The tenancy is the root compartment.
@@ -165,7 +165,14 @@ type SoftwareSourceCustomSoftwareSourceFilter struct {
 // SoftwareSourceStatus defines the observed state of SoftwareSource.
 type SoftwareSourceStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
-	JsonData   string            `json:"jsonData,omitempty"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+	JsonData           string `json:"jsonData,omitempty"`
 	// User-specified description for the software source.
 	Description string `json:"description,omitempty"`
 	// The current state of the software source.
@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -209,6 +210,13 @@ func (in *LifecycleEnvironmentStageSoftwareSourceId) DeepCopy() *LifecycleEnviro
 func (in *LifecycleEnvironmentStatus) DeepCopyInto(out *LifecycleEnvironmentStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Stages != nil {
 		in, out := &in.Stages, &out.Stages
 		*out = make([]LifecycleEnvironmentStage, len(*in))
@@ -435,6 +443,13 @@ func (in *ManagedInstanceGroupSpec) DeepCopy() *ManagedInstanceGroupSpec {
 func (in *ManagedInstanceGroupStatus) DeepCopyInto(out *ManagedInstanceGroupStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.SoftwareSourceIds != nil {
 		in, out := &in.SoftwareSourceIds, &out.SoftwareSourceIds
 		*out = make([]ManagedInstanceGroupSoftwareSourceId, len(*in))
@@ -691,6 +706,13 @@ func (in *ManagementStationSpec) DeepCopy() *ManagementStationSpec {
 func (in *ManagementStationStatus) DeepCopyInto(out *ManagementStationStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.Proxy.DeepCopyInto(&out.Proxy)
 	out.Mirror = in.Mirror
 	if in.PeerManagementStations != nil {
@@ -923,6 +945,13 @@ func (in *ProfileSpec) DeepCopy() *ProfileSpec {
 func (in *ProfileStatus) DeepCopyInto(out *ProfileStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -1268,6 +1297,13 @@ func (in *ScheduledJobSpec) DeepCopy() *ScheduledJobSpec {
 func (in *ScheduledJobStatus) DeepCopyInto(out *ScheduledJobStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Operations != nil {
 		in, out := &in.Operations, &out.Operations
 		*out = make([]ScheduledJobOperation, len(*in))
@@ -1561,6 +1597,13 @@ func (in *SoftwareSourceSpec) DeepCopy() *SoftwareSourceSpec {
 func (in *SoftwareSourceStatus) DeepCopyInto(out *SoftwareSourceStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
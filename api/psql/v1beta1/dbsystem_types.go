@@ -258,6 +258,13 @@ type DbSystemInstance struct {
 // DbSystemStatus defines the observed state of DbSystem.
 type DbSystemStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// A unique identifier for the database system. Immutable on creation.
 	Id string `json:"id,omitempty"`
 	// A user-friendly display name for the database system. Avoid entering confidential information.
@@ -49,6 +49,9 @@ type QueueSpec struct {
 	// Example: `{"foo-namespace": {"bar-key": "value"}}`
 	// +kubebuilder:validation:Optional
 	DefinedTags map[string]shared.MapValue `json:"definedTags,omitempty"`
+	// WriteConnectionSecretToRef configures the Secret that OSOK writes the connection details to.
+	// +kubebuilder:validation:Optional
+	WriteConnectionSecretToRef *shared.ConnectionSecretTarget `json:"writeConnectionSecretToRef,omitempty"`
 }
 
 // QueueCapability defines nested fields for Queue.Capability.
@@ -77,6 +80,13 @@ type QueueCapability struct {
 // QueueStatus defines the observed state of Queue.
 type QueueStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// A unique identifier for the queue that is immutable on creation.
 	Id string `json:"id,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the compartment containing the queue.
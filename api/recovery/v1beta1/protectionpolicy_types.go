@@ -52,6 +52,13 @@ type ProtectionPolicySpec struct {
 // ProtectionPolicyStatus defines the observed state of ProtectionPolicy.
 type ProtectionPolicyStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The protection policy OCID.
 	Id string `json:"id,omitempty"`
 	// The OCID of the compartment that contains the protection policy.
@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -152,6 +153,13 @@ func (in *ProtectedDatabaseSpec) DeepCopy() *ProtectedDatabaseSpec {
 func (in *ProtectedDatabaseStatus) DeepCopyInto(out *ProtectedDatabaseStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.RecoveryServiceSubnets != nil {
 		in, out := &in.RecoveryServiceSubnets, &out.RecoveryServiceSubnets
 		*out = make([]ProtectedDatabaseRecoveryServiceSubnet, len(*in))
@@ -316,6 +324,13 @@ func (in *ProtectionPolicySpec) DeepCopy() *ProtectionPolicySpec {
 func (in *ProtectionPolicyStatus) DeepCopyInto(out *ProtectionPolicyStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -484,6 +499,13 @@ func (in *RecoveryServiceSubnetSpec) DeepCopy() *RecoveryServiceSubnetSpec {
 func (in *RecoveryServiceSubnetStatus) DeepCopyInto(out *RecoveryServiceSubnetStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Subnets != nil {
 		in, out := &in.Subnets, &out.Subnets
 		*out = make([]string, len(*in))
@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -92,6 +93,13 @@ func (in *MonitoredRegionSpec) DeepCopy() *MonitoredRegionSpec {
 func (in *MonitoredRegionStatus) DeepCopyInto(out *MonitoredRegionStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.SystemTags != nil {
 		in, out := &in.SystemTags, &out.SystemTags
 		*out = make(map[string]shared.MapValue, len(*in))
@@ -246,6 +254,13 @@ func (in *ResourceAnalyticsInstanceSpec) DeepCopy() *ResourceAnalyticsInstanceSp
 func (in *ResourceAnalyticsInstanceStatus) DeepCopyInto(out *ResourceAnalyticsInstanceStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -379,6 +394,13 @@ func (in *TenancyAttachmentSpec) DeepCopy() *TenancyAttachmentSpec {
 func (in *TenancyAttachmentStatus) DeepCopyInto(out *TenancyAttachmentStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.SystemTags != nil {
 		in, out := &in.SystemTags, &out.SystemTags
 		*out = make(map[string]shared.MapValue, len(*in))
@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -133,6 +134,13 @@ func (in *ConfigurationSourceProviderSpec) DeepCopy() *ConfigurationSourceProvid
 func (in *ConfigurationSourceProviderStatus) DeepCopyInto(out *ConfigurationSourceProviderStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.PrivateServerConfigDetails = in.PrivateServerConfigDetails
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
@@ -320,6 +328,13 @@ func (in *PrivateEndpointSpec) DeepCopy() *PrivateEndpointSpec {
 func (in *PrivateEndpointStatus) DeepCopyInto(out *PrivateEndpointStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.SourceIps != nil {
 		in, out := &in.SourceIps, &out.SourceIps
 		*out = make([]string, len(*in))
@@ -555,6 +570,13 @@ func (in *StackSpec) DeepCopy() *StackSpec {
 func (in *StackStatus) DeepCopyInto(out *StackStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.ConfigSource.DeepCopyInto(&out.ConfigSource)
 	out.CustomTerraformProvider = in.CustomTerraformProvider
 	if in.Variables != nil {
@@ -738,6 +760,13 @@ func (in *TemplateSpec) DeepCopy() *TemplateSpec {
 func (in *TemplateStatus) DeepCopyInto(out *TemplateStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.TemplateConfigSource = in.TemplateConfigSource
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -265,6 +266,13 @@ func (in *ServiceConnectorSpec) DeepCopy() *ServiceConnectorSpec {
 func (in *ServiceConnectorStatus) DeepCopyInto(out *ServiceConnectorStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.Source.DeepCopyInto(&out.Source)
 	if in.Tasks != nil {
 		in, out := &in.Tasks, &out.Tasks
@@ -43,6 +43,13 @@ type SecurityAttributeValidator struct {
 // SecurityAttributeStatus defines the observed state of SecurityAttribute.
 type SecurityAttributeStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the compartment that contains the security attribute definition.
 	CompartmentId string `json:"compartmentId,omitempty"`
 	// The OCID of the security attribute namespace that contains the security attribute definition.
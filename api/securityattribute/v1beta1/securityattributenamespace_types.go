@@ -42,6 +42,13 @@ type SecurityAttributeNamespaceSpec struct {
 // SecurityAttributeNamespaceStatus defines the observed state of SecurityAttributeNamespace.
 type SecurityAttributeNamespaceStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of the security attribute namespace.
 	Id string `json:"id,omitempty"`
 	// The OCID of the compartment that contains the namespace.
@@ -36,6 +36,13 @@ type ServiceCatalogSpec struct {
 // ServiceCatalogStatus defines the observed state of ServiceCatalog.
 type ServiceCatalogStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The unique identifier for the Service catalog.
 	Id string `json:"id,omitempty"`
 	// The Compartment id where the service catalog exists
@@ -67,6 +67,13 @@ type AlarmConditionCondition struct {
 // AlarmConditionStatus defines the observed state of AlarmCondition.
 type AlarmConditionStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the Alarm Condition.
 	Id string `json:"id,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the monitoring template.
@@ -80,7 +87,8 @@ type AlarmConditionStatus struct {
 	// Type of defined monitoring template.
 	ConditionType string `json:"conditionType,omitempty"`
 	// Monitoring template conditions
-	Conditions []AlarmConditionCondition `json:"conditions,omitempty"`
+	// This uses a distinct JSON name so it can coexist with the OSOK status envelope.
+	SdkConditions []AlarmConditionCondition `json:"sdkConditions,omitempty"`
 	// The current status of the monitoring template i.e. whether it is Published or Unpublished
 	// This uses a distinct JSON name so it can coexist with the OSOK status envelope.
 	Status string `json:"sdkStatus,omitempty"`
@@ -61,6 +61,13 @@ type BaselineableMetricSpec struct {
 // BaselineableMetricStatus defines the observed state of BaselineableMetric.
 type BaselineableMetricStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// OCID of the metric
 	Id string `json:"id,omitempty"`
 	// name of the metric
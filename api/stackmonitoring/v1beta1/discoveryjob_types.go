@@ -105,6 +105,13 @@ type DiscoveryJobDiscoveryDetails struct {
 // DiscoveryJobStatus defines the observed state of DiscoveryJob.
 type DiscoveryJobStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID of Discovery job
 	Id string `json:"id,omitempty"`
 	// The OCID of the Compartment
@@ -94,6 +94,13 @@ type MaintenanceWindowResourcesDetail struct {
 // MaintenanceWindowStatus defines the observed state of MaintenanceWindow.
 type MaintenanceWindowStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of maintenance window.
 	Id string `json:"id,omitempty"`
 	// Maintenance Window name.
@@ -177,6 +177,13 @@ type MonitoredResourceTypeHandlerConfig struct {
 // MonitoredResourceTypeStatus defines the observed state of MonitoredResourceType.
 type MonitoredResourceTypeStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Monitored resource type identifier OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm).
 	Id string `json:"id,omitempty"`
 	// A unique monitored resource type name. The name must be unique across tenancy.
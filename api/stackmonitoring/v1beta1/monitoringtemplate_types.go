@@ -67,6 +67,13 @@ type MonitoringTemplateMember struct {
 // MonitoringTemplateStatus defines the observed state of MonitoringTemplate.
 type MonitoringTemplateStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the monitoringTemplate
 	Id string `json:"id,omitempty"`
 	// A user-friendly name for the monitoring template. It should be unique, and it's mutable in nature. Avoid entering confidential information.
@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -139,6 +140,13 @@ func (in *AlarmConditionStatus) DeepCopyInto(out *AlarmConditionStatus) {
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SdkConditions != nil {
+		in, out := &in.SdkConditions, &out.SdkConditions
 		*out = make([]AlarmConditionCondition, len(*in))
 		copy(*out, *in)
 	}
@@ -318,6 +326,13 @@ func (in *BaselineableMetricSpec) DeepCopy() *BaselineableMetricSpec {
 func (in *BaselineableMetricStatus) DeepCopyInto(out *BaselineableMetricStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -529,6 +544,13 @@ func (in *ConfigSpec) DeepCopy() *ConfigSpec {
 func (in *ConfigStatus) DeepCopyInto(out *ConfigStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
 		*out = make(map[string]string, len(*in))
@@ -841,6 +863,13 @@ func (in *DiscoveryJobSpec) DeepCopy() *DiscoveryJobSpec {
 func (in *DiscoveryJobStatus) DeepCopyInto(out *DiscoveryJobStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.DiscoveryDetails.DeepCopyInto(&out.DiscoveryDetails)
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
@@ -1051,6 +1080,13 @@ func (in *MaintenanceWindowSpec) DeepCopy() *MaintenanceWindowSpec {
 func (in *MaintenanceWindowStatus) DeepCopyInto(out *MaintenanceWindowStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
 		*out = make([]MaintenanceWindowResource, len(*in))
@@ -1314,6 +1350,13 @@ func (in *MetricExtensionSpec) DeepCopy() *MetricExtensionSpec {
 func (in *MetricExtensionStatus) DeepCopyInto(out *MetricExtensionStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.MetricList != nil {
 		in, out := &in.MetricList, &out.MetricList
 		*out = make([]MetricExtensionMetricList, len(*in))
@@ -1622,6 +1665,13 @@ func (in *MonitoredResourceSpec) DeepCopy() *MonitoredResourceSpec {
 func (in *MonitoredResourceStatus) DeepCopyInto(out *MonitoredResourceStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Properties != nil {
 		in, out := &in.Properties, &out.Properties
 		*out = make([]MonitoredResourceProperty, len(*in))
@@ -2020,6 +2070,13 @@ func (in *MonitoredResourceTypeSpec) DeepCopy() *MonitoredResourceTypeSpec {
 func (in *MonitoredResourceTypeStatus) DeepCopyInto(out *MonitoredResourceTypeStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.AvailabilityMetricsConfig.DeepCopyInto(&out.AvailabilityMetricsConfig)
 	in.HandlerConfig.DeepCopyInto(&out.HandlerConfig)
 	if in.AdditionalNamespaceMap != nil {
@@ -2213,6 +2270,13 @@ func (in *MonitoringTemplateSpec) DeepCopy() *MonitoringTemplateSpec {
 func (in *MonitoringTemplateStatus) DeepCopyInto(out *MonitoringTemplateStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Destinations != nil {
 		in, out := &in.Destinations, &out.Destinations
 		*out = make([]string, len(*in))
@@ -2417,6 +2481,13 @@ func (in *ProcessSetSpecificationItem) DeepCopy() *ProcessSetSpecificationItem {
 func (in *ProcessSetStatus) DeepCopyInto(out *ProcessSetStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.Specification.DeepCopyInto(&out.Specification)
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
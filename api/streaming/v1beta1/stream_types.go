@@ -40,11 +40,21 @@ type StreamSpec struct {
 	// Example: `{"Operations": {"CostCenter": "42"}}`
 	// +kubebuilder:validation:Optional
 	DefinedTags map[string]shared.MapValue `json:"definedTags,omitempty"`
+	// WriteConnectionSecretToRef configures the Secret that OSOK writes the connection details to.
+	// +kubebuilder:validation:Optional
+	WriteConnectionSecretToRef *shared.ConnectionSecretTarget `json:"writeConnectionSecretToRef,omitempty"`
 }
 
 // StreamStatus defines the observed state of Stream.
 type StreamStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The name of the stream. Avoid entering confidential information.
 	// Example: `TelemetryEvents`
 	Name string `json:"name,omitempty"`
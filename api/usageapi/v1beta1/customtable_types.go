@@ -72,6 +72,13 @@ type CustomTableSavedCustomTable struct {
 // CustomTableStatus defines the observed state of CustomTable.
 type CustomTableStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The custom table OCID.
 	Id string `json:"id,omitempty"`
 	// The custom table associated saved report OCID.
@@ -168,6 +168,13 @@ type ScheduleQueryProperties struct {
 // ScheduleStatus defines the observed state of Schedule.
 type ScheduleStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID representing a unique shedule.
 	Id string `json:"id,omitempty"`
 	// The unique name of the schedule created by the user.
@@ -142,6 +142,13 @@ type UsageCarbonEmissionsQueryQueryDefinition struct {
 // UsageCarbonEmissionsQueryStatus defines the observed state of UsageCarbonEmissionsQuery.
 type UsageCarbonEmissionsQueryStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The query OCID.
 	Id string `json:"id,omitempty"`
 	// The compartment OCID.
@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -138,6 +139,13 @@ func (in *CustomTableSpec) DeepCopy() *CustomTableSpec {
 func (in *CustomTableStatus) DeepCopyInto(out *CustomTableStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.SavedCustomTable.DeepCopyInto(&out.SavedCustomTable)
 }
 
@@ -374,6 +382,13 @@ func (in *QuerySpec) DeepCopy() *QuerySpec {
 func (in *QueryStatus) DeepCopyInto(out *QueryStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.QueryDefinition.DeepCopyInto(&out.QueryDefinition)
 }
 
@@ -619,6 +634,13 @@ func (in *ScheduleSpec) DeepCopy() *ScheduleSpec {
 func (in *ScheduleStatus) DeepCopyInto(out *ScheduleStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.ResultLocation = in.ResultLocation
 	in.QueryProperties.DeepCopyInto(&out.QueryProperties)
 	if in.FreeformTags != nil {
@@ -883,6 +905,13 @@ func (in *UsageCarbonEmissionsQuerySpec) DeepCopy() *UsageCarbonEmissionsQuerySp
 func (in *UsageCarbonEmissionsQueryStatus) DeepCopyInto(out *UsageCarbonEmissionsQueryStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.QueryDefinition.DeepCopyInto(&out.QueryDefinition)
 }
 
@@ -45,6 +45,13 @@ type ContainerScanRecipeScanSettings struct {
 // ContainerScanRecipeStatus defines the observed state of ContainerScanRecipe.
 type ContainerScanRecipeStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of container scan recipe. Immutable and generated on creation.
 	Id string `json:"id,omitempty"`
 	// User friendly name of container scan recipe
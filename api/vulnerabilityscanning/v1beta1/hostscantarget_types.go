@@ -45,6 +45,13 @@ type HostScanTargetSpec struct {
 // HostScanTargetStatus defines the observed state of HostScanTarget.
 type HostScanTargetStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Unique identifier of the config that is immutable on creation
 	Id string `json:"id,omitempty"`
 	// Target identifier, which can be renamed.
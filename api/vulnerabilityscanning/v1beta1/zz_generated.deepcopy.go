@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -133,6 +134,13 @@ func (in *ContainerScanRecipeSpec) DeepCopy() *ContainerScanRecipeSpec {
 func (in *ContainerScanRecipeStatus) DeepCopyInto(out *ContainerScanRecipeStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.ScanSettings = in.ScanSettings
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
@@ -293,6 +301,13 @@ func (in *ContainerScanTargetSpec) DeepCopy() *ContainerScanTargetSpec {
 func (in *ContainerScanTargetStatus) DeepCopyInto(out *ContainerScanTargetStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.TargetRegistry.DeepCopyInto(&out.TargetRegistry)
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
@@ -604,6 +619,13 @@ func (in *HostScanRecipeSpec) DeepCopy() *HostScanRecipeSpec {
 func (in *HostScanRecipeStatus) DeepCopyInto(out *HostScanRecipeStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.PortSettings = in.PortSettings
 	out.AgentSettings = in.AgentSettings
 	out.Schedule = in.Schedule
@@ -771,6 +793,13 @@ func (in *HostScanTargetSpec) DeepCopy() *HostScanTargetSpec {
 func (in *HostScanTargetStatus) DeepCopyInto(out *HostScanTargetStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.InstanceIds != nil {
 		in, out := &in.InstanceIds, &out.InstanceIds
 		*out = make([]string, len(*in))
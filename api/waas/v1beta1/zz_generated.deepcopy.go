@@ -11,6 +11,7 @@ package v1beta1
 
 import (
 	"github.com/oracle/oci-service-operator/pkg/shared"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -122,6 +123,13 @@ func (in *AddressListSpec) DeepCopy() *AddressListSpec {
 func (in *AddressListStatus) DeepCopyInto(out *AddressListStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Addresses != nil {
 		in, out := &in.Addresses, &out.Addresses
 		*out = make([]string, len(*in))
@@ -312,6 +320,13 @@ func (in *CertificateSpec) DeepCopy() *CertificateSpec {
 func (in *CertificateStatus) DeepCopyInto(out *CertificateStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.SubjectName = in.SubjectName
 	out.IssuerName = in.IssuerName
 	out.PublicKeyInfo = in.PublicKeyInfo
@@ -475,6 +490,13 @@ func (in *CustomProtectionRuleSpec) DeepCopy() *CustomProtectionRuleSpec {
 func (in *CustomProtectionRuleStatus) DeepCopyInto(out *CustomProtectionRuleStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ModSecurityRuleIds != nil {
 		in, out := &in.ModSecurityRuleIds, &out.ModSecurityRuleIds
 		*out = make([]string, len(*in))
@@ -621,6 +643,13 @@ func (in *HttpRedirectSpec) DeepCopy() *HttpRedirectSpec {
 func (in *HttpRedirectStatus) DeepCopyInto(out *HttpRedirectStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.Target = in.Target
 	if in.FreeformTags != nil {
 		in, out := &in.FreeformTags, &out.FreeformTags
@@ -937,6 +966,13 @@ func (in *WaasPolicySpec) DeepCopy() *WaasPolicySpec {
 func (in *WaasPolicyStatus) DeepCopyInto(out *WaasPolicyStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.AdditionalDomains != nil {
 		in, out := &in.AdditionalDomains, &out.AdditionalDomains
 		*out = make([]string, len(*in))
@@ -47,7 +47,14 @@ type WebAppFirewallSpec struct {
 // WebAppFirewallStatus defines the observed state of WebAppFirewall.
 type WebAppFirewallStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
-	JsonData   string            `json:"jsonData,omitempty"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+	JsonData           string `json:"jsonData,omitempty"`
 	// The time the WebAppFirewall was updated. An RFC3339 formatted datetime string.
 	TimeUpdated string `json:"timeUpdated,omitempty"`
 	// A message describing the current state in more detail.
@@ -464,6 +464,13 @@ type WebAppFirewallPolicyResponseProtection struct {
 // WebAppFirewallPolicyStatus defines the observed state of WebAppFirewallPolicy.
 type WebAppFirewallPolicyStatus struct {
 	OsokStatus shared.OSOKStatus `json:"status"`
+	// Conditions publishes the standard Ready, Reconciling and Stalled conditions
+	// projected from the OSOK lifecycle and async state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the metadata.generation the controller last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The OCID (https://docs.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the WebAppFirewallPolicy.
 	Id string `json:"id,omitempty"`
 	// WebAppFirewallPolicy display name, can be renamed.
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/oracle/oci-service-operator/internal/generator"
+)
+
+const serviceManagerImportPrefix = "github.com/oracle/oci-service-operator/pkg/servicemanager/"
+
+const (
+	conformancePass   = "pass"
+	conformanceFail   = "fail"
+	conformanceSkip   = "skip"
+	conformanceNotRun = "notRun"
+)
+
+type outputConformance struct {
+	JSONPath string                  `json:"jsonPath"`
+	Passed   int                     `json:"passed"`
+	Failed   int                     `json:"failed"`
+	NotRun   int                     `json:"notRun"`
+	Kinds    []outputConformanceKind `json:"kinds"`
+}
+
+type outputConformanceKind struct {
+	Service string `json:"service"`
+	Kind    string `json:"kind"`
+	Package string `json:"package"`
+	Result  string `json:"result"`
+}
+
+// testEvent is the subset of a `go test -json` event the report reads.
+type testEvent struct {
+	Action  string `json:"Action"`
+	Package string `json:"Package"`
+	Test    string `json:"Test"`
+}
+
+// summarizeConformance reports the result of every generated conformance
+// test of packages found in the `go test -json` output at jsonPath. Kinds
+// whose test did not run, for example because the run was scoped to other
+// packages, are reported as notRun.
+func summarizeConformance(jsonPath string, packages []*generator.PackageModel) (*outputConformance, error) {
+	content, err := os.ReadFile(jsonPath)
+	if err != nil {
+		return nil, fmt.Errorf("read conformance test output: %w", err)
+	}
+	results, err := conformanceResults(content)
+	if err != nil {
+		return nil, fmt.Errorf("decode conformance test output %s: %w", jsonPath, err)
+	}
+
+	summary := &outputConformance{JSONPath: jsonPath, Kinds: []outputConformanceKind{}}
+	for _, pkg := range packages {
+		for _, serviceManager := range pkg.ServiceManagers {
+			if serviceManager.ConformanceTestFileName == "" {
+				continue
+			}
+			importPath := serviceManagerImportPrefix + path.Clean(serviceManager.PackagePath)
+			result, ok := results[conformanceKey(importPath, "Test"+serviceManager.Kind+"Conformance")]
+			if !ok {
+				result = conformanceNotRun
+			}
+			switch result {
+			case conformancePass:
+				summary.Passed++
+			case conformanceNotRun:
+				summary.NotRun++
+			default:
+				summary.Failed++
+			}
+			summary.Kinds = append(summary.Kinds, outputConformanceKind{
+				Service: pkg.Service.Service,
+				Kind:    serviceManager.Kind,
+				Package: importPath,
+				Result:  result,
+			})
+		}
+	}
+	sort.Slice(summary.Kinds, func(i, j int) bool {
+		if summary.Kinds[i].Service == summary.Kinds[j].Service {
+			return summary.Kinds[i].Kind < summary.Kinds[j].Kind
+		}
+		return summary.Kinds[i].Service < summary.Kinds[j].Service
+	})
+	return summary, nil
+}
+
+// conformanceResults maps each top-level test to its final action. A
+// skipped conformance test counts as a failure in the summary: it means the
+// suite could not run, not that the kind conforms.
+func conformanceResults(content []byte) (map[string]string, error) {
+	results := map[string]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		// go test -json interleaves build output that is not JSON.
+		if len(line) == 0 || line[0] != '{' {
+			continue
+		}
+		var event testEvent
+		if err := json.Unmarshal(line, &event); err != nil {
+			return nil, err
+		}
+		if event.Test == "" || strings.Contains(event.Test, "/") {
+			continue
+		}
+		switch event.Action {
+		case conformancePass, conformanceFail, conformanceSkip:
+			results[conformanceKey(event.Package, event.Test)] = event.Action
+		}
+	}
+	return results, scanner.Err()
+}
+
+func conformanceKey(importPath string, test string) string {
+	return importPath + "." + test
+}
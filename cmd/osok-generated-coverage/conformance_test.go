@@ -0,0 +1,54 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/oracle/oci-service-operator/internal/generator"
+)
+
+func TestSummarizeConformanceReportsEachGeneratedKind(t *testing.T) {
+	t.Parallel()
+
+	jsonPath := filepath.Join(t.TempDir(), "conformance.json")
+	mustCoverageWriteFile(t, jsonPath, `# github.com/oracle/oci-service-operator/pkg/servicemanager/queue/queue
+{"Action":"run","Package":"github.com/oracle/oci-service-operator/pkg/servicemanager/queue/queue","Test":"TestQueueConformance"}
+{"Action":"fail","Package":"github.com/oracle/oci-service-operator/pkg/servicemanager/queue/queue","Test":"TestQueueConformance/MutableDrift"}
+{"Action":"pass","Package":"github.com/oracle/oci-service-operator/pkg/servicemanager/queue/queue","Test":"TestQueueConformance"}
+{"Action":"fail","Package":"github.com/oracle/oci-service-operator/pkg/servicemanager/streaming/stream","Test":"TestStreamConformance"}
+{"Action":"pass","Package":"github.com/oracle/oci-service-operator/pkg/servicemanager/streaming/stream"}
+`)
+	packages := []*generator.PackageModel{
+		{
+			Service: generator.ServiceConfig{Service: "queue"},
+			ServiceManagers: []generator.ServiceManagerModel{
+				{Kind: "Queue", PackagePath: "queue/queue", ConformanceTestFileName: "queue_conformance_test.go"},
+				{Kind: "WorkRequest", PackagePath: "queue/workrequest"},
+			},
+		},
+		{
+			Service: generator.ServiceConfig{Service: "streaming"},
+			ServiceManagers: []generator.ServiceManagerModel{
+				{Kind: "Stream", PackagePath: "streaming/stream", ConformanceTestFileName: "stream_conformance_test.go"},
+				{Kind: "StreamPool", PackagePath: "streaming/streampool", ConformanceTestFileName: "streampool_conformance_test.go"},
+			},
+		},
+	}
+
+	summary, err := summarizeConformance(jsonPath, packages)
+	if err != nil {
+		t.Fatalf("summarizeConformance() error = %v", err)
+	}
+	if summary.Passed != 1 || summary.Failed != 1 || summary.NotRun != 1 {
+		t.Fatalf("summary = %d passed, %d failed, %d not run; want 1 of each", summary.Passed, summary.Failed, summary.NotRun)
+	}
+	want := map[string]string{"Queue": conformancePass, "Stream": conformanceFail, "StreamPool": conformanceNotRun}
+	if len(summary.Kinds) != len(want) {
+		t.Fatalf("kinds = %+v, want %d entries", summary.Kinds, len(want))
+	}
+	for _, kind := range summary.Kinds {
+		if kind.Result != want[kind.Kind] {
+			t.Fatalf("%s result = %q, want %q", kind.Kind, kind.Result, want[kind.Kind])
+		}
+	}
+}
@@ -34,6 +34,7 @@ type options struct {
 	baselinePath     string
 	writeBaseline    string
 	failOnRegression bool
+	conformanceJSON  string
 }
 
 type validatorEnvelope struct {
@@ -45,6 +46,9 @@ type outputReport struct {
 	Snapshot  outputSnapshot     `json:"snapshot"`
 	Validator outputValidator    `json:"validator"`
 	Summary   metrics.APISummary `json:"summary"`
+	// Conformance is set when --conformance-json names the output of
+	// make conformance-test.
+	Conformance *outputConformance `json:"conformance,omitempty"`
 }
 
 type outputConfig struct {
@@ -96,6 +100,7 @@ func main() {
 	flag.StringVar(&opts.baselinePath, "baseline", "", "Optional generated coverage baseline JSON to compare against.")
 	flag.StringVar(&opts.writeBaseline, "write-baseline", "", "Write the current generated coverage baseline to the given path.")
 	flag.BoolVar(&opts.failOnRegression, "fail-on-regression", false, "Exit with a non-zero status when the generated coverage report regresses compared to --baseline.")
+	flag.StringVar(&opts.conformanceJSON, "conformance-json", "", "Optional `go test -json` output of make conformance-test to report per-kind conformance results from.")
 	flag.Parse()
 
 	if err := run(context.Background(), opts); err != nil {
@@ -232,7 +237,14 @@ func generateCoverageOutput(
 	if err != nil {
 		return outputReport{}, err
 	}
-	return buildCoverageOutput(opts, inputs, snapshotDir, envelope), nil
+	output := buildCoverageOutput(opts, inputs, snapshotDir, envelope)
+	if strings.TrimSpace(opts.conformanceJSON) != "" {
+		output.Conformance, err = summarizeConformance(opts.conformanceJSON, inputs.packageModels)
+		if err != nil {
+			return outputReport{}, err
+		}
+	}
+	return output, nil
 }
 
 func generateCoverageSnapshot(
@@ -24,6 +24,8 @@ type InstanceReconciler struct {
 // +kubebuilder:rbac:groups=core.oracle.com,resources=instances/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=core.oracle.com,resources=instances/finalizers,verbs=update
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=identity.oracle.com,resources=compartments,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core.oracle.com,resources=subnets,verbs=get;list;watch
 
 // Reconcile is part of the main Kubernetes reconciliation loop.
 func (r *InstanceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
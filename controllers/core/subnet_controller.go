@@ -24,6 +24,10 @@ type SubnetReconciler struct {
 // +kubebuilder:rbac:groups=core.oracle.com,resources=subnets/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=core.oracle.com,resources=subnets/finalizers,verbs=update
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=identity.oracle.com,resources=compartments,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core.oracle.com,resources=vcns,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core.oracle.com,resources=routetables,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core.oracle.com,resources=securitylists,verbs=get;list;watch
 
 // Reconcile is part of the main Kubernetes reconciliation loop.
 func (r *SubnetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
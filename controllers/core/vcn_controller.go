@@ -24,6 +24,7 @@ type VcnReconciler struct {
 // +kubebuilder:rbac:groups=core.oracle.com,resources=vcns/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=core.oracle.com,resources=vcns/finalizers,verbs=update
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=identity.oracle.com,resources=compartments,verbs=get;list;watch
 
 // Reconcile is part of the main Kubernetes reconciliation loop.
 func (r *VcnReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
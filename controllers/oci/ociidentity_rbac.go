@@ -0,0 +1,11 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+// Package oci holds the RBAC markers for operator-level oci.oracle.com kinds.
+// OCIIdentity and ClusterOCIIdentity have no controller; service managers read
+// them when they resolve the credentials for a resource.
+package oci
+
+// +kubebuilder:rbac:groups=oci.oracle.com,resources=ociidentities;clusterociidentities,verbs=get;list;watch
@@ -207,6 +207,9 @@ func (inventory *cleanupInventory) includeServiceManagerOutputs(root string, ser
 		inventory.serviceManagerFiles[filepath.Join(outputDir, serviceManager.RuntimeHooksFileName)] = struct{}{}
 		inventory.serviceManagerFiles[filepath.Join(outputDir, serviceManager.ServiceClientFileName)] = struct{}{}
 		inventory.serviceManagerFiles[filepath.Join(outputDir, serviceManager.ServiceManagerFileName)] = struct{}{}
+		if serviceManager.ConformanceTestFileName != "" {
+			inventory.serviceManagerFiles[filepath.Join(outputDir, serviceManager.ConformanceTestFileName)] = struct{}{}
+		}
 	}
 }
 
@@ -428,8 +431,12 @@ func cleanupPackageGroup(groupDir string, packagesRoot string, desired bool) err
 		}
 	}
 
-	// cmd/generator owns the package metadata and install kustomization files.
-	// install/generated is refreshed by downstream manifest targets.
+	// cmd/generator owns the package metadata, install kustomization, and
+	// install/vap files. install/generated is refreshed by downstream manifest
+	// targets.
+	if err := os.RemoveAll(filepath.Join(groupDir, filepath.FromSlash(vapUpdatePolicyInstallDir))); err != nil {
+		return err
+	}
 	for _, path := range []string{
 		filepath.Join(groupDir, "metadata.env"),
 		filepath.Join(groupDir, "install", "kustomization.yaml"),
@@ -903,7 +910,8 @@ func isOwnedRegistrationFile(name string) bool {
 func isOwnedServiceManagerFile(name string) bool {
 	return strings.HasSuffix(name, "_runtimehooks_generated.go") ||
 		strings.HasSuffix(name, "_serviceclient.go") ||
-		strings.HasSuffix(name, "_servicemanager.go")
+		strings.HasSuffix(name, "_servicemanager.go") ||
+		strings.HasSuffix(name, "_conformance_test.go")
 }
 
 func isOwnedManagerCmdFile(name string) bool {
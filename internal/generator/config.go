@@ -95,9 +95,22 @@ type ResourceGenerationOverride struct {
 	Webhooks       GenerationSurfaceConfig          `yaml:"webhooks,omitempty"`
 	SpecFields     []FieldOverride                  `yaml:"specFields,omitempty"`
 	StatusFields   []FieldOverride                  `yaml:"statusFields,omitempty"`
+	References     []ReferenceOverride              `yaml:"references,omitempty"`
 	Sample         SampleOverride                   `yaml:"sample,omitempty"`
 }
 
+// ReferenceOverride adds a sibling *Ref spec field for one raw OCID spec field.
+// The runtime resolves the reference from the named resource's status.status.ocid.
+type ReferenceOverride struct {
+	// Field is the Go name of the OCID spec field, such as VcnId or SecurityListIds.
+	Field string `yaml:"field"`
+	// Kind is the referenced OSOK kind.
+	Kind string `yaml:"kind"`
+	// APIVersion is the referenced kind's API version. It defaults to the
+	// referencing resource's own group and version.
+	APIVersion string `yaml:"apiVersion,omitempty"`
+}
+
 // ControllerGenerationOverride captures per-kind controller-specific settings.
 type ControllerGenerationOverride struct {
 	Strategy                string   `yaml:"strategy,omitempty"`
@@ -521,6 +534,12 @@ func validateResourceGenerationOverrides(serviceName string, resources []Resourc
 		); err != nil {
 			return err
 		}
+		if err := validateReferenceOverrides(
+			fmt.Sprintf("service %q generation.resources[%q].references", serviceName, kind),
+			resource.References,
+		); err != nil {
+			return err
+		}
 		if err := validateSampleOverride(
 			fmt.Sprintf("service %q generation.resources[%q].sample", serviceName, kind),
 			resource.Sample,
@@ -663,6 +682,31 @@ func validateFieldOverrides(field string, overrides []FieldOverride) error {
 	return nil
 }
 
+func validateReferenceOverrides(field string, overrides []ReferenceOverride) error {
+	seen := make(map[string]struct{}, len(overrides))
+	for index, override := range overrides {
+		overrideField := fmt.Sprintf("%s[%d]", field, index)
+		name := strings.TrimSpace(override.Field)
+		if name == "" {
+			return fmt.Errorf("%s.field is required", overrideField)
+		}
+		if referenceFieldName(name) == "" {
+			return fmt.Errorf("%s.field %q must end in Id or Ids", overrideField, name)
+		}
+		if _, ok := seen[name]; ok {
+			return fmt.Errorf("%s.field %q is duplicated", overrideField, name)
+		}
+		seen[name] = struct{}{}
+		if strings.TrimSpace(override.Kind) == "" {
+			return fmt.Errorf("%s.kind is required", overrideField)
+		}
+		if apiVersion := strings.TrimSpace(override.APIVersion); apiVersion != "" && !strings.Contains(apiVersion, "/") {
+			return fmt.Errorf("%s.apiVersion %q must be group/version", overrideField, apiVersion)
+		}
+	}
+	return nil
+}
+
 func validateSampleOverride(field string, sample SampleOverride) error {
 	if strings.TrimSpace(sample.Body) != "" && (strings.TrimSpace(sample.MetadataName) != "" || strings.TrimSpace(sample.Spec) != "") {
 		return fmt.Errorf("%s.body cannot be combined with metadataName or spec", field)
@@ -824,6 +868,7 @@ func (r ResourceGenerationOverride) hasOverrides() bool {
 		strings.TrimSpace(r.Webhooks.Strategy) != "" ||
 		len(r.SpecFields) > 0 ||
 		len(r.StatusFields) > 0 ||
+		len(r.References) > 0 ||
 		r.Sample.hasOverride()
 }
 
@@ -1085,6 +1085,57 @@ func TestValidateRejectsInvalidGenerationConfig(t *testing.T) {
 			},
 			wantErr: `formalSpec "../dbsystem" must be a single formal slug`,
 		},
+		{
+			name: "reference field without id suffix",
+			mutate: func(cfg *Config) {
+				cfg.Services[0].Generation.Resources = []ResourceGenerationOverride{
+					{
+						Kind:       "DbSystem",
+						References: []ReferenceOverride{{Field: "DisplayName", Kind: "Widget"}},
+					},
+				}
+			},
+			wantErr: `references[0].field "DisplayName" must end in Id or Ids`,
+		},
+		{
+			name: "reference without kind",
+			mutate: func(cfg *Config) {
+				cfg.Services[0].Generation.Resources = []ResourceGenerationOverride{
+					{
+						Kind:       "DbSystem",
+						References: []ReferenceOverride{{Field: "SubnetId"}},
+					},
+				}
+			},
+			wantErr: `references[0].kind is required`,
+		},
+		{
+			name: "duplicate reference field",
+			mutate: func(cfg *Config) {
+				cfg.Services[0].Generation.Resources = []ResourceGenerationOverride{
+					{
+						Kind: "DbSystem",
+						References: []ReferenceOverride{
+							{Field: "SubnetId", Kind: "Subnet", APIVersion: "core.oracle.com/v1beta1"},
+							{Field: "SubnetId", Kind: "Subnet", APIVersion: "core.oracle.com/v1beta1"},
+						},
+					},
+				}
+			},
+			wantErr: `references[1].field "SubnetId" is duplicated`,
+		},
+		{
+			name: "reference api version without group",
+			mutate: func(cfg *Config) {
+				cfg.Services[0].Generation.Resources = []ResourceGenerationOverride{
+					{
+						Kind:       "DbSystem",
+						References: []ReferenceOverride{{Field: "SubnetId", Kind: "Subnet", APIVersion: "v1beta1"}},
+					},
+				}
+			},
+			wantErr: `references[0].apiVersion "v1beta1" must be group/version`,
+		},
 	}
 
 	for _, test := range tests {
@@ -2602,8 +2653,11 @@ func assertMySQLRuntimeRolloutMetadata(t *testing.T, service *ServiceConfig) {
 	assertResourceOverrideCount(t, service, 1)
 	override := service.Generation.Resources[0]
 	assertMySQLGenerationOverride(t, override, mysqlSecretRBACMarkers())
-	if len(override.SpecFields) != 2 {
-		t.Fatalf("mysql specFields = %#v, want 2 secret-backed overrides", override.SpecFields)
+	if len(override.SpecFields) != 3 {
+		t.Fatalf("mysql specFields = %#v, want 2 secret-backed overrides and writeConnectionSecretToRef", override.SpecFields)
+	}
+	if override.SpecFields[2].Name != "WriteConnectionSecretToRef" {
+		t.Fatalf("mysql specFields[2] = %q, want WriteConnectionSecretToRef", override.SpecFields[2].Name)
 	}
 	if len(override.StatusFields) != 2 {
 		t.Fatalf("mysql statusFields = %#v, want 2 secret-backed overrides", override.StatusFields)
@@ -671,15 +671,27 @@ type fieldRenderingOptions struct {
 	excludedFieldPaths        map[string]struct{}
 }
 
+// statusEnvelopeFieldNames maps observed-state JSON names that collide with
+// the OSOK status envelope to the distinct names they are rendered with.
+var statusEnvelopeFieldNames = map[string]string{
+	"status":             "sdkStatus",
+	"conditions":         "sdkConditions",
+	"observedGeneration": "sdkObservedGeneration",
+}
+
 func buildFieldModel(field ocisdk.Field, jsonName string, options fieldRenderingOptions) FieldModel {
 	renderedJSONName := renderedFieldJSONName(jsonName, options)
 	comments := fieldComments(field)
+	name := field.Name
 	if renderedJSONName != jsonName {
 		comments = append(comments, "This uses a distinct JSON name so it can coexist with the OSOK status envelope.")
+		if hasField(defaultStatusFields(), name) {
+			name = "Sdk" + name
+		}
 	}
 
 	return FieldModel{
-		Name:     field.Name,
+		Name:     name,
 		Type:     field.RenderableType,
 		Tag:      jsonTag(renderedJSONName, shouldOmitEmpty(field, options)),
 		Comments: comments,
@@ -688,8 +700,11 @@ func buildFieldModel(field ocisdk.Field, jsonName string, options fieldRendering
 }
 
 func renderedFieldJSONName(jsonName string, options fieldRenderingOptions) string {
-	if options.scope == fieldScopeStatus && options.escapeStatusJSONCollision && strings.TrimSpace(jsonName) == "status" {
-		return "sdkStatus"
+	if options.scope != fieldScopeStatus || !options.escapeStatusJSONCollision {
+		return jsonName
+	}
+	if escaped, ok := statusEnvelopeFieldNames[strings.TrimSpace(jsonName)]; ok {
+		return escaped
 	}
 	return jsonName
 }
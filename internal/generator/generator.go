@@ -105,6 +105,7 @@ func (g *Generator) Generate(ctx context.Context, cfg *Config, services []Servic
 		}
 	}
 
+	vapAdmissions := sortedVAPUpdatePolicyAdmissions(vapUpdatePolicyArtifacts)
 	generatedPackages := make([]*PackageModel, 0, len(builtPackages))
 	for index, service := range services {
 		pkg := builtPackages[index]
@@ -126,6 +127,9 @@ func (g *Generator) Generate(ctx context.Context, cfg *Config, services []Servic
 		if err := g.renderer.RenderPackageOutputs(options.OutputRoot, pkg); err != nil {
 			return result, fmt.Errorf("render package outputs for service %q: %w", service.Service, err)
 		}
+		if err := g.renderer.RenderVAPUpdatePolicyPackage(options.OutputRoot, pkg, vapAdmissions[pkg.OutputName]); err != nil {
+			return result, fmt.Errorf("render vap update policy package for service %q: %w", service.Service, err)
+		}
 		if err := g.renderer.RenderControllers(options.OutputRoot, pkg, options.Overwrite); err != nil {
 			return result, fmt.Errorf("render controller outputs for service %q: %w", service.Service, err)
 		}
@@ -146,6 +150,9 @@ func (g *Generator) Generate(ctx context.Context, cfg *Config, services []Servic
 			if err := g.renderer.RenderPackageOutputs(options.OutputRoot, splitPkg); err != nil {
 				return result, fmt.Errorf("render split package outputs for service %q split %q: %w", service.Service, splitPkg.OutputName, err)
 			}
+			if err := g.renderer.RenderVAPUpdatePolicyPackage(options.OutputRoot, splitPkg, vapAdmissions[splitPkg.OutputName]); err != nil {
+				return result, fmt.Errorf("render split vap update policy package for service %q split %q: %w", service.Service, splitPkg.OutputName, err)
+			}
 			if err := g.renderer.RenderRegistrations(options.OutputRoot, splitPkg, options.Overwrite); err != nil {
 				return result, fmt.Errorf("render split registration outputs for service %q split %q: %w", service.Service, splitPkg.OutputName, err)
 			}
@@ -479,6 +479,40 @@ func TestBuildPackageModelSynthesizesContainerEngineObservedStateFields(t *testi
 	assertFieldTag(t, "CredentialRotationStatus Status", credentialRotationObservedStatus, `json:"sdkStatus,omitempty"`)
 }
 
+func TestBuildPackageModelEscapesObservedConditionsBesideStandardConditions(t *testing.T) {
+	t.Parallel()
+
+	cfg := &Config{
+		Domain:         "oracle.com",
+		DefaultVersion: "v1beta1",
+	}
+	service := ServiceConfig{
+		Service:        "stackmonitoring",
+		SDKPackage:     "github.com/oracle/oci-go-sdk/v65/stackmonitoring",
+		Group:          "stackmonitoring",
+		PackageProfile: PackageProfileCRDOnly,
+	}
+
+	pkg, err := NewDiscoverer().BuildPackageModel(context.Background(), cfg, service)
+	if err != nil {
+		t.Fatalf("BuildPackageModel() error = %v", err)
+	}
+
+	alarmCondition := findResource(t, pkg.Resources, "AlarmCondition")
+	standardConditions := findFieldModel(t, alarmCondition.StatusFields, "Conditions")
+	if standardConditions.Type != "[]metav1.Condition" {
+		t.Fatalf("AlarmCondition Conditions type = %q, want []metav1.Condition", standardConditions.Type)
+	}
+	assertFieldTag(t, "AlarmCondition Conditions", standardConditions, `json:"conditions,omitempty"`)
+	assertFieldTag(t, "AlarmCondition ObservedGeneration", findFieldModel(t, alarmCondition.StatusFields, "ObservedGeneration"), `json:"observedGeneration,omitempty"`)
+
+	observedConditions := findFieldModel(t, alarmCondition.StatusFields, "SdkConditions")
+	if observedConditions.Type != "[]AlarmConditionCondition" {
+		t.Fatalf("AlarmCondition SdkConditions type = %q, want []AlarmConditionCondition", observedConditions.Type)
+	}
+	assertFieldTag(t, "AlarmCondition SdkConditions", observedConditions, `json:"sdkConditions,omitempty"`)
+}
+
 func TestBuildPackageModelSynthesizesDNSObservedStateAliases(t *testing.T) {
 	t.Parallel()
 
@@ -940,6 +974,83 @@ func TestBuildPackageModelAppliesResourceFieldAndSampleOverrides(t *testing.T) {
 	}
 }
 
+func TestBuildPackageModelAddsReferenceFieldsFromOverrides(t *testing.T) {
+	t.Parallel()
+
+	cfg := &Config{
+		Domain:         "oracle.com",
+		DefaultVersion: "v1beta1",
+	}
+	service := ServiceConfig{
+		Service:        "core",
+		SDKPackage:     "example.com/test/sdk",
+		Group:          "core",
+		PackageProfile: PackageProfileCRDOnly,
+		Generation: GenerationConfig{
+			Resources: []ResourceGenerationOverride{
+				{
+					Kind: "Widget",
+					References: []ReferenceOverride{
+						{Field: "VcnId", Kind: "Vcn"},
+						{Field: "SecurityListIds", Kind: "SecurityList"},
+						{Field: "CompartmentId", Kind: "Compartment", APIVersion: "identity.oracle.com/v1beta1"},
+					},
+				},
+			},
+		},
+	}
+
+	pkg, err := buildPackageModel(cfg, service, []ResourceModel{
+		{
+			SDKName:        "Widget",
+			Kind:           "Widget",
+			FileStem:       "widget",
+			KindPlural:     "widgets",
+			StatusTypeName: defaultStatusTypeName("Widget"),
+			SpecFields: []FieldModel{
+				{
+					Name:     "CompartmentId",
+					Type:     "string",
+					Tag:      `json:"compartmentId"`,
+					Comments: []string{"The OCID of the compartment."},
+					Markers:  []string{"+kubebuilder:validation:Required"},
+				},
+				{Name: "VcnId", Type: "string", Tag: `json:"vcnId"`, Markers: []string{"+kubebuilder:validation:Required"}},
+				{Name: "SecurityListIds", Type: "[]string", Tag: `json:"securityListIds,omitempty"`, Markers: []string{"+kubebuilder:validation:Optional"}},
+			},
+			StatusFields: []FieldModel{
+				{Name: "OsokStatus", Type: "shared.OSOKStatus", Tag: `json:"status"`},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("buildPackageModel() error = %v", err)
+	}
+
+	resource := findResource(t, pkg.Resources, "Widget")
+	assertResourceSpecFields(t, resource, "CompartmentId", "CompartmentRef", "VcnId", "VcnRef", "SecurityListIds", "SecurityListRefs")
+	compartmentID := findFieldModel(t, resource.SpecFields, "CompartmentId")
+	assertFieldMarkers(t, "Widget CompartmentId", compartmentID, []string{"+kubebuilder:validation:Optional"})
+	assertFieldCommentsEqual(t, "Widget CompartmentId", compartmentID, []string{
+		"The OCID of the compartment.",
+		"Set either compartmentId or compartmentRef.",
+	})
+	if compartmentID.Tag != `json:"compartmentId,omitempty"` {
+		t.Fatalf("Widget CompartmentId tag = %q, want omitempty", compartmentID.Tag)
+	}
+	assertFieldType(t, "Widget VcnRef", findFieldModel(t, resource.SpecFields, "VcnRef"), "*shared.ResourceRef")
+	assertFieldType(t, "Widget SecurityListRefs", findFieldModel(t, resource.SpecFields, "SecurityListRefs"), "[]shared.ResourceRef")
+
+	want := []ReferenceModel{
+		{FieldName: "VcnId", RefFieldName: "VcnRef", APIVersion: "core.oracle.com/v1beta1", Kind: "Vcn"},
+		{FieldName: "SecurityListIds", RefFieldName: "SecurityListRefs", APIVersion: "core.oracle.com/v1beta1", Kind: "SecurityList"},
+		{FieldName: "CompartmentId", RefFieldName: "CompartmentRef", APIVersion: "identity.oracle.com/v1beta1", Kind: "Compartment"},
+	}
+	if !slices.Equal(resource.References, want) {
+		t.Fatalf("Widget references = %#v, want %#v", resource.References, want)
+	}
+}
+
 func TestBuildPackageModelPrunesHelperTypesAfterFieldOverrides(t *testing.T) {
 	t.Parallel()
 
@@ -1509,7 +1620,7 @@ func TestGenerateRendersServiceManagerScaffolds(t *testing.T) {
 		"var newDbSystemServiceClient = func(manager *DbSystemServiceManager) DbSystemServiceClient {",
 		"github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime",
 		"generatedruntime.NewServiceClient[*mysqlv1beta1.DbSystem]",
-		"mysqlsdk.NewSampleClientWithConfigurationProvider(manager.Provider)",
+		"ociclient.New(manager.Provider, mysqlsdk.NewSampleClientWithConfigurationProvider)",
 		"hooks := newDbSystemRuntimeHooks(manager, sdkClient)",
 		"config := buildDbSystemGeneratedRuntimeConfig(manager, hooks)",
 		"return wrapDbSystemGeneratedClient(hooks, delegate)",
@@ -1680,7 +1791,8 @@ func TestRenderServiceClientFileHandlesEndpointConstructors(t *testing.T) {
 		"return wrapCursorGeneratedClient(hooks, delegate)",
 	})
 	assertNotContains(t, content, []string{
-		"NewStreamClientWithConfigurationProvider(manager.Provider)",
+		"ociclient.New(manager.Provider, streamingsdk.NewStreamClientWithConfigurationProvider)",
+		"github.com/oracle/oci-service-operator/pkg/ociclient",
 	})
 
 	runtimeHooksContent, err := renderServiceRuntimeHooksFile(model)
@@ -1896,7 +2008,7 @@ func TestBuildServiceManagerModelsSanitizesKeywordPackageName(t *testing.T) {
 	service := testServiceConfig(PackageProfileControllerBacked)
 	service.Generation.ServiceManager.Strategy = GenerationStrategyGenerated
 
-	serviceManagers, err := buildServiceManagerModels(service, "v1beta1", []ResourceModel{{
+	serviceManagers, err := buildServiceManagerModels(service, "oracle.com", "v1beta1", []ResourceModel{{
 		Kind:     "Package",
 		FileStem: "package",
 		Runtime:  &RuntimeModel{},
@@ -1952,6 +2064,56 @@ func TestGeneratedServiceManagerScaffoldCompiles(t *testing.T) {
 	}
 }
 
+func TestGeneratedConformanceTestCompiles(t *testing.T) {
+	cfg := &Config{
+		Domain:         "oracle.com",
+		DefaultVersion: "v1beta1",
+	}
+	service := testServiceConfig(PackageProfileControllerBacked)
+	service.Generation.Controller.Strategy = GenerationStrategyGenerated
+	service.Generation.ServiceManager.Strategy = GenerationStrategyGenerated
+	pipeline := newTestGenerator(t)
+
+	moduleRoot := repoRoot(t)
+	outputRoot, err := os.MkdirTemp(moduleRoot, "generated-conformance-")
+	if err != nil {
+		t.Fatalf("MkdirTemp(%s) error = %v", moduleRoot, err)
+	}
+	t.Cleanup(func() {
+		_ = os.RemoveAll(outputRoot)
+	})
+
+	if _, err := pipeline.Generate(context.Background(), cfg, []ServiceConfig{service}, Options{
+		OutputRoot: outputRoot,
+	}); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	packageDir := filepath.Join(outputRoot, "pkg", "servicemanager", "mysql", "dbsystem")
+	content := readFile(t, filepath.Join(packageDir, "dbsystem_conformance_test.go"))
+	for _, want := range []string{
+		"//go:build conformance",
+		`CRD:             "mysql.oracle.com_dbsystems.yaml",`,
+		`Sample:          "mysql_v1beta1_dbsystem.yaml",`,
+		"fakeoci.Install(service, &config, sdkClient)",
+	} {
+		if !strings.Contains(content, want) {
+			t.Fatalf("dbsystem_conformance_test.go does not contain %q:\n%s", want, content)
+		}
+	}
+
+	relativePackagePath, err := filepath.Rel(moduleRoot, packageDir)
+	if err != nil {
+		t.Fatalf("Rel() error = %v", err)
+	}
+	cmd := exec.Command("go", "vet", "-tags", "conformance", "./"+filepath.ToSlash(relativePackagePath))
+	cmd.Dir = moduleRoot
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("go vet -tags conformance %s error = %v\n%s", relativePackagePath, err, output)
+	}
+}
+
 func TestGeneratedServiceManagerRuntimeHooksMutatorsCompose(t *testing.T) {
 	cfg := &Config{
 		Domain:         "oracle.com",
@@ -116,9 +116,15 @@ type ServiceManagerModel struct {
 	ListOperation            *RuntimeOperationModel
 	UpdateOperation          *RuntimeOperationModel
 	DeleteOperation          *RuntimeOperationModel
+	References               []ReferenceModel
 	RuntimeHooksFileName     string
 	ServiceClientFileName    string
 	ServiceManagerFileName   string
+	// ConformanceTestFileName is empty for kinds without a controller, which
+	// get no conformance test.
+	ConformanceTestFileName string
+	CRDFileName             string
+	SampleFileName          string
 }
 
 // RuntimeOperationModel describes one generated SDK-backed operation binding.
@@ -152,6 +158,15 @@ type ResourceModel struct {
 	ListComments        []string
 	Sample              SampleModel
 	PrimaryDisplayField string
+	References          []ReferenceModel
+}
+
+// ReferenceModel describes one spec *Ref field and the OCID field it resolves.
+type ReferenceModel struct {
+	FieldName    string
+	RefFieldName string
+	APIVersion   string
+	Kind         string
 }
 
 // RuntimeModel describes the OCI SDK client and methods that back a generated resource.
@@ -19,9 +19,13 @@ func buildPackageModel(cfg *Config, service ServiceConfig, discovered []Resource
 	resources = assignHelperTypeNames(resources)
 	resources = assignStatusTypeNames(resources)
 	resources = applyResourceGenerationOverrides(service, version, resources)
+	resources, err := applyReferenceOverrides(service, service.GroupDNSName(cfg.Domain)+"/"+version, resources)
+	if err != nil {
+		return nil, err
+	}
 	resources = applyDefaultSamples(service, version, resources)
 	controllerOutput := buildControllerOutputModel(service, cfg.Domain, resources)
-	serviceManagers, err := buildServiceManagerModels(service, version, resources)
+	serviceManagers, err := buildServiceManagerModels(service, cfg.Domain, version, resources)
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +77,7 @@ func buildPackageSplitModels(pkg *PackageModel) ([]*PackageModel, error) {
 		}
 
 		controllerOutput := buildControllerOutputModel(pkg.Service, pkg.Domain, resources)
-		serviceManagers, err := buildServiceManagerModels(pkg.Service, pkg.Version, resources)
+		serviceManagers, err := buildServiceManagerModels(pkg.Service, pkg.Domain, pkg.Version, resources)
 		if err != nil {
 			return nil, err
 		}
@@ -149,6 +153,8 @@ func buildPackageOutputModelFor(service ServiceConfig, outputName string, defaul
 			"../../../config/rbac/role_binding.yaml",
 			"../../../config/rbac/leader_election_role.yaml",
 			"../../../config/rbac/leader_election_role_binding.yaml",
+			"../../../config/ociidentity",
+			vapUpdatePolicyInstallResource,
 		)
 		output.Install.Resources = appendUniqueStrings(output.Install.Resources, service.Package.ExtraResources...)
 		if service.WebhookGenerationStrategy() == GenerationStrategyManual {
@@ -364,15 +370,11 @@ func buildControllerOutputModel(service ServiceConfig, domain string, resources
 			continue
 		}
 
-		kindPlural := resource.KindPlural
-		if strings.TrimSpace(kindPlural) == "" {
-			kindPlural = strings.ToLower(pluralize(resource.Kind))
-		}
-
 		rbacMarkers := append(
-			defaultControllerRBACMarkers(groupDNSName, kindPlural),
+			defaultControllerRBACMarkers(groupDNSName, resourcePlural(resource)),
 			extraControllerRBACMarkers(controllerConfig.ExtraRBACMarkers)...,
 		)
+		rbacMarkers = append(rbacMarkers, referenceControllerRBACMarkers(resource.References)...)
 
 		output.Resources = append(output.Resources, ControllerModel{
 			Kind:                       resource.Kind,
@@ -388,11 +390,20 @@ func buildControllerOutputModel(service ServiceConfig, domain string, resources
 	return output
 }
 
+// resourcePlural returns the lowercase plural the CRD of resource is served
+// under.
+func resourcePlural(resource ResourceModel) string {
+	if strings.TrimSpace(resource.KindPlural) == "" {
+		return strings.ToLower(pluralize(resource.Kind))
+	}
+	return strings.ToLower(resource.KindPlural)
+}
+
 func registrationServiceManagerImportAlias(group string, fileStem string) string {
 	return group + fileStem + "servicemanager"
 }
 
-func buildServiceManagerModels(service ServiceConfig, version string, resources []ResourceModel) ([]ServiceManagerModel, error) {
+func buildServiceManagerModels(service ServiceConfig, domain string, version string, resources []ResourceModel) ([]ServiceManagerModel, error) {
 	serviceManagers := make([]ServiceManagerModel, 0, len(resources))
 	for _, resource := range resources {
 		if service.ServiceManagerGenerationStrategyFor(resource.Kind) != GenerationStrategyGenerated {
@@ -407,6 +418,11 @@ func buildServiceManagerModels(service ServiceConfig, version string, resources
 		}
 
 		packagePath := service.ServiceManagerPackagePathFor(resource.Kind, resource.FileStem)
+		conformanceTestFileName := ""
+		if service.IsControllerBacked() && service.ControllerGenerationStrategyFor(resource.Kind) == GenerationStrategyGenerated &&
+			resource.Runtime.Create != nil {
+			conformanceTestFileName = fmt.Sprintf("%s_conformance_test.go", resource.FileStem)
+		}
 		serviceManagers = append(serviceManagers, ServiceManagerModel{
 			Kind:                     resource.Kind,
 			SDKName:                  resource.SDKName,
@@ -434,9 +450,13 @@ func buildServiceManagerModels(service ServiceConfig, version string, resources
 			ListOperation:            resource.Runtime.List,
 			UpdateOperation:          resource.Runtime.Update,
 			DeleteOperation:          resource.Runtime.Delete,
+			References:               resource.References,
 			RuntimeHooksFileName:     fmt.Sprintf("%s_runtimehooks_generated.go", resource.FileStem),
 			ServiceClientFileName:    fmt.Sprintf("%s_serviceclient.go", resource.FileStem),
 			ServiceManagerFileName:   fmt.Sprintf("%s_servicemanager.go", resource.FileStem),
+			ConformanceTestFileName:  conformanceTestFileName,
+			CRDFileName:              fmt.Sprintf("%s_%s.yaml", service.GroupDNSName(domain), resourcePlural(resource)),
+			SampleFileName:           resource.Sample.FileName,
 		})
 	}
 
@@ -467,6 +487,114 @@ func applyResourceGenerationOverrides(service ServiceConfig, version string, res
 	return updated
 }
 
+// applyReferenceOverrides relaxes each referenced OCID spec field to optional
+// and adds the sibling *Ref field that names the OSOK resource to resolve it from.
+func applyReferenceOverrides(service ServiceConfig, apiVersion string, resources []ResourceModel) ([]ResourceModel, error) {
+	updated := make([]ResourceModel, 0, len(resources))
+	for _, resource := range resources {
+		override, ok := service.resourceGenerationOverride(resource.Kind)
+		if !ok || len(override.References) == 0 {
+			updated = append(updated, resource)
+			continue
+		}
+
+		specFields := append([]FieldModel(nil), resource.SpecFields...)
+		references := make([]ReferenceModel, 0, len(override.References))
+		for _, reference := range override.References {
+			fieldName := strings.TrimSpace(reference.Field)
+			index := -1
+			for candidate, field := range specFields {
+				if field.Name == fieldName {
+					index = candidate
+					break
+				}
+			}
+			if index < 0 {
+				return nil, fmt.Errorf("service %q generation.resources[%q].references field %q is not a spec field", service.Service, resource.Kind, fieldName)
+			}
+
+			field := specFields[index]
+			refType := ""
+			switch field.Type {
+			case "string":
+				refType = "*shared.ResourceRef"
+			case "[]string":
+				refType = "[]shared.ResourceRef"
+			default:
+				return nil, fmt.Errorf("service %q generation.resources[%q].references field %q has type %s, want string or []string", service.Service, resource.Kind, fieldName, field.Type)
+			}
+
+			jsonName := jsonTagName(field.Tag)
+			refFieldName := referenceFieldName(fieldName)
+			refJSONName := lowerCamel(refFieldName)
+			field.Tag = fmt.Sprintf(`json:"%s,omitempty"`, jsonName)
+			field.Markers = optionalFieldMarkers(field.Markers)
+			field.Comments = append(append([]string(nil), field.Comments...),
+				fmt.Sprintf("Set either %s or %s.", jsonName, refJSONName))
+
+			comments := []string{
+				fmt.Sprintf("%s names the %s resource whose status.status.ocid is used for %s.", refFieldName, reference.Kind, jsonName),
+				fmt.Sprintf("Reconciliation waits with a DependencyNotReady condition until that %s is Active.", reference.Kind),
+			}
+			if field.Type == "[]string" {
+				comments = []string{
+					fmt.Sprintf("%s names the %s resources whose status.status.ocid values are used for %s.", refFieldName, reference.Kind, jsonName),
+					fmt.Sprintf("Reconciliation waits with a DependencyNotReady condition until every %s is Active.", reference.Kind),
+				}
+			}
+			refField := FieldModel{
+				Name:     refFieldName,
+				Type:     refType,
+				Tag:      fmt.Sprintf(`json:"%s,omitempty"`, refJSONName),
+				Comments: comments,
+				Markers:  []string{"+kubebuilder:validation:Optional"},
+			}
+			specFields[index] = field
+			specFields = append(specFields[:index+1], append([]FieldModel{refField}, specFields[index+1:]...)...)
+
+			referenceAPIVersion := strings.TrimSpace(reference.APIVersion)
+			if referenceAPIVersion == "" {
+				referenceAPIVersion = apiVersion
+			}
+			references = append(references, ReferenceModel{
+				FieldName:    fieldName,
+				RefFieldName: refFieldName,
+				APIVersion:   referenceAPIVersion,
+				Kind:         strings.TrimSpace(reference.Kind),
+			})
+		}
+
+		resource.SpecFields = specFields
+		resource.References = references
+		updated = append(updated, resource)
+	}
+	return updated, nil
+}
+
+// referenceFieldName maps an OCID field name to its sibling reference field
+// name: VcnId becomes VcnRef and SecurityListIds becomes SecurityListRefs.
+func referenceFieldName(fieldName string) string {
+	switch {
+	case strings.HasSuffix(fieldName, "Ids") && len(fieldName) > len("Ids"):
+		return strings.TrimSuffix(fieldName, "Ids") + "Refs"
+	case strings.HasSuffix(fieldName, "Id") && len(fieldName) > len("Id"):
+		return strings.TrimSuffix(fieldName, "Id") + "Ref"
+	default:
+		return ""
+	}
+}
+
+func optionalFieldMarkers(markers []string) []string {
+	optional := make([]string, 0, len(markers))
+	for _, marker := range markers {
+		if marker == "+kubebuilder:validation:Required" {
+			marker = "+kubebuilder:validation:Optional"
+		}
+		optional = append(optional, marker)
+	}
+	return optional
+}
+
 func reachableHelperTypes(resource ResourceModel) []TypeModel {
 	if len(resource.HelperTypes) == 0 {
 		return nil
@@ -558,6 +686,23 @@ func defaultControllerRBACMarkers(groupDNSName string, kindPlural string) []stri
 	}
 }
 
+// referenceControllerRBACMarkers grants read access to the kinds that spec
+// references resolve from.
+func referenceControllerRBACMarkers(references []ReferenceModel) []string {
+	markers := make([]string, 0, len(references))
+	seen := make(map[string]struct{}, len(references))
+	for _, reference := range references {
+		group, _, _ := strings.Cut(reference.APIVersion, "/")
+		marker := fmt.Sprintf("+kubebuilder:rbac:groups=%s,resources=%s,verbs=get;list;watch", group, strings.ToLower(pluralize(reference.Kind)))
+		if _, ok := seen[marker]; ok {
+			continue
+		}
+		seen[marker] = struct{}{}
+		markers = append(markers, marker)
+	}
+	return markers
+}
+
 func extraControllerRBACMarkers(markers []string) []string {
 	normalized := make([]string, 0, len(markers))
 	for _, marker := range markers {
@@ -715,6 +860,22 @@ func defaultStatusFields() []FieldModel {
 			Type: "shared.OSOKStatus",
 			Tag:  `json:"status"`,
 		},
+		{
+			Name: "Conditions",
+			Type: "[]metav1.Condition",
+			Tag:  `json:"conditions,omitempty"`,
+			Comments: []string{
+				"Conditions publishes the standard Ready, Reconciling and Stalled conditions",
+				"projected from the OSOK lifecycle and async state.",
+			},
+			Markers: []string{"+listType=map", "+listMapKey=type"},
+		},
+		{
+			Name:     "ObservedGeneration",
+			Type:     "int64",
+			Tag:      `json:"observedGeneration,omitempty"`,
+			Comments: []string{"ObservedGeneration is the metadata.generation the controller last reconciled."},
+		},
 	}
 }
 
@@ -178,6 +178,18 @@ func (r *Renderer) RenderServiceManagers(root string, pkg *PackageModel, overwri
 		if err := writeGeneratedFile(serviceManagerPath, serviceManagerContent, overwrite); err != nil {
 			return err
 		}
+
+		if serviceManager.ConformanceTestFileName == "" {
+			continue
+		}
+		conformanceTestContent, err := renderConformanceTestFile(serviceManager)
+		if err != nil {
+			return fmt.Errorf("render %s for %s: %w", serviceManager.ConformanceTestFileName, pkg.Service.Service, err)
+		}
+		conformanceTestPath := filepath.Join(outputDir, serviceManager.ConformanceTestFileName)
+		if err := writeGeneratedFile(conformanceTestPath, conformanceTestContent, overwrite); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -503,6 +515,14 @@ func renderServiceManagerFile(serviceManager ServiceManagerModel) (string, error
 	return formatGoSource(content)
 }
 
+func renderConformanceTestFile(serviceManager ServiceManagerModel) (string, error) {
+	content, err := executeTemplate(conformanceTestTemplate, serviceManager)
+	if err != nil {
+		return "", err
+	}
+	return formatGoSource(content)
+}
+
 func renderSampleFile(body string, groupDNS string, version string, kind string, metadataName string, spec string) (string, error) {
 	data := struct {
 		Body         string
@@ -1051,6 +1071,9 @@ type {{ .Kind }}RuntimeHooks struct {
 {{- end }}
 {{- if .DeleteOperation }}
 	Delete          runtimeOperationHooks[{{ .SDKImportAlias }}.{{ .DeleteOperation.RequestTypeName }}, {{ .SDKImportAlias }}.{{ .DeleteOperation.ResponseTypeName }}]
+{{- end }}
+{{- if .References }}
+	References          []generatedruntime.ReferenceField
 {{- end }}
 	WrapGeneratedClient []func({{ .ClientInterfaceName }}) {{ .ClientInterfaceName }}
 }
@@ -1209,6 +1232,13 @@ func new{{ .Kind }}DefaultRuntimeHooks(sdkClient {{ .SDKImportAlias }}.{{ .SDKCl
 {{- end }}
 			},
 		},
+{{- end }}
+{{- if .References }}
+		References: []generatedruntime.ReferenceField{
+{{- range .References }}
+			{FieldName: "{{ .FieldName }}", RefFieldName: "{{ .RefFieldName }}", APIVersion: "{{ .APIVersion }}", Kind: "{{ .Kind }}"},
+{{- end }}
+		},
 {{- end }}
 		WrapGeneratedClient: []func({{ .ClientInterfaceName }}) {{ .ClientInterfaceName }}{},
 	}
@@ -1321,6 +1351,9 @@ import (
 
 	{{ .SDKImportAlias }} "{{ .SDKImportPath }}"
 	{{ .APIImportAlias }} "{{ .APIImportPath }}"
+{{- if eq .SDKClientConstructorKind "provider" }}
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
+{{- end }}
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -1342,7 +1375,7 @@ var _ {{ .ClientInterfaceName }} = {{ .DefaultClientTypeName }}{}
 
 var new{{ .Kind }}ServiceClient = func(manager *{{ .ManagerTypeName }}) {{ .ClientInterfaceName }} {
 {{- if eq .SDKClientConstructorKind "provider" }}
-	sdkClient, err := {{ .SDKImportAlias }}.{{ .SDKClientConstructor }}(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, {{ .SDKImportAlias }}.{{ .SDKClientConstructor }})
 {{- else }}
 	var (
 		sdkClient {{ .SDKImportAlias }}.{{ .SDKClientTypeName }}
@@ -1362,7 +1395,13 @@ var new{{ .Kind }}ServiceClient = func(manager *{{ .ManagerTypeName }}) {{ .Clie
 	delegate := {{ .DefaultClientTypeName }}{
 		ServiceClient: generatedruntime.NewServiceClient[*{{ .APIImportAlias }}.{{ .Kind }}](config),
 	}
+{{- if .References }}
+	return generatedruntime.WithReferenceResolution[*{{ .APIImportAlias }}.{{ .Kind }}](
+		"{{ .Kind }}", manager.Log, hooks.References, wrap{{ .Kind }}GeneratedClient(hooks, delegate),
+	)
+{{- else }}
 	return wrap{{ .Kind }}GeneratedClient(hooks, delegate)
+{{- end }}
 }
 `
 
@@ -1475,6 +1514,57 @@ func (c *{{ .ManagerTypeName }}) status(resource *{{ .APIImportAlias }}.{{ .Kind
 }
 `
 
+const conformanceTestTemplate = `//go:build conformance
+
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+// Code generated by generator. DO NOT EDIT.
+
+package {{ .PackageName }}
+
+import (
+	"testing"
+
+	{{ .SDKImportAlias }} "{{ .SDKImportPath }}"
+	{{ .APIImportAlias }} "{{ .APIImportPath }}"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime/conformance"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime/fakeoci"
+)
+
+func Test{{ .Kind }}Conformance(t *testing.T) {
+	conformance.Run(t, conformance.Kind[*{{ .APIImportAlias }}.{{ .Kind }}]{
+		CRD:             "{{ .CRDFileName }}",
+		Sample:          "{{ .SampleFileName }}",
+		AddToScheme:     {{ .APIImportAlias }}.AddToScheme,
+{{- if .Semantics }}
+		Semantics:       new{{ .Kind }}RuntimeSemantics(),
+{{- end }}
+		CreateOperation: "{{ .CreateOperation.MethodName }}",
+{{- if .UpdateOperation }}
+		UpdateOperation: "{{ .UpdateOperation.MethodName }}",
+{{- end }}
+		Setup: func(service *fakeoci.Service, deps servicemanager.RuntimeDeps) (servicemanager.OSOKServiceManager, error) {
+			manager := {{ .WithDepsConstructor }}(deps)
+			sdkClient := {{ .SDKImportAlias }}.{{ .SDKClientTypeName }}{}
+			hooks := new{{ .Kind }}RuntimeHooks(manager, sdkClient)
+			config := build{{ .Kind }}GeneratedRuntimeConfig(manager, hooks)
+			if err := fakeoci.Install(service, &config, sdkClient); err != nil {
+				return nil, err
+			}
+			delegate := {{ .DefaultClientTypeName }}{
+				ServiceClient: generatedruntime.NewServiceClient[*{{ .APIImportAlias }}.{{ .Kind }}](config),
+			}
+			return manager.WithClient(wrap{{ .Kind }}GeneratedClient(hooks, delegate)), nil
+		},
+	})
+}
+`
+
 const sampleTemplate = `#
 # Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
 # Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
@@ -1810,6 +1900,12 @@ spec:
               secretKeyRef:
                 name: osokconfig
                 key: useinstanceprincipal
+          - name: VAULTDETAILS
+            valueFrom:
+              secretKeyRef:
+                name: osokconfig
+                key: vaultdetails
+                optional: true
         volumeMounts:
           - name: oci-credentials
             mountPath: /etc/oci
@@ -0,0 +1,219 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// vapUpdatePolicyInstallResource is the install kustomization resource of
+	// every controller-backed package. It holds the package's generated
+	// ValidatingAdmissionPolicies and may be empty.
+	vapUpdatePolicyInstallResource = "vap"
+	vapUpdatePolicyInstallDir      = "install/vap"
+
+	// vapUpdatePolicyReplacementAllowedVariable is true when the object opted
+	// into replacing its OCI resource through the oci.oracle.com/replace-policy
+	// annotation. It lets replacement-required changes through admission.
+	vapUpdatePolicyReplacementAllowedVariable   = "replacementAllowed"
+	vapUpdatePolicyReplacementAllowedExpression = "has(object.metadata.annotations) && " +
+		"'oci.oracle.com/replace-policy' in object.metadata.annotations && " +
+		"object.metadata.annotations['oci.oracle.com/replace-policy'].trim().lowerAscii() in ['createbeforedelete', 'deletebeforecreate']"
+)
+
+// vapUpdatePolicyAdmission is the ValidatingAdmissionPolicy projection of one
+// vapUpdatePolicyDocument for a controller-backed kind.
+type vapUpdatePolicyAdmission struct {
+	PackageName string
+	PolicyName  string
+	Kind        string
+	Group       string
+	Version     string
+	Resource    string
+	Rules       []vapUpdatePolicyAdmissionRule
+	// ReplacementAllowed declares the replacementAllowed variable that the
+	// replacement-required rules consult.
+	ReplacementAllowed bool
+}
+
+// vapUpdatePolicyAdmissionRule rejects updates that change one immutable spec path.
+type vapUpdatePolicyAdmissionRule struct {
+	FieldPath  string
+	Expression string
+	Message    string
+}
+
+// buildVAPUpdatePolicyAdmission projects the enforceable deny rules of doc into
+// admission rules. Rules with an unknown decision stay reconcile-time only, as
+// do paths that do not resolve to a spec field or that cross a list or map.
+// It returns nil when the kind has no controller or no enforceable rule.
+func buildVAPUpdatePolicyAdmission(
+	pkg *PackageModel,
+	resource ResourceModel,
+	doc vapUpdatePolicyDocument,
+) *vapUpdatePolicyAdmission {
+	packageName := vapUpdatePolicyPackageName(pkg, resource.Kind)
+	if packageName == "" {
+		return nil
+	}
+
+	rules := make([]vapUpdatePolicyAdmissionRule, 0, len(doc.Update.DenyRules))
+	replacementAllowed := false
+	for _, denyRule := range doc.Update.DenyRules {
+		if denyRule.Decision == mutabilityOverlayPolicyUnknown {
+			continue
+		}
+		segments := strings.Split(denyRule.FieldPath, ".")
+		if !resolveVAPUpdatePolicySpecPath(resource, segments) {
+			continue
+		}
+		expression := vapUpdatePolicyImmutableExpression(segments)
+		if denyRule.Decision == mutabilityOverlayPolicyReplacementRequired {
+			expression = fmt.Sprintf("variables.%s || (%s)", vapUpdatePolicyReplacementAllowedVariable, expression)
+			replacementAllowed = true
+		}
+		rules = append(rules, vapUpdatePolicyAdmissionRule{
+			FieldPath:  vapUpdatePolicySpecPathPrefix + "." + denyRule.FieldPath,
+			Expression: expression,
+			Message:    vapUpdatePolicyAdmissionMessage(resource.Kind, denyRule),
+		})
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+
+	return &vapUpdatePolicyAdmission{
+		PackageName: packageName,
+		PolicyName:  fileStem(resource.Kind) + "-update-policy",
+		Kind:        resource.Kind,
+		Group:       pkg.GroupDNSName,
+		Version:     pkg.Version,
+		Resource:    strings.ToLower(resource.KindPlural),
+		Rules:       rules,
+
+		ReplacementAllowed: replacementAllowed,
+	}
+}
+
+// vapUpdatePolicyPackageName returns the package that installs the controller
+// for kind, or "" when the service is not controller-backed.
+func vapUpdatePolicyPackageName(pkg *PackageModel, kind string) string {
+	if pkg.Service.PackageProfile != PackageProfileControllerBacked {
+		return ""
+	}
+	for _, split := range pkg.Service.PackageSplits {
+		for _, splitKind := range split.IncludeKinds {
+			if strings.TrimSpace(splitKind) == kind {
+				return split.Name
+			}
+		}
+	}
+	return pkg.OutputName
+}
+
+// resolveVAPUpdatePolicySpecPath reports whether segments name a spec field
+// reached through struct fields only. CEL cannot compare one element of a
+// list or map in place, so such paths are not enforced at admission time.
+func resolveVAPUpdatePolicySpecPath(resource ResourceModel, segments []string) bool {
+	helperTypes := make(map[string]TypeModel, len(resource.HelperTypes))
+	for _, helperType := range resource.HelperTypes {
+		helperTypes[helperType.Name] = helperType
+	}
+
+	fields := resource.SpecFields
+	for index, segment := range segments {
+		field, ok := findVAPUpdatePolicyField(fields, segment)
+		if !ok {
+			return false
+		}
+		if index == len(segments)-1 {
+			return true
+		}
+		helperType, ok := helperTypes[strings.TrimPrefix(strings.TrimSpace(field.Type), "*")]
+		if !ok {
+			return false
+		}
+		fields = helperType.Fields
+	}
+	return false
+}
+
+func findVAPUpdatePolicyField(fields []FieldModel, jsonName string) (FieldModel, bool) {
+	for _, field := range fields {
+		if field.Embedded {
+			continue
+		}
+		if jsonTagName(field.Tag) == jsonName {
+			return field, true
+		}
+	}
+	return FieldModel{}, false
+}
+
+// vapUpdatePolicyImmutableExpression allows an update only when the path is
+// present in both objects with the same value, or absent from both.
+func vapUpdatePolicyImmutableExpression(segments []string) string {
+	newPresent := vapUpdatePolicyPresenceExpression("object", segments)
+	oldPresent := vapUpdatePolicyPresenceExpression("oldObject", segments)
+	path := vapUpdatePolicyCELPath(segments)
+	return fmt.Sprintf(
+		"%s == %s && (!%s || object.spec.%s == oldObject.spec.%s)",
+		newPresent, oldPresent, newPresent, path, path,
+	)
+}
+
+func vapUpdatePolicyPresenceExpression(root string, segments []string) string {
+	checks := make([]string, 0, len(segments))
+	for index := range segments {
+		checks = append(checks, fmt.Sprintf("has(%s.spec.%s)", root, vapUpdatePolicyCELPath(segments[:index+1])))
+	}
+	if len(checks) == 1 {
+		return checks[0]
+	}
+	return "(" + strings.Join(checks, " && ") + ")"
+}
+
+// vapUpdatePolicyCELReservedNames are the property names Kubernetes CEL
+// exposes as __<name>__.
+var vapUpdatePolicyCELReservedNames = map[string]struct{}{
+	"true": {}, "false": {}, "null": {}, "in": {}, "as": {}, "break": {}, "const": {},
+	"continue": {}, "else": {}, "for": {}, "function": {}, "if": {}, "import": {},
+	"let": {}, "loop": {}, "package": {}, "namespace": {}, "return": {}, "var": {},
+	"void": {}, "while": {},
+}
+
+func vapUpdatePolicyCELPath(segments []string) string {
+	escaped := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		if _, reserved := vapUpdatePolicyCELReservedNames[segment]; reserved {
+			segment = "__" + segment + "__"
+		}
+		escaped = append(escaped, segment)
+	}
+	return strings.Join(escaped, ".")
+}
+
+func vapUpdatePolicyAdmissionMessage(kind string, rule vapUpdatePolicyRule) string {
+	fieldPath := vapUpdatePolicySpecPathPrefix + "." + rule.FieldPath
+	if rule.Decision == mutabilityOverlayPolicyReplacementRequired {
+		return fmt.Sprintf("%s is immutable; OCI requires a new %s to change it. Set the oci.oracle.com/replace-policy annotation to replace it", fieldPath, kind)
+	}
+	return fmt.Sprintf("%s cannot be updated in place", fieldPath)
+}
+
+func sortedVAPUpdatePolicyAdmissions(artifacts []vapUpdatePolicyGeneratedArtifact) map[string][]*vapUpdatePolicyAdmission {
+	byPackage := make(map[string][]*vapUpdatePolicyAdmission)
+	for _, artifact := range artifacts {
+		if artifact.Admission == nil {
+			continue
+		}
+		byPackage[artifact.Admission.PackageName] = append(byPackage[artifact.Admission.PackageName], artifact.Admission)
+	}
+	for _, admissions := range byPackage {
+		sort.Slice(admissions, func(i, j int) bool {
+			return admissions[i].PolicyName < admissions[j].PolicyName
+		})
+	}
+	return byPackage
+}
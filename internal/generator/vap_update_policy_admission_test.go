@@ -0,0 +1,165 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBuildVAPUpdatePolicyAdmissionEnforcesResolvableReplacementRules(t *testing.T) {
+	t.Parallel()
+
+	pkg := &PackageModel{
+		Service: ServiceConfig{
+			Service:        "containerengine",
+			PackageProfile: PackageProfileControllerBacked,
+		},
+		OutputName:   "containerengine",
+		GroupDNSName: "containerengine.oracle.com",
+		Version:      "v1beta1",
+	}
+	resource := ResourceModel{
+		Kind:       "Cluster",
+		KindPlural: "Clusters",
+		SpecFields: []FieldModel{
+			{Name: "CompartmentId", Type: "string", Tag: `json:"compartmentId"`},
+			{Name: "EndpointConfig", Type: "ClusterEndpointConfig", Tag: `json:"endpointConfig,omitempty"`},
+			{Name: "Options", Type: "ClusterOptions", Tag: `json:"options,omitempty"`},
+			{Name: "Namespace", Type: "string", Tag: `json:"namespace,omitempty"`},
+		},
+		HelperTypes: []TypeModel{
+			{Name: "ClusterEndpointConfig", Fields: []FieldModel{
+				{Name: "SubnetId", Type: "string", Tag: `json:"subnetId,omitempty"`},
+			}},
+			{Name: "ClusterOptions", Fields: []FieldModel{
+				{Name: "RequiredClaims", Type: "[]ClusterRequiredClaim", Tag: `json:"requiredClaims,omitempty"`},
+			}},
+		},
+	}
+	doc := vapUpdatePolicyDocument{
+		Update: vapUpdatePolicyUpdate{
+			DenyRules: []vapUpdatePolicyRule{
+				{FieldPath: "compartmentId", Decision: mutabilityOverlayPolicyReplacementRequired},
+				{FieldPath: "endpointConfig.subnetId", Decision: mutabilityOverlayPolicyDenyInPlaceUpdate},
+				{FieldPath: "namespace", Decision: mutabilityOverlayPolicyReplacementRequired},
+				{FieldPath: "options.requiredClaims.key", Decision: mutabilityOverlayPolicyReplacementRequired},
+				{FieldPath: "kubernetesVersion", Decision: mutabilityOverlayPolicyUnknown},
+				{FieldPath: "missing", Decision: mutabilityOverlayPolicyReplacementRequired},
+			},
+		},
+	}
+
+	admission := buildVAPUpdatePolicyAdmission(pkg, resource, doc)
+	if admission == nil {
+		t.Fatal("buildVAPUpdatePolicyAdmission() = nil, want admission rules")
+	}
+	if admission.PackageName != "containerengine" || admission.PolicyName != "cluster-update-policy" || admission.Resource != "clusters" {
+		t.Fatalf("admission = %#v, want the containerengine clusters policy", admission)
+	}
+
+	var fieldPaths []string
+	for _, rule := range admission.Rules {
+		fieldPaths = append(fieldPaths, rule.FieldPath)
+	}
+	if got, want := strings.Join(fieldPaths, ","), "spec.compartmentId,spec.endpointConfig.subnetId,spec.namespace"; got != want {
+		t.Fatalf("admission rule paths = %s, want %s", got, want)
+	}
+	if got, want := admission.Rules[0].Expression, "variables.replacementAllowed || (has(object.spec.compartmentId) == has(oldObject.spec.compartmentId) && "+
+		"(!has(object.spec.compartmentId) || object.spec.compartmentId == oldObject.spec.compartmentId))"; got != want {
+		t.Fatalf("compartmentId expression = %s, want %s", got, want)
+	}
+	if got := admission.Rules[1].Expression; !strings.HasPrefix(got, "(has(object.spec.endpointConfig) && has(object.spec.endpointConfig.subnetId)) == ") {
+		t.Fatalf("endpointConfig.subnetId expression = %s, want nested presence checks", got)
+	}
+	if got := admission.Rules[2].Expression; !strings.Contains(got, "object.spec.__namespace__ == oldObject.spec.__namespace__") {
+		t.Fatalf("namespace expression = %s, want the escaped CEL property", got)
+	}
+	if got := admission.Rules[1].Message; got != "spec.endpointConfig.subnetId cannot be updated in place" {
+		t.Fatalf("endpointConfig.subnetId message = %q", got)
+	}
+	if !admission.ReplacementAllowed {
+		t.Fatal("admission.ReplacementAllowed = false, want the replace-policy variable for replacement-required rules")
+	}
+
+	pkg.Service.PackageProfile = PackageProfileCRDOnly
+	if admission := buildVAPUpdatePolicyAdmission(pkg, resource, doc); admission != nil {
+		t.Fatalf("buildVAPUpdatePolicyAdmission() for a CRD-only package = %#v, want nil", admission)
+	}
+}
+
+func TestBuildVAPUpdatePolicyAdmissionUsesSplitPackage(t *testing.T) {
+	t.Parallel()
+
+	pkg := &PackageModel{
+		Service: ServiceConfig{
+			Service:        "core",
+			PackageProfile: PackageProfileControllerBacked,
+			PackageSplits:  []PackageSplitConfig{{Name: "core-network", IncludeKinds: []string{"Subnet"}}},
+		},
+		OutputName: "core",
+	}
+	if got := vapUpdatePolicyPackageName(pkg, "Subnet"); got != "core-network" {
+		t.Fatalf("vapUpdatePolicyPackageName(Subnet) = %q, want core-network", got)
+	}
+	if got := vapUpdatePolicyPackageName(pkg, "Instance"); got != "core" {
+		t.Fatalf("vapUpdatePolicyPackageName(Instance) = %q, want core", got)
+	}
+}
+
+func TestRenderVAPUpdatePolicyPackageWritesPolicies(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	pkg := &PackageModel{
+		Service:       ServiceConfig{Service: "queue", PackageProfile: PackageProfileControllerBacked},
+		OutputName:    "queue",
+		PackageOutput: PackageOutputModel{Generate: true},
+	}
+	admission := &vapUpdatePolicyAdmission{
+		PackageName: "queue",
+		PolicyName:  "queue-update-policy",
+		Kind:        "Queue",
+		Group:       "queue.oracle.com",
+		Version:     "v1beta1",
+		Resource:    "queues",
+		Rules: []vapUpdatePolicyAdmissionRule{{
+			FieldPath:  "spec.compartmentId",
+			Expression: vapUpdatePolicyImmutableExpression([]string{"compartmentId"}),
+			Message:    "spec.compartmentId is immutable; OCI requires a new Queue to change it",
+		}},
+		ReplacementAllowed: true,
+	}
+
+	if err := NewRenderer().RenderVAPUpdatePolicyPackage(root, pkg, []*vapUpdatePolicyAdmission{admission}); err != nil {
+		t.Fatalf("RenderVAPUpdatePolicyPackage() error = %v", err)
+	}
+
+	vapDir := filepath.Join(root, "packages", "queue", "install", "vap")
+	policy := readFile(t, filepath.Join(vapDir, "queue-update-policy.yaml"))
+	for _, want := range []string{
+		"kind: ValidatingAdmissionPolicy\n",
+		"      - queues\n",
+		`  - expression: "has(object.spec.compartmentId) == has(oldObject.spec.compartmentId)`,
+		"  variables:\n  - name: replacementAllowed\n",
+		"kind: ValidatingAdmissionPolicyBinding\n",
+		"  policyName: queue-update-policy\n",
+	} {
+		if !strings.Contains(policy, want) {
+			t.Fatalf("queue-update-policy.yaml missing %q:\n%s", want, policy)
+		}
+	}
+	if kustomization := readFile(t, filepath.Join(vapDir, "kustomization.yaml")); !strings.Contains(kustomization, "\nresources:\n- queue-update-policy.yaml\n") {
+		t.Fatalf("kustomization.yaml = %s, want the queue policy resource", kustomization)
+	}
+	if _, err := os.Stat(filepath.Join(vapDir, "kustomizeconfig.yaml")); err != nil {
+		t.Fatalf("kustomizeconfig.yaml error = %v", err)
+	}
+
+	if err := NewRenderer().RenderVAPUpdatePolicyPackage(root, pkg, nil); err != nil {
+		t.Fatalf("RenderVAPUpdatePolicyPackage() without policies error = %v", err)
+	}
+	if kustomization := readFile(t, filepath.Join(vapDir, "kustomization.yaml")); !strings.Contains(kustomization, "\nresources: []\n") {
+		t.Fatalf("kustomization.yaml = %s, want an empty resource list", kustomization)
+	}
+}
@@ -11,6 +11,8 @@ type vapUpdatePolicyGeneratedArtifact struct {
 	Service      string
 	RelativePath string
 	Document     vapUpdatePolicyDocument
+	// Admission is nil when the kind has no admission-time enforcement.
+	Admission *vapUpdatePolicyAdmission
 }
 
 type vapUpdatePolicyResourceContext struct {
@@ -66,7 +68,9 @@ func buildVAPUpdatePolicyArtifacts(
 		if err != nil {
 			return nil, err
 		}
-		artifacts = append(artifacts, newVAPUpdatePolicyGeneratedArtifact(overlay.Document.Resource.Service, context.Resource, doc))
+		artifact := newVAPUpdatePolicyGeneratedArtifact(overlay.Document.Resource.Service, context.Resource, doc)
+		artifact.Admission = buildVAPUpdatePolicyAdmission(context.Package, context.Resource, doc)
+		artifacts = append(artifacts, artifact)
 	}
 
 	sort.Slice(artifacts, func(i, j int) bool {
@@ -6,6 +6,9 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
+	"strings"
+	"text/template"
 )
 
 func (r *Renderer) RenderVAPUpdatePolicyArtifacts(root string, artifacts []vapUpdatePolicyGeneratedArtifact) error {
@@ -34,6 +37,69 @@ func (r *Renderer) RenderVAPUpdatePolicyArtifacts(root string, artifacts []vapUp
 	return nil
 }
 
+// RenderVAPUpdatePolicyPackage writes the ValidatingAdmissionPolicy manifests
+// of a controller-backed package to packages/<package>/install/vap. The
+// kustomization is written even without policies because the install
+// kustomization always references it.
+func (r *Renderer) RenderVAPUpdatePolicyPackage(root string, pkg *PackageModel, admissions []*vapUpdatePolicyAdmission) error {
+	if !pkg.PackageOutput.Generate || pkg.Service.PackageProfile != PackageProfileControllerBacked {
+		return nil
+	}
+
+	outputDir := filepath.Join(root, "packages", pkg.OutputName, filepath.FromSlash(vapUpdatePolicyInstallDir))
+	if err := os.MkdirAll(outputDir, 0o755); err != nil {
+		return fmt.Errorf("create vap update policy package dir %q: %w", outputDir, err)
+	}
+
+	files := make([]string, 0, len(admissions))
+	for _, admission := range admissions {
+		content, err := executeVAPUpdatePolicyTemplate(vapUpdatePolicyAdmissionTemplate, admission)
+		if err != nil {
+			return fmt.Errorf("render vap update policy for kind %q: %w", admission.Kind, err)
+		}
+		fileName := admission.PolicyName + ".yaml"
+		if err := os.WriteFile(filepath.Join(outputDir, fileName), []byte(content), 0o644); err != nil {
+			return fmt.Errorf("write vap update policy %q: %w", filepath.Join(outputDir, fileName), err)
+		}
+		files = append(files, fileName)
+	}
+
+	kustomization, err := executeVAPUpdatePolicyTemplate(vapUpdatePolicyKustomizationTemplate, files)
+	if err != nil {
+		return fmt.Errorf("render vap update policy kustomization: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(outputDir, "kustomization.yaml"), []byte(kustomization), 0o644); err != nil {
+		return fmt.Errorf("write vap update policy kustomization %q: %w", outputDir, err)
+	}
+	if err := os.WriteFile(filepath.Join(outputDir, "kustomizeconfig.yaml"), []byte(vapUpdatePolicyKustomizeConfig), 0o644); err != nil {
+		return fmt.Errorf("write vap update policy kustomizeconfig %q: %w", outputDir, err)
+	}
+	return nil
+}
+
+func executeVAPUpdatePolicyTemplate(content string, data any) (string, error) {
+	tmpl, err := template.New("vap").Funcs(template.FuncMap{
+		"quote": strconv.Quote,
+		"replacementAllowedVariable": func() string {
+			return vapUpdatePolicyReplacementAllowedVariable
+		},
+		"replacementAllowedExpression": func() string {
+			return vapUpdatePolicyReplacementAllowedExpression
+		},
+		"marker": func() string {
+			return generatedFileMarker
+		},
+	}).Parse(content)
+	if err != nil {
+		return "", err
+	}
+	var out strings.Builder
+	if err := tmpl.Execute(&out, data); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
+
 func renderVAPUpdatePolicyArtifact(doc vapUpdatePolicyDocument) ([]byte, error) {
 	if err := validateVAPUpdatePolicyDocument(doc); err != nil {
 		return nil, err
@@ -44,3 +110,76 @@ func renderVAPUpdatePolicyArtifact(doc vapUpdatePolicyDocument) ([]byte, error)
 	}
 	return append(content, '\n'), nil
 }
+
+const vapUpdatePolicyAdmissionTemplate = `# {{ marker }}
+
+# Rejects updates to spec fields of {{ .Kind }} that OCI cannot change in place,
+# instead of failing the reconcile. Requires Kubernetes 1.30+.
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingAdmissionPolicy
+metadata:
+  name: {{ .PolicyName }}
+spec:
+  failurePolicy: Fail
+  matchConstraints:
+    resourceRules:
+    - apiGroups:
+      - {{ .Group }}
+      apiVersions:
+      - {{ .Version }}
+      operations:
+      - UPDATE
+      resources:
+      - {{ .Resource }}
+  matchConditions:
+  - name: has-spec
+    expression: has(object.spec) && has(oldObject.spec)
+{{- if .ReplacementAllowed }}
+  variables:
+  - name: {{ replacementAllowedVariable }}
+    expression: {{ quote replacementAllowedExpression }}
+{{- end }}
+  validations:
+{{- range .Rules }}
+  - expression: {{ quote .Expression }}
+    message: {{ quote .Message }}
+    reason: Invalid
+{{- end }}
+---
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingAdmissionPolicyBinding
+metadata:
+  name: {{ .PolicyName }}
+spec:
+  policyName: {{ .PolicyName }}
+  validationActions:
+  - Deny
+`
+
+const vapUpdatePolicyKustomizationTemplate = `# {{ marker }}
+apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+{{ if . }}
+resources:
+{{- range . }}
+- {{ . }}
+{{- end }}
+{{- else }}
+resources: []
+{{- end }}
+
+configurations:
+- kustomizeconfig.yaml
+`
+
+const vapUpdatePolicyKustomizeConfig = `# ` + generatedFileMarker + `
+
+# This file is for teaching kustomize how to substitute the policy name referenced by its binding
+nameReference:
+- kind: ValidatingAdmissionPolicy
+  group: admissionregistration.k8s.io
+  fieldSpecs:
+  - kind: ValidatingAdmissionPolicyBinding
+    group: admissionregistration.k8s.io
+    path: spec/policyName
+`
@@ -20,7 +20,7 @@ func init() {
 		AddToScheme: apigatewayv1beta1.AddToScheme,
 		SetupWithManager: func(ctx Context) error {
 			if err := (&apigatewaycontrollers.ApiGatewayReconciler{
-				Reconciler: NewBaseReconciler(
+				Reconciler: newHandwrittenReconciler(
 					ctx,
 					"ApiGateway",
 					func(deps servicemanager.RuntimeDeps) servicemanager.OSOKServiceManager {
@@ -31,7 +31,7 @@ func init() {
 				return fmt.Errorf("setup ApiGateway controller: %w", err)
 			}
 			if err := (&apigatewaycontrollers.ApiGatewayDeploymentReconciler{
-				Reconciler: NewBaseReconciler(
+				Reconciler: newHandwrittenReconciler(
 					ctx,
 					"ApiGatewayDeployment",
 					func(deps servicemanager.RuntimeDeps) servicemanager.OSOKServiceManager {
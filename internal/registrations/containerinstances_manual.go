@@ -20,7 +20,7 @@ func init() {
 		AddToScheme: containerinstancesv1beta1.AddToScheme,
 		SetupWithManager: func(ctx Context) error {
 			if err := (&containerinstancescontrollers.ContainerInstanceReconciler{
-				Reconciler: NewBaseReconciler(
+				Reconciler: newHandwrittenReconciler(
 					ctx,
 					"ContainerInstance",
 					func(deps servicemanager.RuntimeDeps) servicemanager.OSOKServiceManager {
@@ -20,7 +20,7 @@ func init() {
 		AddToScheme: functionsv1beta1.AddToScheme,
 		SetupWithManager: func(ctx Context) error {
 			if err := (&functionscontrollers.ApplicationReconciler{
-				Reconciler: NewBaseReconciler(
+				Reconciler: newHandwrittenReconciler(
 					ctx,
 					"Application",
 					func(deps servicemanager.RuntimeDeps) servicemanager.OSOKServiceManager {
@@ -31,7 +31,7 @@ func init() {
 				return fmt.Errorf("setup Application controller: %w", err)
 			}
 			if err := (&functionscontrollers.FunctionReconciler{
-				Reconciler: NewBaseReconciler(
+				Reconciler: newHandwrittenReconciler(
 					ctx,
 					"Function",
 					func(deps servicemanager.RuntimeDeps) servicemanager.OSOKServiceManager {
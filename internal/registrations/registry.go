@@ -10,6 +10,7 @@ import (
 
 	"github.com/oracle/oci-service-operator/pkg/core"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociidentity"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
@@ -38,6 +39,13 @@ type Context struct {
 	Scheme             *runtime.Scheme
 	EventRecorderFor   func(string) record.EventRecorder
 	ServiceManagerDeps servicemanager.RuntimeDeps
+	// Drift is the periodic drift resync configuration shared by every kind.
+	Drift core.DriftConfig
+	// Plan is the plan mode configuration shared by every kind.
+	Plan core.PlanConfig
+	// Identities resolves per-resource OCIIdentity credentials. When nil every
+	// resource uses ServiceManagerDeps.Provider.
+	Identities *ociidentity.Resolver
 }
 
 var generatedGroupRegistrations []GroupRegistration
@@ -67,15 +75,26 @@ func NewBaseReconciler(ctx Context, component string, factory servicemanager.Fac
 
 	return &core.BaseReconciler{
 		Client:             ctx.Client,
-		OSOKServiceManager: factory(serviceManagerDeps),
+		OSOKServiceManager: ociidentity.NewServiceManager(factory, serviceManagerDeps, ctx.Identities),
 		Finalizer:          core.NewBaseFinalizer(ctx.Client, ctrl.Log),
 		Log:                loggerutil.OSOKLogger{Logger: ctrl.Log.WithName("controllers").WithName(component)},
 		Metrics:            serviceManagerDeps.Metrics,
 		Recorder:           recorder,
 		Scheme:             ctx.Scheme,
+		Drift:              ctx.Drift,
+		Plan:               ctx.Plan,
 	}
 }
 
+// newHandwrittenReconciler wires a kind whose service manager calls OCI
+// directly instead of through the generated runtime. Such managers cannot
+// plan, so plan mode skips them.
+func newHandwrittenReconciler(ctx Context, component string, factory servicemanager.Factory) *core.BaseReconciler {
+	reconciler := NewBaseReconciler(ctx, component, factory)
+	reconciler.PlanUnsupported = true
+	return reconciler
+}
+
 func registerGeneratedGroup(registration GroupRegistration) {
 	generatedGroupRegistrations = append(generatedGroupRegistrations, registration)
 }
@@ -6,10 +6,12 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 
 	"github.com/oracle/oci-go-sdk/v65/common"
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/oci/v1beta1"
 	"github.com/oracle/oci-service-operator/go_ensurefips"
 	"github.com/oracle/oci-service-operator/internal/registrations"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
@@ -30,9 +32,16 @@ import (
 
 	"github.com/oracle/oci-service-operator/pkg/authhelper"
 	"github.com/oracle/oci-service-operator/pkg/config"
+	"github.com/oracle/oci-service-operator/pkg/core"
+	"github.com/oracle/oci-service-operator/pkg/credhelper"
 	"github.com/oracle/oci-service-operator/pkg/credhelper/kubesecret"
+	"github.com/oracle/oci-service-operator/pkg/credhelper/vault"
 	"github.com/oracle/oci-service-operator/pkg/metrics"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
+	"github.com/oracle/oci-service-operator/pkg/ociidentity"
+	"github.com/oracle/oci-service-operator/pkg/ratelimit"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	"github.com/oracle/oci-service-operator/pkg/tracing"
 	"github.com/oracle/oci-service-operator/pkg/util"
 	// +kubebuilder:scaffold:imports
 )
@@ -46,6 +55,7 @@ type managerFlags = startupFlags
 
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(ociv1beta1.AddToScheme(scheme))
 
 	for _, registration := range registrations.All() {
 		utilruntime.Must(registration.AddToScheme(scheme))
@@ -71,12 +81,50 @@ func run() error {
 		return err
 	}
 
+	driftConfig, err := resolveDriftConfig(startup)
+	if err != nil {
+		return err
+	}
+
+	planConfig, err := resolvePlanConfig(startup)
+	if err != nil {
+		return err
+	}
+
+	rateLimitConfig, err := resolveRateLimitConfig(startup)
+	if err != nil {
+		return err
+	}
+	ratelimit.Configure(rateLimitConfig)
+
+	ociClientConfig, err := resolveOCIClientConfig(startup)
+	if err != nil {
+		return err
+	}
+	if err := ociclient.Configure(ociClientConfig); err != nil {
+		return fmt.Errorf("unable to configure the OCI clients: %w", err)
+	}
+
+	tracingConfig, err := resolveTracingConfig(startup)
+	if err != nil {
+		return err
+	}
+	shutdownTracing, err := tracing.Setup(context.Background(), tracingConfig)
+	if err != nil {
+		return fmt.Errorf("unable to set up tracing: %w", err)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			setupLog.ErrorLog(err, "unable to flush traces")
+		}
+	}()
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), options)
 	if err != nil {
 		return fmt.Errorf("unable to start manager: %w", err)
 	}
 
-	if err := setupRegistrations(mgr, startup.initOSOKResources); err != nil {
+	if err := setupRegistrations(mgr, startup.initOSOKResources, driftConfig, planConfig); err != nil {
 		return err
 	}
 
@@ -237,7 +285,7 @@ func newCredentialClient(mgr credentialClientManager, metricsClient *metrics.Met
 	)
 }
 
-func setupRegistrations(mgr ctrl.Manager, initOSOKResources bool) error {
+func setupRegistrations(mgr ctrl.Manager, initOSOKResources bool, driftConfig core.DriftConfig, planConfig core.PlanConfig) error {
 	if initOSOKResources {
 		util.InitOSOK(mgr.GetConfig(), loggerutil.OSOKLogger{Logger: ctrl.Log.WithName("setup").WithName("initOSOK")})
 	}
@@ -248,6 +296,9 @@ func setupRegistrations(mgr ctrl.Manager, initOSOKResources bool) error {
 	}
 
 	registrationContext := registrations.NewContext(mgr, runtimeDeps)
+	registrationContext.Drift = driftConfig
+	registrationContext.Plan = planConfig
+	registrationContext.Identities = newIdentityResolver(mgr)
 	for _, registration := range registrations.All() {
 		if err := registration.SetupWithManager(registrationContext); err != nil {
 			return err
@@ -261,6 +312,16 @@ func setupRegistrations(mgr ctrl.Manager, initOSOKResources bool) error {
 	return nil
 }
 
+func newIdentityResolver(mgr credentialClientManager) *ociidentity.Resolver {
+	return &ociidentity.Resolver{
+		Client:       mgr.GetClient(),
+		SecretReader: mgr.GetAPIReader(),
+		AuthProvider: &authhelper.AuthConfigProvider{
+			Log: loggerutil.OSOKLogger{Logger: ctrl.Log.WithName("identity").WithName("config")},
+		},
+	}
+}
+
 func buildRuntimeDeps(mgr ctrl.Manager) (servicemanager.RuntimeDeps, error) {
 	setupLog.InfoLog("Getting the config details")
 	configLogger := loggerutil.OSOKLogger{Logger: ctrl.Log.WithName("setup").WithName("config")}
@@ -273,7 +334,17 @@ func buildRuntimeDeps(mgr ctrl.Manager) (servicemanager.RuntimeDeps, error) {
 	}
 
 	metricsClient := metrics.Init("osok", loggerutil.OSOKLogger{Logger: ctrl.Log.WithName("metrics")})
-	credClient := newCredentialClient(mgr, metricsClient)
+	var credClient credhelper.CredentialClient = newCredentialClient(mgr, metricsClient)
+	if vaultDetails := osokCfg.VaultDetails(); vaultDetails != "" {
+		details, err := vault.ParseDetails(vaultDetails)
+		if err != nil {
+			return servicemanager.RuntimeDeps{}, fmt.Errorf("invalid VAULTDETAILS: %w", err)
+		}
+		setupLog.InfoLog("Storing credential secrets in OCI Vault", "vaultId", details.VaultId)
+		credClient = vault.NewVaultClient(provider,
+			loggerutil.OSOKLogger{Logger: ctrl.Log.WithName("credential-helper").WithName("VaultClient")},
+			metricsClient, details)
+	}
 
 	return servicemanager.RuntimeDeps{
 		Provider:         provider,
@@ -6,6 +6,10 @@ import (
 	"os"
 	"time"
 
+	"github.com/oracle/oci-service-operator/pkg/core"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
+	"github.com/oracle/oci-service-operator/pkg/ratelimit"
+	"github.com/oracle/oci-service-operator/pkg/tracing"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
@@ -43,6 +47,11 @@ type controllerManagerConfigFile struct {
 	Metrics                 metricsConfigFile         `json:"metrics,omitempty"`
 	Health                  healthConfigFile          `json:"health,omitempty"`
 	Webhook                 webhookConfigFile         `json:"webhook,omitempty"`
+	Drift                   *driftConfigFile          `json:"drift,omitempty"`
+	Plan                    *planConfigFile           `json:"plan,omitempty"`
+	Tracing                 *tracingConfigFile        `json:"tracing,omitempty"`
+	RateLimit               *rateLimitConfigFile      `json:"rateLimit,omitempty"`
+	OCIClient               *ociClientConfigFile      `json:"ociClient,omitempty"`
 }
 
 type leaderElectionConfigFile struct {
@@ -77,6 +86,63 @@ type webhookConfigFile struct {
 	CertDir string `json:"certDir,omitempty"`
 }
 
+// driftConfigFile is the OSOK extension of the manager config that controls
+// periodic drift resync. Kind entries override the top-level values field by
+// field and are keyed by "Kind.group" or the bare Kind name.
+type driftConfigFile struct {
+	ResyncInterval *metav1.Duration                 `json:"resyncInterval,omitempty"`
+	AutoCorrect    *bool                            `json:"autoCorrect,omitempty"`
+	Kinds          map[string]driftPolicyConfigFile `json:"kinds,omitempty"`
+}
+
+type driftPolicyConfigFile struct {
+	ResyncInterval *metav1.Duration `json:"resyncInterval,omitempty"`
+	AutoCorrect    *bool            `json:"autoCorrect,omitempty"`
+}
+
+type planConfigFile struct {
+	Enabled    bool     `json:"enabled,omitempty"`
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// tracingConfigFile selects the OpenTelemetry span exporter. The exporter
+// names match tracing.Exporter.
+type tracingConfigFile struct {
+	Exporter    string   `json:"exporter,omitempty"`
+	Endpoint    string   `json:"endpoint,omitempty"`
+	Insecure    bool     `json:"insecure,omitempty"`
+	Path        string   `json:"path,omitempty"`
+	SampleRatio *float64 `json:"sampleRatio,omitempty"`
+}
+
+// rateLimitConfigFile limits the OCI calls made by every service client.
+// Service entries override the top-level values field by field and are keyed
+// by the OCI SDK package name, such as "core" or "mysql".
+type rateLimitConfigFile struct {
+	rateLimitPolicyConfigFile `json:",inline"`
+	Services                  map[string]rateLimitPolicyConfigFile `json:"services,omitempty"`
+}
+
+type rateLimitPolicyConfigFile struct {
+	QPS            *float64                  `json:"qps,omitempty"`
+	Burst          *int                      `json:"burst,omitempty"`
+	CircuitBreaker *circuitBreakerConfigFile `json:"circuitBreaker,omitempty"`
+}
+
+type circuitBreakerConfigFile struct {
+	FailureThreshold *uint32          `json:"failureThreshold,omitempty"`
+	OpenTimeout      *metav1.Duration `json:"openTimeout,omitempty"`
+}
+
+// ociClientConfigFile changes how OCI SDK clients reach OCI, for air-gapped
+// realms, proxies, and local OCI stand-ins. Endpoint keys match
+// ociclient.Config.Endpoints.
+type ociClientConfigFile struct {
+	Endpoints map[string]string `json:"endpoints,omitempty"`
+	CABundle  string            `json:"caBundle,omitempty"`
+	Proxy     string            `json:"proxy,omitempty"`
+}
+
 func parseStartupFlags() startupFlags {
 	flags := startupFlags{
 		zapOptions: zap.Options{
@@ -116,6 +182,225 @@ func resolveManagerOptions(flags startupFlags) (ctrl.Options, error) {
 	return options, nil
 }
 
+func resolveDriftConfig(flags startupFlags) (core.DriftConfig, error) {
+	if flags.configFile == "" {
+		return core.DriftConfig{}, nil
+	}
+
+	cfg, err := readControllerManagerConfigFile(flags.configFile)
+	if err != nil {
+		return core.DriftConfig{}, err
+	}
+	return cfg.Drift.toDriftConfig()
+}
+
+func resolvePlanConfig(flags startupFlags) (core.PlanConfig, error) {
+	if flags.configFile == "" {
+		return core.PlanConfig{}, nil
+	}
+
+	cfg, err := readControllerManagerConfigFile(flags.configFile)
+	if err != nil {
+		return core.PlanConfig{}, err
+	}
+	return cfg.Plan.toPlanConfig()
+}
+
+func resolveTracingConfig(flags startupFlags) (tracing.Config, error) {
+	if flags.configFile == "" {
+		return tracing.Config{}, nil
+	}
+
+	cfg, err := readControllerManagerConfigFile(flags.configFile)
+	if err != nil {
+		return tracing.Config{}, err
+	}
+	return cfg.Tracing.toTracingConfig()
+}
+
+func resolveRateLimitConfig(flags startupFlags) (ratelimit.Config, error) {
+	if flags.configFile == "" {
+		return ratelimit.Config{}, nil
+	}
+
+	cfg, err := readControllerManagerConfigFile(flags.configFile)
+	if err != nil {
+		return ratelimit.Config{}, err
+	}
+	return cfg.RateLimit.toRateLimitConfig()
+}
+
+func resolveOCIClientConfig(flags startupFlags) (ociclient.Config, error) {
+	if flags.configFile == "" {
+		return ociclient.Config{}, nil
+	}
+
+	cfg, err := readControllerManagerConfigFile(flags.configFile)
+	if err != nil {
+		return ociclient.Config{}, err
+	}
+	return cfg.OCIClient.toOCIClientConfig()
+}
+
+func readControllerManagerConfigFile(path string) (controllerManagerConfigFile, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return controllerManagerConfigFile{}, fmt.Errorf("unable to load the config file: %w", err)
+	}
+	cfg := controllerManagerConfigFile{}
+	if err := yaml.UnmarshalStrict(content, &cfg); err != nil {
+		return controllerManagerConfigFile{}, fmt.Errorf("unable to load the config file: %w", err)
+	}
+	return cfg, nil
+}
+
+func (cfg *planConfigFile) toPlanConfig() (core.PlanConfig, error) {
+	if cfg == nil {
+		return core.PlanConfig{}, nil
+	}
+	if !cfg.Enabled && len(cfg.Namespaces) > 0 {
+		return core.PlanConfig{}, fmt.Errorf("plan.namespaces is set but plan.enabled is false")
+	}
+	for _, namespace := range cfg.Namespaces {
+		if namespace == "" {
+			return core.PlanConfig{}, fmt.Errorf("plan.namespaces must not contain an empty namespace")
+		}
+	}
+	return core.PlanConfig{Enabled: cfg.Enabled, Namespaces: append([]string(nil), cfg.Namespaces...)}, nil
+}
+
+func (cfg *tracingConfigFile) toTracingConfig() (tracing.Config, error) {
+	if cfg == nil {
+		return tracing.Config{}, nil
+	}
+	tracingConfig := tracing.Config{
+		Exporter: tracing.Exporter(cfg.Exporter),
+		Endpoint: cfg.Endpoint,
+		Insecure: cfg.Insecure,
+		Path:     cfg.Path,
+	}
+	switch tracingConfig.Exporter {
+	case "", tracing.ExporterNone, tracing.ExporterOTLP, tracing.ExporterStdout:
+	case tracing.ExporterFile:
+		if cfg.Path == "" {
+			return tracing.Config{}, fmt.Errorf("tracing.path is required by the file exporter")
+		}
+	default:
+		return tracing.Config{}, fmt.Errorf("tracing.exporter = %q, want one of none, otlp, stdout, or file", cfg.Exporter)
+	}
+	if cfg.SampleRatio != nil {
+		if *cfg.SampleRatio <= 0 || *cfg.SampleRatio > 1 {
+			return tracing.Config{}, fmt.Errorf("tracing.sampleRatio = %v, want a value in (0, 1]", *cfg.SampleRatio)
+		}
+		tracingConfig.SampleRatio = *cfg.SampleRatio
+	}
+	return tracingConfig, nil
+}
+
+func (cfg *ociClientConfigFile) toOCIClientConfig() (ociclient.Config, error) {
+	if cfg == nil {
+		return ociclient.Config{}, nil
+	}
+	ociClientConfig := ociclient.Config{
+		Endpoints: cfg.Endpoints,
+		CABundle:  cfg.CABundle,
+		Proxy:     cfg.Proxy,
+	}
+	if err := ociClientConfig.Validate(); err != nil {
+		return ociclient.Config{}, fmt.Errorf("ociClient: %w", err)
+	}
+	return ociClientConfig, nil
+}
+
+func (cfg *rateLimitConfigFile) toRateLimitConfig() (ratelimit.Config, error) {
+	if cfg == nil {
+		return ratelimit.Config{}, nil
+	}
+	defaultPolicy, err := cfg.rateLimitPolicyConfigFile.apply(ratelimit.Policy{}, "rateLimit")
+	if err != nil {
+		return ratelimit.Config{}, err
+	}
+	rateLimitConfig := ratelimit.Config{Default: defaultPolicy}
+	for service, override := range cfg.Services {
+		if service == "" {
+			return ratelimit.Config{}, fmt.Errorf("rateLimit.services must not contain an empty service name")
+		}
+		policy, err := override.apply(defaultPolicy, "rateLimit.services."+service)
+		if err != nil {
+			return ratelimit.Config{}, err
+		}
+		if rateLimitConfig.Services == nil {
+			rateLimitConfig.Services = map[string]ratelimit.Policy{}
+		}
+		rateLimitConfig.Services[service] = policy
+	}
+	return rateLimitConfig, nil
+}
+
+func (cfg rateLimitPolicyConfigFile) apply(policy ratelimit.Policy, field string) (ratelimit.Policy, error) {
+	if cfg.QPS != nil {
+		if *cfg.QPS < 0 {
+			return ratelimit.Policy{}, fmt.Errorf("%s.qps = %v, want zero for unlimited or a positive rate", field, *cfg.QPS)
+		}
+		policy.QPS = *cfg.QPS
+	}
+	if cfg.Burst != nil {
+		if *cfg.Burst < 0 {
+			return ratelimit.Policy{}, fmt.Errorf("%s.burst = %d, want a non-negative value", field, *cfg.Burst)
+		}
+		policy.Burst = *cfg.Burst
+	}
+	if cfg.CircuitBreaker != nil {
+		if cfg.CircuitBreaker.FailureThreshold != nil {
+			policy.FailureThreshold = *cfg.CircuitBreaker.FailureThreshold
+		}
+		if cfg.CircuitBreaker.OpenTimeout != nil {
+			if cfg.CircuitBreaker.OpenTimeout.Duration < 0 {
+				return ratelimit.Policy{}, fmt.Errorf("%s.circuitBreaker.openTimeout must not be negative", field)
+			}
+			policy.OpenTimeout = cfg.CircuitBreaker.OpenTimeout.Duration
+		}
+	}
+	return policy, nil
+}
+
+func (cfg *driftConfigFile) toDriftConfig() (core.DriftConfig, error) {
+	if cfg == nil {
+		return core.DriftConfig{}, nil
+	}
+
+	defaults, err := driftPolicyConfigFile{ResyncInterval: cfg.ResyncInterval, AutoCorrect: cfg.AutoCorrect}.toDriftPolicy(core.DriftPolicy{})
+	if err != nil {
+		return core.DriftConfig{}, fmt.Errorf("drift: %w", err)
+	}
+	driftConfig := core.DriftConfig{Default: defaults}
+	if len(cfg.Kinds) > 0 {
+		driftConfig.Kinds = make(map[string]core.DriftPolicy, len(cfg.Kinds))
+	}
+	for kind, kindCfg := range cfg.Kinds {
+		policy, err := kindCfg.toDriftPolicy(defaults)
+		if err != nil {
+			return core.DriftConfig{}, fmt.Errorf("drift.kinds[%s]: %w", kind, err)
+		}
+		driftConfig.Kinds[kind] = policy
+	}
+	return driftConfig, nil
+}
+
+func (cfg driftPolicyConfigFile) toDriftPolicy(defaults core.DriftPolicy) (core.DriftPolicy, error) {
+	policy := defaults
+	if cfg.ResyncInterval != nil {
+		if cfg.ResyncInterval.Duration < 0 {
+			return core.DriftPolicy{}, fmt.Errorf("resyncInterval = %s, want a non-negative duration", cfg.ResyncInterval.Duration)
+		}
+		policy.ResyncInterval = cfg.ResyncInterval.Duration
+	}
+	if cfg.AutoCorrect != nil {
+		policy.AutoCorrect = *cfg.AutoCorrect
+	}
+	return policy, nil
+}
+
 func defaultManagerOptions(flags startupFlags) ctrl.Options {
 	return ctrl.Options{
 		Scheme:                 scheme,
@@ -3,10 +3,15 @@ package main
 import (
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/oracle/oci-service-operator/pkg/core"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
+	"github.com/oracle/oci-service-operator/pkg/ratelimit"
+	"github.com/oracle/oci-service-operator/pkg/tracing"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
@@ -189,6 +194,90 @@ func TestLoadManagerOptionsFromFileRejectsInvalidTypeMeta(t *testing.T) {
 	}
 }
 
+func TestResolveDriftConfigAppliesKindOverrides(t *testing.T) {
+	t.Parallel()
+
+	configPath := writeTempManagerConfig(t, `
+apiVersion: controller-runtime.sigs.k8s.io/v1alpha1
+kind: ControllerManagerConfiguration
+drift:
+  resyncInterval: "30m"
+  kinds:
+    SecurityList.core.oracle.com:
+      autoCorrect: true
+    Queue:
+      resyncInterval: "0s"
+`)
+
+	driftConfig, err := resolveDriftConfig(startupFlags{configFile: configPath})
+	if err != nil {
+		t.Fatalf("resolveDriftConfig() error = %v", err)
+	}
+	if want := (core.DriftPolicy{ResyncInterval: 30 * time.Minute}); driftConfig.Default != want {
+		t.Fatalf("drift default = %#v, want %#v", driftConfig.Default, want)
+	}
+	if want := (core.DriftPolicy{ResyncInterval: 30 * time.Minute, AutoCorrect: true}); driftConfig.Kinds["SecurityList.core.oracle.com"] != want {
+		t.Fatalf("SecurityList drift policy = %#v, want %#v", driftConfig.Kinds["SecurityList.core.oracle.com"], want)
+	}
+	if want := (core.DriftPolicy{}); driftConfig.Kinds["Queue"] != want {
+		t.Fatalf("Queue drift policy = %#v, want resync disabled", driftConfig.Kinds["Queue"])
+	}
+}
+
+func TestResolveDriftConfigRejectsNegativeInterval(t *testing.T) {
+	t.Parallel()
+
+	configPath := writeTempManagerConfig(t, `
+apiVersion: controller-runtime.sigs.k8s.io/v1alpha1
+kind: ControllerManagerConfiguration
+drift:
+  kinds:
+    Vcn:
+      resyncInterval: "-1m"
+`)
+
+	if _, err := resolveDriftConfig(startupFlags{configFile: configPath}); err == nil || !strings.Contains(err.Error(), "drift.kinds[Vcn]") {
+		t.Fatalf("resolveDriftConfig() error = %v, want negative interval failure", err)
+	}
+}
+
+func TestResolvePlanConfigReadsNamespaces(t *testing.T) {
+	t.Parallel()
+
+	configPath := writeTempManagerConfig(t, `
+apiVersion: controller-runtime.sigs.k8s.io/v1alpha1
+kind: ControllerManagerConfiguration
+plan:
+  enabled: true
+  namespaces:
+  - payments
+`)
+
+	planConfig, err := resolvePlanConfig(startupFlags{configFile: configPath})
+	if err != nil {
+		t.Fatalf("resolvePlanConfig() error = %v", err)
+	}
+	if !planConfig.Enabled || len(planConfig.Namespaces) != 1 || planConfig.Namespaces[0] != "payments" {
+		t.Fatalf("plan config = %#v, want enabled for the payments namespace", planConfig)
+	}
+}
+
+func TestResolvePlanConfigRejectsNamespacesWhenDisabled(t *testing.T) {
+	t.Parallel()
+
+	configPath := writeTempManagerConfig(t, `
+apiVersion: controller-runtime.sigs.k8s.io/v1alpha1
+kind: ControllerManagerConfiguration
+plan:
+  namespaces:
+  - payments
+`)
+
+	if _, err := resolvePlanConfig(startupFlags{configFile: configPath}); err == nil || !strings.Contains(err.Error(), "plan.enabled") {
+		t.Fatalf("resolvePlanConfig() error = %v, want disabled plan failure", err)
+	}
+}
+
 func writeTempManagerConfig(t *testing.T, content string) string {
 	t.Helper()
 
@@ -292,3 +381,142 @@ func assertManagerOptionsWebhookConfig(t *testing.T, options ctrl.Options) {
 		t.Fatal("options.WebhookServer = nil, want initialized server")
 	}
 }
+
+func TestResolveTracingConfigReadsExporter(t *testing.T) {
+	t.Parallel()
+
+	configPath := writeTempManagerConfig(t, `
+apiVersion: controller-runtime.sigs.k8s.io/v1alpha1
+kind: ControllerManagerConfiguration
+tracing:
+  exporter: otlp
+  endpoint: otel-collector.observability:4318
+  insecure: true
+  sampleRatio: 0.25
+`)
+
+	tracingConfig, err := resolveTracingConfig(startupFlags{configFile: configPath})
+	if err != nil {
+		t.Fatalf("resolveTracingConfig() error = %v", err)
+	}
+	want := tracing.Config{Exporter: tracing.ExporterOTLP, Endpoint: "otel-collector.observability:4318", Insecure: true, SampleRatio: 0.25}
+	if tracingConfig != want {
+		t.Fatalf("tracing config = %#v, want %#v", tracingConfig, want)
+	}
+}
+
+func TestResolveTracingConfigRejectsFileExporterWithoutPath(t *testing.T) {
+	t.Parallel()
+
+	configPath := writeTempManagerConfig(t, `
+apiVersion: controller-runtime.sigs.k8s.io/v1alpha1
+kind: ControllerManagerConfiguration
+tracing:
+  exporter: file
+`)
+
+	if _, err := resolveTracingConfig(startupFlags{configFile: configPath}); err == nil || !strings.Contains(err.Error(), "tracing.path") {
+		t.Fatalf("resolveTracingConfig() error = %v, want missing path failure", err)
+	}
+}
+
+func TestResolveRateLimitConfigMergesServiceOverrides(t *testing.T) {
+	t.Parallel()
+
+	configPath := writeTempManagerConfig(t, `
+apiVersion: controller-runtime.sigs.k8s.io/v1alpha1
+kind: ControllerManagerConfiguration
+rateLimit:
+  qps: 10
+  burst: 20
+  circuitBreaker:
+    failureThreshold: 5
+    openTimeout: 1m
+  services:
+    core:
+      qps: 2
+      burst: 4
+    mysql:
+      circuitBreaker:
+        failureThreshold: 0
+`)
+
+	rateLimitConfig, err := resolveRateLimitConfig(startupFlags{configFile: configPath})
+	if err != nil {
+		t.Fatalf("resolveRateLimitConfig() error = %v", err)
+	}
+	want := ratelimit.Config{
+		Default: ratelimit.Policy{QPS: 10, Burst: 20, FailureThreshold: 5, OpenTimeout: time.Minute},
+		Services: map[string]ratelimit.Policy{
+			"core":  {QPS: 2, Burst: 4, FailureThreshold: 5, OpenTimeout: time.Minute},
+			"mysql": {QPS: 10, Burst: 20, OpenTimeout: time.Minute},
+		},
+	}
+	if !reflect.DeepEqual(rateLimitConfig, want) {
+		t.Fatalf("rate limit config = %#v, want %#v", rateLimitConfig, want)
+	}
+}
+
+func TestResolveRateLimitConfigRejectsNegativeQPS(t *testing.T) {
+	t.Parallel()
+
+	configPath := writeTempManagerConfig(t, `
+apiVersion: controller-runtime.sigs.k8s.io/v1alpha1
+kind: ControllerManagerConfiguration
+rateLimit:
+  services:
+    core:
+      qps: -1
+`)
+
+	if _, err := resolveRateLimitConfig(startupFlags{configFile: configPath}); err == nil || !strings.Contains(err.Error(), "rateLimit.services.core.qps") {
+		t.Fatalf("resolveRateLimitConfig() error = %v, want negative qps failure", err)
+	}
+}
+
+func TestResolveOCIClientConfigReadsOverrides(t *testing.T) {
+	t.Parallel()
+
+	configPath := writeTempManagerConfig(t, `
+apiVersion: controller-runtime.sigs.k8s.io/v1alpha1
+kind: ControllerManagerConfiguration
+ociClient:
+  endpoints:
+    core: https://oci-fake.test:8443
+    identity.IdentityClient: http://127.0.0.1:18443
+  caBundle: /etc/osok/ca/ca.pem
+  proxy: http://proxy.example.com:3128
+`)
+
+	ociClientConfig, err := resolveOCIClientConfig(startupFlags{configFile: configPath})
+	if err != nil {
+		t.Fatalf("resolveOCIClientConfig() error = %v", err)
+	}
+	want := ociclient.Config{
+		Endpoints: map[string]string{
+			"core":                    "https://oci-fake.test:8443",
+			"identity.IdentityClient": "http://127.0.0.1:18443",
+		},
+		CABundle: "/etc/osok/ca/ca.pem",
+		Proxy:    "http://proxy.example.com:3128",
+	}
+	if !reflect.DeepEqual(ociClientConfig, want) {
+		t.Fatalf("OCI client config = %#v, want %#v", ociClientConfig, want)
+	}
+}
+
+func TestResolveOCIClientConfigRejectsEndpointWithoutScheme(t *testing.T) {
+	t.Parallel()
+
+	configPath := writeTempManagerConfig(t, `
+apiVersion: controller-runtime.sigs.k8s.io/v1alpha1
+kind: ControllerManagerConfiguration
+ociClient:
+  endpoints:
+    core: oci-fake.test:8443
+`)
+
+	if _, err := resolveOCIClientConfig(startupFlags{configFile: configPath}); err == nil || !strings.Contains(err.Error(), "ociClient: endpoint override for core") {
+		t.Fatalf("resolveOCIClientConfig() error = %v, want invalid endpoint failure", err)
+	}
+}
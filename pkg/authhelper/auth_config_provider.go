@@ -17,6 +17,7 @@ import (
 
 	configpkg "github.com/oracle/oci-service-operator/pkg/config"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 )
 
 type authProviderFactory func(configpkg.UserAuthConfig) (common.ConfigurationProvider, error)
@@ -323,7 +324,7 @@ func (configProvider *AuthConfigProvider) authValidate(ctx context.Context, prov
 	request := identity.ListAvailabilityDomainsRequest{
 		CompartmentId: &tenancy,
 	}
-	identClient, err := identity.NewIdentityClientWithConfigurationProvider(provider)
+	identClient, err := ociclient.New(provider, identity.NewIdentityClientWithConfigurationProvider)
 	if err != nil {
 		configProvider.Log.ErrorLog(err, "unable to validate and instantiate using the auth provider.")
 		return false
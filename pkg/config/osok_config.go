@@ -30,3 +30,10 @@ func (o osokConfig) UseInstancePrincipals() bool {
 func (o osokConfig) VaultDetails() string {
 	return o.vaultDetails
 }
+
+// NewOsokConfig returns an OsokConfig that carries only auth settings. It is
+// used to build providers for OCIIdentity resources, which do not configure
+// the operator-wide vault details.
+func NewOsokConfig(auth UserAuthConfig) OsokConfig {
+	return osokConfig{auth: auth}
+}
@@ -0,0 +1,65 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DeletionPolicyAnnotation selects what happens to the OCI resource when the
+// owning custom resource is deleted. It is read by BaseReconciler for every
+// kind, so generated and handwritten service managers do not need to opt in.
+const DeletionPolicyAnnotation = "oci.oracle.com/deletion-policy"
+
+// DeletionPolicy is the value of DeletionPolicyAnnotation. It only governs the
+// OCI resource; Kubernetes secrets written by the operator for the resource are
+// removed under every policy.
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete deletes the OCI resource before the finalizer is removed.
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+	// DeletionPolicyOrphan removes the finalizer and leaves the OCI resource untouched.
+	DeletionPolicyOrphan DeletionPolicy = "Orphan"
+	// DeletionPolicyRetain is accepted as an alias of Orphan for users familiar
+	// with PersistentVolume reclaim policies.
+	DeletionPolicyRetain DeletionPolicy = "Retain"
+)
+
+const (
+	deleteEventReasonOrphaned      = "DeleteOrphaned"
+	deleteEventReasonInvalidPolicy = "InvalidDeletionPolicy"
+)
+
+// ResolveDeletionPolicy returns the deletion policy requested on obj. A missing
+// or blank annotation resolves to DeletionPolicyDelete. Unknown values are
+// rejected so that a typo never falls back to destroying the OCI resource.
+func ResolveDeletionPolicy(obj client.Object) (DeletionPolicy, error) {
+	if obj == nil {
+		return DeletionPolicyDelete, nil
+	}
+
+	raw, ok := obj.GetAnnotations()[DeletionPolicyAnnotation]
+	if !ok || strings.TrimSpace(raw) == "" {
+		return DeletionPolicyDelete, nil
+	}
+
+	for _, policy := range []DeletionPolicy{DeletionPolicyDelete, DeletionPolicyOrphan, DeletionPolicyRetain} {
+		if strings.EqualFold(strings.TrimSpace(raw), string(policy)) {
+			return policy, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported %s annotation value %q: must be one of %q, %q, or %q",
+		DeletionPolicyAnnotation, raw, DeletionPolicyDelete, DeletionPolicyOrphan, DeletionPolicyRetain)
+}
+
+// SkipsOCIDelete reports whether the policy leaves the OCI resource in place.
+func (p DeletionPolicy) SkipsOCIDelete() bool {
+	return p == DeletionPolicyOrphan || p == DeletionPolicyRetain
+}
@@ -0,0 +1,207 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	shared "github.com/oracle/oci-service-operator/pkg/shared"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func TestResolveDeletionPolicy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        DeletionPolicy
+		wantErr     bool
+	}{
+		{name: "missing annotation defaults to delete", want: DeletionPolicyDelete},
+		{name: "blank annotation defaults to delete", annotations: map[string]string{DeletionPolicyAnnotation: " "}, want: DeletionPolicyDelete},
+		{name: "delete", annotations: map[string]string{DeletionPolicyAnnotation: "Delete"}, want: DeletionPolicyDelete},
+		{name: "orphan is case insensitive", annotations: map[string]string{DeletionPolicyAnnotation: "orphan"}, want: DeletionPolicyOrphan},
+		{name: "retain", annotations: map[string]string{DeletionPolicyAnnotation: "Retain"}, want: DeletionPolicyRetain},
+		{name: "unknown value is rejected", annotations: map[string]string{DeletionPolicyAnnotation: "Keep"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			obj := testConfigMap("policy")
+			obj.Annotations = tt.annotations
+			got, err := ResolveDeletionPolicy(obj)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ResolveDeletionPolicy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Fatalf("ResolveDeletionPolicy() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReconcileOrphanDeletionPolicySkipsOCIDeleteAndRemovesFinalizer(t *testing.T) {
+	t.Parallel()
+
+	for _, policy := range []DeletionPolicy{DeletionPolicyOrphan, DeletionPolicyRetain} {
+		policy := policy
+		t.Run(string(policy), func(t *testing.T) {
+			t.Parallel()
+
+			serviceManager := &stubServiceManager{
+				deleteBehavior: deleteBehavior{deleted: true},
+				currentStatus:  &shared.OSOKStatus{Ocid: "ocid1.queue.oc1..orphan"},
+			}
+			sink := &collectingLogSink{}
+			reconciler, recorder, kubeClient := newTestReconcilerWithLogger(t, serviceManager,
+				deletingTestConfigMapWithDeletionPolicy("test-delete", string(policy)), sink)
+
+			result, err := reconciler.Reconcile(context.Background(), testRequest(), &corev1.ConfigMap{})
+			if err != nil {
+				t.Fatalf("Reconcile() error = %v, want nil", err)
+			}
+			if result != (ctrl.Result{}) {
+				t.Fatalf("Reconcile() result = %#v, want empty result", result)
+			}
+			if serviceManager.deleteCalls != 0 {
+				t.Fatalf("service manager delete calls = %d, want 0", serviceManager.deleteCalls)
+			}
+
+			stored := kubeClient.StoredConfigMap()
+			if HasFinalizer(stored, OSOKFinalizerName) {
+				t.Fatal("finalizer still present after orphaned delete")
+			}
+
+			events := drainEvents(recorder)
+			assertContainsEvent(t, events, deleteEventReasonOrphaned)
+			assertContainsEvent(t, events, "OCI resource ocid1.queue.oc1..orphan left in place by oci.oracle.com/deletion-policy="+string(policy))
+			assertContainsEvent(t, events, "Removed finalizer")
+			assertAnyMessageContains(t, sink.infos, "Removed the CR and orphaned the OCI resource")
+			assertNoMessageContains(t, sink.infos, "Deletion of the CR successful")
+		})
+	}
+}
+
+func TestReconcileOrphanDeletionPolicyCleansUpOwnedSecrets(t *testing.T) {
+	t.Parallel()
+
+	serviceManager := &orphanCleaningServiceManager{stubServiceManager: &stubServiceManager{}}
+	reconciler, _, kubeClient := newTestReconcilerWithLogger(t, serviceManager,
+		deletingTestConfigMapWithDeletionPolicy("test-delete", string(DeletionPolicyOrphan)), nil)
+
+	if _, err := reconciler.Reconcile(context.Background(), testRequest(), &corev1.ConfigMap{}); err != nil {
+		t.Fatalf("Reconcile() error = %v, want nil", err)
+	}
+	if serviceManager.cleanupCalls != 1 {
+		t.Fatalf("CleanupOrphaned() calls = %d, want 1", serviceManager.cleanupCalls)
+	}
+	if serviceManager.deleteCalls != 0 {
+		t.Fatalf("service manager delete calls = %d, want 0", serviceManager.deleteCalls)
+	}
+	if HasFinalizer(kubeClient.StoredConfigMap(), OSOKFinalizerName) {
+		t.Fatal("finalizer still present after orphaned delete")
+	}
+}
+
+func TestReconcileOrphanDeletionPolicyKeepsFinalizerWhenSecretCleanupFails(t *testing.T) {
+	t.Parallel()
+
+	serviceManager := &orphanCleaningServiceManager{
+		stubServiceManager: &stubServiceManager{},
+		cleanupErr:         errors.New("secret delete conflict"),
+	}
+	reconciler, recorder, kubeClient := newTestReconcilerWithLogger(t, serviceManager,
+		deletingTestConfigMapWithDeletionPolicy("test-delete", string(DeletionPolicyOrphan)), nil)
+
+	result, err := reconciler.Reconcile(context.Background(), testRequest(), &corev1.ConfigMap{})
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v, want nil", err)
+	}
+	if result.RequeueAfter != defaultRequeueTime {
+		t.Fatalf("Reconcile() requeueAfter = %v, want %v", result.RequeueAfter, defaultRequeueTime)
+	}
+	if !HasFinalizer(kubeClient.StoredConfigMap(), OSOKFinalizerName) {
+		t.Fatal("finalizer removed after failed secret cleanup, want retained")
+	}
+
+	events := drainEvents(recorder)
+	assertContainsEvent(t, events, "Failed to clean up secrets for orphaned resource: secret delete conflict")
+	assertNoEventContains(t, events, deleteEventReasonOrphaned)
+}
+
+func TestReconcileExplicitDeleteDeletionPolicyCallsServiceManager(t *testing.T) {
+	t.Parallel()
+
+	serviceManager := &stubServiceManager{deleteBehavior: deleteBehavior{deleted: true}}
+	reconciler, recorder, kubeClient := newTestReconcilerWithLogger(t, serviceManager,
+		deletingTestConfigMapWithDeletionPolicy("test-delete", string(DeletionPolicyDelete)), nil)
+
+	if _, err := reconciler.Reconcile(context.Background(), testRequest(), &corev1.ConfigMap{}); err != nil {
+		t.Fatalf("Reconcile() error = %v, want nil", err)
+	}
+	if serviceManager.deleteCalls != 1 {
+		t.Fatalf("service manager delete calls = %d, want 1", serviceManager.deleteCalls)
+	}
+	if HasFinalizer(kubeClient.StoredConfigMap(), OSOKFinalizerName) {
+		t.Fatal("finalizer still present after confirmed delete")
+	}
+	assertNoEventContains(t, drainEvents(recorder), deleteEventReasonOrphaned)
+}
+
+func TestReconcileInvalidDeletionPolicyKeepsFinalizerAndOCIResource(t *testing.T) {
+	t.Parallel()
+
+	serviceManager := &stubServiceManager{deleteBehavior: deleteBehavior{deleted: true}}
+	reconciler, recorder, kubeClient := newTestReconcilerWithLogger(t, serviceManager,
+		deletingTestConfigMapWithDeletionPolicy("test-delete", "Keep"), nil)
+
+	result, err := reconciler.Reconcile(context.Background(), testRequest(), &corev1.ConfigMap{})
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v, want nil", err)
+	}
+	if result.RequeueAfter != defaultRequeueTime {
+		t.Fatalf("Reconcile() requeueAfter = %v, want %v", result.RequeueAfter, defaultRequeueTime)
+	}
+	if serviceManager.deleteCalls != 0 {
+		t.Fatalf("service manager delete calls = %d, want 0", serviceManager.deleteCalls)
+	}
+	if !HasFinalizer(kubeClient.StoredConfigMap(), OSOKFinalizerName) {
+		t.Fatal("finalizer removed after invalid deletion policy, want retained")
+	}
+
+	events := drainEvents(recorder)
+	assertContainsEvent(t, events, deleteEventReasonInvalidPolicy)
+	assertContainsEvent(t, events, `unsupported oci.oracle.com/deletion-policy annotation value "Keep"`)
+	assertNoEventContains(t, events, "Removed finalizer")
+}
+
+func deletingTestConfigMapWithDeletionPolicy(name string, policy string) *corev1.ConfigMap {
+	configMap := deletingTestConfigMap(name)
+	configMap.Annotations = map[string]string{DeletionPolicyAnnotation: policy}
+	return configMap
+}
+
+type orphanCleaningServiceManager struct {
+	*stubServiceManager
+	cleanupCalls int
+	cleanupErr   error
+}
+
+var _ servicemanager.OSOKOrphanCleaner = (*orphanCleaningServiceManager)(nil)
+
+func (m *orphanCleaningServiceManager) CleanupOrphaned(context.Context, runtime.Object) error {
+	m.cleanupCalls++
+	return m.cleanupErr
+}
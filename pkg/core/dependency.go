@@ -0,0 +1,33 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package core
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	"github.com/oracle/oci-service-operator/pkg/util"
+)
+
+const dependencyNotReadyEventReason = "DependencyNotReady"
+
+// waitForDependency requeues a resource whose spec references another OSOK
+// resource that is not Active yet. Waiting is expected while a stack is
+// applied in one go, so it is reported as a normal event rather than a
+// reconcile failure.
+func (r *BaseReconciler) waitForDependency(ctx context.Context, obj client.Object, response servicemanager.OSOKResponse) (ctrl.Result, error) {
+	message := "Waiting for a referenced resource to become Active"
+	if status, err := r.OSOKServiceManager.GetCrdStatus(obj); err == nil && status != nil && status.Message != "" {
+		message = status.Message
+	}
+	r.Log.InfoLogWithFixedMessage(ctx, message)
+	r.Recorder.Event(obj, v1.EventTypeNormal, dependencyNotReadyEventReason, message)
+	return util.RequeueWithoutError(ctx, response.RequeueDuration, r.Log)
+}
@@ -0,0 +1,90 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package core
+
+import (
+	"context"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	shared "github.com/oracle/oci-service-operator/pkg/shared"
+	"github.com/oracle/oci-service-operator/pkg/util"
+)
+
+const driftEventReason = "DriftDetected"
+
+// DriftPolicy controls periodic drift resync for one kind. A zero
+// ResyncInterval disables resync, which keeps the historical behavior of
+// reconciling Active resources only when their spec changes.
+type DriftPolicy struct {
+	ResyncInterval time.Duration
+	// AutoCorrect updates the OCI resource back to spec when a resync finds
+	// mutable drift. Without it drift is only reported.
+	AutoCorrect bool
+}
+
+// DriftConfig holds the manager-wide drift policy and per-kind overrides.
+// Kinds are keyed by "Kind.group" (for example "SecurityList.core.oracle.com")
+// or by the bare Kind name; the group-qualified key wins when both are set.
+type DriftConfig struct {
+	Default DriftPolicy
+	Kinds   map[string]DriftPolicy
+}
+
+// PolicyFor returns the drift policy that applies to gvk.
+func (c DriftConfig) PolicyFor(gvk schema.GroupVersionKind) DriftPolicy {
+	if policy, ok := c.Kinds[gvk.Kind+"."+gvk.Group]; ok {
+		return policy
+	}
+	if policy, ok := c.Kinds[gvk.Kind]; ok {
+		return policy
+	}
+	return c.Default
+}
+
+func (r *BaseReconciler) driftPolicy(obj client.Object) DriftPolicy {
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	if gvk.Kind == "" && r.Scheme != nil {
+		if resolved, err := apiutil.GVKForObject(obj, r.Scheme); err == nil {
+			gvk = resolved
+		}
+	}
+	return r.Drift.PolicyFor(gvk)
+}
+
+// driftCheckContext marks ctx as a drift resync when obj has already been
+// reconciled at its current generation. Spec edits bump the generation, so
+// they always run as ordinary reconciles and are applied regardless of the
+// auto-correct setting.
+func (r *BaseReconciler) driftCheckContext(ctx context.Context, obj client.Object, policy DriftPolicy) context.Context {
+	if policy.ResyncInterval <= 0 {
+		return ctx
+	}
+	synced, ok := r.syncedGenerations.Load(obj.GetUID())
+	if !ok || synced.(int64) != obj.GetGeneration() {
+		return ctx
+	}
+	return servicemanager.WithDriftCheck(ctx, policy.AutoCorrect)
+}
+
+func (r *BaseReconciler) recordDrift(obj client.Object, policy DriftPolicy) {
+	if policy.AutoCorrect {
+		r.Recorder.Event(obj, v1.EventTypeWarning, driftEventReason,
+			r.messageWithAsyncBreadcrumb(obj, "OCI resource drifted from spec and was updated back to spec"))
+		return
+	}
+
+	message := "OCI resource drifted from spec; auto-correct is disabled, edit the spec or the OCI resource to resolve it"
+	if status, err := r.OSOKServiceManager.GetCrdStatus(obj); err == nil && status != nil {
+		*status = util.UpdateOSOKStatusCondition(*status, shared.DriftDetected, v1.ConditionTrue, "", message, r.Log)
+	}
+	r.Recorder.Event(obj, v1.EventTypeWarning, driftEventReason, message)
+}
@@ -0,0 +1,126 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	shared "github.com/oracle/oci-service-operator/pkg/shared"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func TestDriftConfigPolicyFor(t *testing.T) {
+	t.Parallel()
+
+	config := DriftConfig{
+		Default: DriftPolicy{ResyncInterval: time.Hour},
+		Kinds: map[string]DriftPolicy{
+			"SecurityList":                 {ResyncInterval: 10 * time.Minute},
+			"SecurityList.core.oracle.com": {ResyncInterval: 5 * time.Minute, AutoCorrect: true},
+			"Queue":                        {},
+		},
+	}
+
+	tests := []struct {
+		name string
+		gvk  schema.GroupVersionKind
+		want DriftPolicy
+	}{
+		{name: "group-qualified key wins", gvk: schema.GroupVersionKind{Group: "core.oracle.com", Kind: "SecurityList"}, want: DriftPolicy{ResyncInterval: 5 * time.Minute, AutoCorrect: true}},
+		{name: "bare kind key", gvk: schema.GroupVersionKind{Group: "other.oracle.com", Kind: "SecurityList"}, want: DriftPolicy{ResyncInterval: 10 * time.Minute}},
+		{name: "kind override can disable resync", gvk: schema.GroupVersionKind{Group: "queue.oracle.com", Kind: "Queue"}, want: DriftPolicy{}},
+		{name: "default", gvk: schema.GroupVersionKind{Group: "core.oracle.com", Kind: "Vcn"}, want: DriftPolicy{ResyncInterval: time.Hour}},
+	}
+	for _, tt := range tests {
+		if got := config.PolicyFor(tt.gvk); got != tt.want {
+			t.Errorf("%s: PolicyFor() = %#v, want %#v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestReconcileRequeuesAtDriftResyncInterval(t *testing.T) {
+	t.Parallel()
+
+	serviceManager := &driftCheckingServiceManager{}
+	reconciler, _, _ := newTestReconcilerWithLogger(t, serviceManager, testConfigMap("test-delete"), nil)
+	reconciler.Drift = DriftConfig{Kinds: map[string]DriftPolicy{"ConfigMap": {ResyncInterval: 15 * time.Minute}}}
+
+	result, err := reconciler.Reconcile(context.Background(), testRequest(), &corev1.ConfigMap{})
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v, want nil", err)
+	}
+	if result.RequeueAfter != 15*time.Minute {
+		t.Fatalf("Reconcile() requeueAfter = %v, want the drift resync interval", result.RequeueAfter)
+	}
+	if len(serviceManager.resyncs) != 1 || serviceManager.resyncs[0] {
+		t.Fatalf("drift checks = %v, want the first reconcile to run as an ordinary reconcile", serviceManager.resyncs)
+	}
+}
+
+func TestReconcileResyncAtSameGenerationReportsDrift(t *testing.T) {
+	t.Parallel()
+
+	serviceManager := &driftCheckingServiceManager{driftDetected: true}
+	reconciler, recorder, _ := newTestReconcilerWithLogger(t, serviceManager, testConfigMap("test-delete"), nil)
+	reconciler.Drift = DriftConfig{Default: DriftPolicy{ResyncInterval: time.Minute}}
+
+	for i := 0; i < 2; i++ {
+		if _, err := reconciler.Reconcile(context.Background(), testRequest(), &corev1.ConfigMap{}); err != nil {
+			t.Fatalf("Reconcile() #%d error = %v, want nil", i+1, err)
+		}
+	}
+	if len(serviceManager.resyncs) != 2 || serviceManager.resyncs[0] || !serviceManager.resyncs[1] {
+		t.Fatalf("drift checks = %v, want only the second reconcile to run as a resync", serviceManager.resyncs)
+	}
+	if serviceManager.autoCorrect {
+		t.Fatal("drift check requested auto-correct, want report only")
+	}
+	assertTrailingCondition(t, &serviceManager.status, shared.DriftDetected)
+	assertContainsEvent(t, drainEvents(recorder), driftEventReason)
+}
+
+func TestReconcileWithoutDriftConfigDoesNotRequeue(t *testing.T) {
+	t.Parallel()
+
+	serviceManager := &driftCheckingServiceManager{}
+	reconciler, _, _ := newTestReconcilerWithLogger(t, serviceManager, testConfigMap("test-delete"), nil)
+
+	result, err := reconciler.Reconcile(context.Background(), testRequest(), &corev1.ConfigMap{})
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v, want nil", err)
+	}
+	if result != (ctrl.Result{}) {
+		t.Fatalf("Reconcile() result = %#v, want empty result", result)
+	}
+}
+
+type driftCheckingServiceManager struct {
+	driftDetected bool
+	status        shared.OSOKStatus
+	resyncs       []bool
+	autoCorrect   bool
+}
+
+func (m *driftCheckingServiceManager) CreateOrUpdate(ctx context.Context, _ runtime.Object, _ ctrl.Request) (servicemanager.OSOKResponse, error) {
+	resync, autoCorrect := servicemanager.DriftCheck(ctx)
+	m.resyncs = append(m.resyncs, resync)
+	m.autoCorrect = m.autoCorrect || autoCorrect
+	return servicemanager.OSOKResponse{IsSuccessful: true, DriftDetected: resync && m.driftDetected}, nil
+}
+
+func (m *driftCheckingServiceManager) Delete(context.Context, runtime.Object) (bool, error) {
+	return true, nil
+}
+
+func (m *driftCheckingServiceManager) GetCrdStatus(runtime.Object) (*shared.OSOKStatus, error) {
+	return &m.status, nil
+}
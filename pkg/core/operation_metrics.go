@@ -0,0 +1,92 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package core
+
+import (
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/oracle/oci-service-operator/pkg/metrics"
+	shared "github.com/oracle/oci-service-operator/pkg/shared"
+)
+
+const unknownConditionLabel = "Unknown"
+
+// asyncStart is when BaseReconciler first saw an object's async operation
+// pending.
+type asyncStart struct {
+	phase shared.OSOKAsyncPhase
+	at    time.Time
+}
+
+// kindOf returns obj's kind, resolving it through the scheme for typed
+// objects read without TypeMeta.
+func (r *BaseReconciler) kindOf(obj client.Object) string {
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	if gvk.Kind == "" && r.Scheme != nil {
+		if resolved, err := apiutil.GVKForObject(obj, r.Scheme); err == nil {
+			gvk = resolved
+		}
+	}
+	return gvk.Kind
+}
+
+// recordOperationMetrics updates the managed CR gauge and the async
+// operation histogram from obj's status after a reconcile. The histogram
+// measures from the first reconcile that saw status.async.current pending
+// until the operation left it, so it does not survive operator restarts.
+func (r *BaseReconciler) recordOperationMetrics(obj client.Object) {
+	status, err := r.OSOKServiceManager.GetCrdStatus(obj)
+	if err != nil || status == nil {
+		return
+	}
+	kind := r.kindOf(obj)
+
+	condition := string(lifecycleCondition(*status))
+	if condition == "" {
+		condition = unknownConditionLabel
+	}
+	metrics.SetCRCondition(kind, client.ObjectKeyFromObject(obj).String(), condition)
+
+	current := status.Async.Current
+	pending := current != nil && current.NormalizedClass == shared.OSOKAsyncClassPending
+	if tracked, ok := r.asyncStarts.Load(obj.GetUID()); ok {
+		start := tracked.(asyncStart)
+		if pending && current.Phase == start.phase {
+			return
+		}
+		metrics.ObserveAsyncOperation(kind, string(start.phase), asyncOutcome(*status, start.phase), time.Since(start.at))
+		r.asyncStarts.Delete(obj.GetUID())
+	}
+	if pending {
+		r.asyncStarts.Store(obj.GetUID(), asyncStart{phase: current.Phase, at: time.Now()})
+	}
+}
+
+// asyncOutcome names how the async operation in phase ended.
+func asyncOutcome(status shared.OSOKStatus, phase shared.OSOKAsyncPhase) string {
+	if current := status.Async.Current; current != nil && current.Phase == phase &&
+		current.NormalizedClass != shared.OSOKAsyncClassPending {
+		return string(current.NormalizedClass)
+	}
+	if lifecycleCondition(status) == shared.Failed {
+		return string(shared.OSOKAsyncClassFailed)
+	}
+	return string(shared.OSOKAsyncClassSucceeded)
+}
+
+// forgetOperationMetrics stops tracking obj once the CR is gone. A delete
+// that was pending has finished.
+func (r *BaseReconciler) forgetOperationMetrics(obj client.Object) {
+	kind := r.kindOf(obj)
+	if tracked, ok := r.asyncStarts.LoadAndDelete(obj.GetUID()); ok {
+		start := tracked.(asyncStart)
+		metrics.ObserveAsyncOperation(kind, string(start.phase), string(shared.OSOKAsyncClassSucceeded), time.Since(start.at))
+	}
+	metrics.ForgetCR(kind, client.ObjectKeyFromObject(obj).String())
+}
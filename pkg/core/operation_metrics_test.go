@@ -0,0 +1,67 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	shared "github.com/oracle/oci-service-operator/pkg/shared"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestReconcileTracksPendingAsyncOperationUntilItFinishes(t *testing.T) {
+	t.Parallel()
+
+	pending := statusWithAsyncCurrent(shared.OSOKAsyncPhaseCreate, "ocid1.workrequest.oc1..create")
+	pending.Async.Current.NormalizedClass = shared.OSOKAsyncClassPending
+	serviceManager := &stubServiceManager{createOrUpdateBehavior: createOrUpdateBehavior{
+		response: servicemanager.OSOKResponse{IsSuccessful: true},
+		status:   pending,
+	}}
+	configMap := testConfigMap("test-delete")
+	configMap.UID = "uid-async"
+	reconciler, _, _ := newTestReconcilerWithLogger(t, serviceManager, configMap, nil)
+
+	if _, err := reconciler.Reconcile(context.Background(), testRequest(), &corev1.ConfigMap{}); err != nil {
+		t.Fatalf("Reconcile() error = %v, want nil", err)
+	}
+	tracked, ok := reconciler.asyncStarts.Load(configMap.UID)
+	if !ok || tracked.(asyncStart).phase != shared.OSOKAsyncPhaseCreate {
+		t.Fatalf("tracked async start = %#v, want the pending create", tracked)
+	}
+
+	serviceManager.createOrUpdateBehavior.status = &shared.OSOKStatus{Reason: string(shared.Active)}
+	if _, err := reconciler.Reconcile(context.Background(), testRequest(), &corev1.ConfigMap{}); err != nil {
+		t.Fatalf("Reconcile() error = %v, want nil", err)
+	}
+	if _, ok := reconciler.asyncStarts.Load(configMap.UID); ok {
+		t.Fatal("async start still tracked after the create finished")
+	}
+}
+
+func TestAsyncOutcome(t *testing.T) {
+	t.Parallel()
+
+	failedWorkRequest := statusWithAsyncCurrent(shared.OSOKAsyncPhaseUpdate, "ocid1.workrequest.oc1..update")
+	failedWorkRequest.Async.Current.NormalizedClass = shared.OSOKAsyncClassFailed
+
+	tests := []struct {
+		name   string
+		status shared.OSOKStatus
+		want   string
+	}{
+		{name: "terminal work request class", status: *failedWorkRequest, want: "failed"},
+		{name: "cleared and active", status: shared.OSOKStatus{Reason: string(shared.Active)}, want: "succeeded"},
+		{name: "cleared and failed", status: shared.OSOKStatus{Reason: string(shared.Failed)}, want: "failed"},
+	}
+	for _, tt := range tests {
+		if got := asyncOutcome(tt.status, shared.OSOKAsyncPhaseUpdate); got != tt.want {
+			t.Errorf("%s: asyncOutcome() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
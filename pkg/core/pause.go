@@ -0,0 +1,118 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	shared "github.com/oracle/oci-service-operator/pkg/shared"
+	"github.com/oracle/oci-service-operator/pkg/util"
+)
+
+// PauseAnnotation freezes reconciliation of a single resource. While it is set
+// to "true" BaseReconciler never calls CreateOrUpdate or Delete for mutation;
+// it only observes an in-flight status.async.current work request read-only.
+const PauseAnnotation = "oci.oracle.com/paused"
+
+const pausedEventReason = "Paused"
+
+// ResolvePaused reports whether obj is paused. A missing or blank annotation
+// means not paused. Values that do not parse as a boolean keep the resource
+// paused and are returned as an error, so a typo never resumes OCI mutations.
+func ResolvePaused(obj client.Object) (bool, error) {
+	if obj == nil {
+		return false, nil
+	}
+
+	raw, ok := obj.GetAnnotations()[PauseAnnotation]
+	if !ok || strings.TrimSpace(raw) == "" {
+		return false, nil
+	}
+
+	paused, err := strconv.ParseBool(strings.TrimSpace(raw))
+	if err != nil {
+		return true, fmt.Errorf("unsupported %s annotation value %q: must be \"true\" or \"false\"", PauseAnnotation, raw)
+	}
+	return paused, nil
+}
+
+func (r *BaseReconciler) reconcilePaused(ctx context.Context, obj client.Object, req ctrl.Request, resolveErr error) (ctrl.Result, error) {
+	oldObj := obj.DeepCopyObject().(client.Object)
+	if resolveErr != nil {
+		r.Log.ErrorLogWithFixedMessage(ctx, resolveErr, "Treating the resource as paused because the pause annotation is invalid")
+		r.Recorder.Event(obj, v1.EventTypeWarning, pausedEventReason, resolveErr.Error())
+	}
+
+	inFlight := r.observePausedWorkRequest(ctx, obj, req)
+
+	message := fmt.Sprintf("Reconciliation paused by %s; OCI mutations are suspended", PauseAnnotation)
+	if inFlight {
+		message = r.messageWithAsyncBreadcrumb(obj, message+" while the in-flight work request is observed")
+	}
+	if status, err := r.OSOKServiceManager.GetCrdStatus(obj); err == nil && status != nil {
+		*status = util.UpdateOSOKStatusCondition(*status, shared.Paused, v1.ConditionTrue, "", message, r.Log)
+	}
+	r.projectStandardStatus(oldObj, obj, false)
+	if err := r.Status().Patch(ctx, obj, client.MergeFrom(oldObj)); err != nil {
+		r.Log.ErrorLogWithFixedMessage(ctx, err, "Error updating the status of the paused Object")
+		r.Recorder.Event(obj, v1.EventTypeWarning, "Failed",
+			fmt.Sprintf("Failed to persist paused status: %s", err.Error()))
+		return util.RequeueWithError(ctx, err, defaultRequeueTime, r.Log)
+	}
+	r.recordOperationMetrics(obj)
+	r.recordAsyncTrace(ctx, obj)
+
+	r.Log.InfoLogWithFixedMessage(ctx, message)
+	r.Recorder.Event(obj, v1.EventTypeNormal, pausedEventReason, message)
+	if inFlight {
+		return util.RequeueWithoutError(ctx, defaultRequeueTime, r.Log)
+	}
+	return util.DoNotRequeue()
+}
+
+// observePausedWorkRequest polls the tracked work request, if any, through an
+// observe-only context and reports whether one is still in flight afterwards.
+func (r *BaseReconciler) observePausedWorkRequest(ctx context.Context, obj client.Object, req ctrl.Request) bool {
+	current := r.currentWorkRequest(obj)
+	if current == nil {
+		return false
+	}
+
+	observeCtx := servicemanager.WithObserveOnly(ctx)
+	var err error
+	if current.Phase == shared.OSOKAsyncPhaseDelete {
+		_, err = r.OSOKServiceManager.Delete(observeCtx, obj)
+	} else {
+		_, err = r.OSOKServiceManager.CreateOrUpdate(observeCtx, obj, req)
+	}
+	if err != nil {
+		r.Log.ErrorLogWithFixedMessage(ctx, err, r.messageWithAsyncBreadcrumb(obj, "Observing the in-flight work request of a paused resource failed"))
+	}
+	return r.currentWorkRequest(obj) != nil
+}
+
+func (r *BaseReconciler) currentWorkRequest(obj client.Object) *shared.OSOKAsyncOperation {
+	status, err := r.OSOKServiceManager.GetCrdStatus(obj)
+	if err != nil || status == nil || status.Async.Current == nil {
+		return nil
+	}
+	current := status.Async.Current
+	if current.Source != shared.OSOKAsyncSourceWorkRequest || strings.TrimSpace(current.WorkRequestID) == "" {
+		return nil
+	}
+	if current.NormalizedClass != shared.OSOKAsyncClassPending {
+		return nil
+	}
+	return current
+}
@@ -0,0 +1,182 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	shared "github.com/oracle/oci-service-operator/pkg/shared"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func TestResolvePaused(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        bool
+		wantErr     bool
+	}{
+		{name: "missing annotation is not paused"},
+		{name: "blank annotation is not paused", annotations: map[string]string{PauseAnnotation: " "}},
+		{name: "true", annotations: map[string]string{PauseAnnotation: "true"}, want: true},
+		{name: "case insensitive", annotations: map[string]string{PauseAnnotation: "TRUE"}, want: true},
+		{name: "false", annotations: map[string]string{PauseAnnotation: "false"}},
+		{name: "unknown value stays paused", annotations: map[string]string{PauseAnnotation: "yes please"}, want: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			obj := testConfigMap("paused")
+			obj.Annotations = tt.annotations
+			got, err := ResolvePaused(obj)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ResolvePaused() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Fatalf("ResolvePaused() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReconcilePausedSkipsCreateOrUpdateAndSetsPausedCondition(t *testing.T) {
+	t.Parallel()
+
+	serviceManager := &pauseObservingServiceManager{status: &shared.OSOKStatus{}}
+	configMap := testConfigMap("test-delete")
+	configMap.Annotations = map[string]string{PauseAnnotation: "true"}
+	reconciler, recorder, kubeClient := newTestReconcilerWithLogger(t, serviceManager, configMap, nil)
+
+	result, err := reconciler.Reconcile(context.Background(), testRequest(), &corev1.ConfigMap{})
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v, want nil", err)
+	}
+	if result != (ctrl.Result{}) {
+		t.Fatalf("Reconcile() result = %#v, want empty result", result)
+	}
+	if serviceManager.createOrUpdateCalls != 0 || serviceManager.deleteCalls != 0 {
+		t.Fatalf("service manager calls = %d create/update, %d delete, want none", serviceManager.createOrUpdateCalls, serviceManager.deleteCalls)
+	}
+	if HasFinalizer(kubeClient.StoredConfigMap(), OSOKFinalizerName) {
+		t.Fatal("finalizer added while paused, want the object left untouched")
+	}
+	assertTrailingCondition(t, serviceManager.status, shared.Paused)
+	assertContainsEvent(t, drainEvents(recorder), pausedEventReason)
+}
+
+func TestReconcilePausedDeleteKeepsFinalizerWithoutDelete(t *testing.T) {
+	t.Parallel()
+
+	serviceManager := &pauseObservingServiceManager{status: &shared.OSOKStatus{Ocid: "ocid1.queue.oc1..paused"}}
+	configMap := deletingTestConfigMap("test-delete")
+	configMap.Annotations = map[string]string{PauseAnnotation: "true"}
+	reconciler, _, kubeClient := newTestReconcilerWithLogger(t, serviceManager, configMap, nil)
+
+	if _, err := reconciler.Reconcile(context.Background(), testRequest(), &corev1.ConfigMap{}); err != nil {
+		t.Fatalf("Reconcile() error = %v, want nil", err)
+	}
+	if serviceManager.deleteCalls != 0 {
+		t.Fatalf("service manager delete calls = %d, want 0", serviceManager.deleteCalls)
+	}
+	if !HasFinalizer(kubeClient.StoredConfigMap(), OSOKFinalizerName) {
+		t.Fatal("finalizer removed while paused, want retained")
+	}
+	assertTrailingCondition(t, serviceManager.status, shared.Paused)
+}
+
+func TestReconcilePausedObservesInFlightWorkRequestReadOnly(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name     string
+		phase    shared.OSOKAsyncPhase
+		deleting bool
+	}{
+		{name: "create", phase: shared.OSOKAsyncPhaseCreate},
+		{name: "delete", phase: shared.OSOKAsyncPhaseDelete, deleting: true},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			status := statusWithAsyncCurrent(tt.phase, "wr-paused")
+			status.Async.Current.Source = shared.OSOKAsyncSourceWorkRequest
+			status.Async.Current.NormalizedClass = shared.OSOKAsyncClassPending
+			serviceManager := &pauseObservingServiceManager{status: status}
+			configMap := testConfigMap("test-delete")
+			if tt.deleting {
+				configMap = deletingTestConfigMap("test-delete")
+			}
+			configMap.Annotations = map[string]string{PauseAnnotation: "true"}
+			reconciler, recorder, _ := newTestReconcilerWithLogger(t, serviceManager, configMap, nil)
+
+			result, err := reconciler.Reconcile(context.Background(), testRequest(), &corev1.ConfigMap{})
+			if err != nil {
+				t.Fatalf("Reconcile() error = %v, want nil", err)
+			}
+			if result.RequeueAfter != defaultRequeueTime {
+				t.Fatalf("Reconcile() requeueAfter = %v, want %v while the work request is pending", result.RequeueAfter, defaultRequeueTime)
+			}
+			if serviceManager.createOrUpdateCalls+serviceManager.deleteCalls != 1 {
+				t.Fatalf("service manager calls = %d create/update, %d delete, want exactly one observation", serviceManager.createOrUpdateCalls, serviceManager.deleteCalls)
+			}
+			if serviceManager.mutatingCalls != 0 {
+				t.Fatalf("service manager calls without observe-only context = %d, want 0", serviceManager.mutatingCalls)
+			}
+			if tt.deleting && serviceManager.deleteCalls != 1 {
+				t.Fatalf("delete-phase observation used CreateOrUpdate, want Delete")
+			}
+			assertTrailingCondition(t, serviceManager.status, shared.Paused)
+			assertContainsEvent(t, drainEvents(recorder), "workRequestId=wr-paused")
+		})
+	}
+}
+
+func assertTrailingCondition(t *testing.T, status *shared.OSOKStatus, want shared.OSOKConditionType) {
+	t.Helper()
+	if len(status.Conditions) == 0 {
+		t.Fatalf("status.conditions is empty, want trailing %s", want)
+	}
+	if got := status.Conditions[len(status.Conditions)-1].Type; got != want {
+		t.Fatalf("trailing condition = %s, want %s", got, want)
+	}
+}
+
+type pauseObservingServiceManager struct {
+	status              *shared.OSOKStatus
+	createOrUpdateCalls int
+	deleteCalls         int
+	mutatingCalls       int
+}
+
+func (m *pauseObservingServiceManager) CreateOrUpdate(ctx context.Context, _ runtime.Object, _ ctrl.Request) (servicemanager.OSOKResponse, error) {
+	m.createOrUpdateCalls++
+	if !servicemanager.IsObserveOnly(ctx) {
+		m.mutatingCalls++
+	}
+	return servicemanager.OSOKResponse{IsSuccessful: true, ShouldRequeue: true}, nil
+}
+
+func (m *pauseObservingServiceManager) Delete(ctx context.Context, _ runtime.Object) (bool, error) {
+	m.deleteCalls++
+	if !servicemanager.IsObserveOnly(ctx) {
+		m.mutatingCalls++
+	}
+	return false, nil
+}
+
+func (m *pauseObservingServiceManager) GetCrdStatus(runtime.Object) (*shared.OSOKStatus, error) {
+	return m.status, nil
+}
@@ -0,0 +1,138 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	shared "github.com/oracle/oci-service-operator/pkg/shared"
+	"github.com/oracle/oci-service-operator/pkg/util"
+)
+
+const plannedEventReason = "Planned"
+
+// PlanConfig turns on plan mode. In plan mode BaseReconciler still reads the
+// OCI resource and builds every request, but records the creates, updates,
+// and deletes it would send in a Planned condition and event instead of
+// sending them. Finalizers are neither added nor removed.
+type PlanConfig struct {
+	Enabled bool
+	// Namespaces limits plan mode to these namespaces. Empty means every
+	// namespace.
+	Namespaces []string
+}
+
+// Applies reports whether resources in namespace are reconciled in plan mode.
+func (c PlanConfig) Applies(namespace string) bool {
+	if !c.Enabled {
+		return false
+	}
+	if len(c.Namespaces) == 0 {
+		return true
+	}
+	for _, planned := range c.Namespaces {
+		if planned == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// reconcilePlan runs one reconcile of obj in plan mode. A delete is planned
+// only when OSOK owns the OCI resource, which is when the finalizer was added
+// before plan mode was turned on.
+func (r *BaseReconciler) reconcilePlan(ctx context.Context, obj client.Object, req ctrl.Request) (ctrl.Result, error) {
+	deleting := obj.GetDeletionTimestamp() != nil
+	if deleting && !controllerutil.ContainsFinalizer(obj, OSOKFinalizerName) {
+		return util.DoNotRequeue()
+	}
+
+	oldObj := obj.DeepCopyObject().(client.Object)
+	plan := &servicemanager.Plan{}
+	driftPolicy := r.driftPolicy(obj)
+	var err error
+	switch {
+	case r.PlanUnsupported:
+		r.Log.InfoLogWithFixedMessage(ctx, "Plan mode is not supported for this kind; no OCI calls were made")
+	case deleting:
+		err = r.planDelete(servicemanager.WithPlan(ctx, plan), obj)
+	default:
+		serviceCtx := servicemanager.WithReferenceReader(servicemanager.WithPlan(ctx, plan), r.Client)
+		serviceCtx = r.replacePolicyContext(serviceCtx, obj)
+		_, err = r.OSOKServiceManager.CreateOrUpdate(serviceCtx, obj, req)
+	}
+
+	r.recordPlan(ctx, obj, plan, err)
+	r.projectStandardStatus(oldObj, obj, false)
+	if patchErr := r.Status().Patch(ctx, obj, client.MergeFrom(oldObj)); patchErr != nil {
+		r.Log.ErrorLogWithFixedMessage(ctx, patchErr, "Error updating the status of the planned Object")
+		r.Recorder.Event(obj, v1.EventTypeWarning, "Failed",
+			fmt.Sprintf("Failed to persist planned status: %s", patchErr.Error()))
+		return util.RequeueWithError(ctx, patchErr, defaultRequeueTime, r.Log)
+	}
+	if err != nil {
+		return util.RequeueWithError(ctx, err, defaultRequeueTime, r.Log)
+	}
+	if driftPolicy.ResyncInterval > 0 {
+		return util.RequeueWithoutError(ctx, driftPolicy.ResyncInterval, r.Log)
+	}
+	return util.DoNotRequeue()
+}
+
+// planDelete plans the OCI delete for obj. An orphaning deletion policy
+// plans nothing, since OSOK would not call OCI.
+func (r *BaseReconciler) planDelete(ctx context.Context, obj client.Object) error {
+	policy, err := ResolveDeletionPolicy(obj)
+	if err != nil {
+		return err
+	}
+	if policy.SkipsOCIDelete() {
+		return nil
+	}
+	if manager, ok := r.OSOKServiceManager.(servicemanager.OSOKDeleteResultProvider); ok {
+		_, err = manager.DeleteWithResult(ctx, obj)
+		return err
+	}
+	_, err = r.OSOKServiceManager.Delete(ctx, obj)
+	return err
+}
+
+func (r *BaseReconciler) recordPlan(ctx context.Context, obj client.Object, plan *servicemanager.Plan, planErr error) {
+	status := v1.ConditionTrue
+	message := plan.Summary()
+	switch {
+	case planErr != nil:
+		status = v1.ConditionFalse
+		message = fmt.Sprintf("Plan mode: planning failed: %s", planErr.Error())
+	case r.PlanUnsupported:
+		message = "Plan mode: this kind does not support plan mode; no OCI calls were made"
+	}
+	if crdStatus, err := r.OSOKServiceManager.GetCrdStatus(obj); err == nil && crdStatus != nil {
+		*crdStatus = util.UpdateOSOKStatusCondition(*crdStatus, shared.Planned, status, "", message, r.Log)
+	}
+
+	if planErr != nil {
+		r.Log.ErrorLogWithFixedMessage(ctx, planErr, "Planning the reconcile failed")
+		r.Recorder.Event(obj, v1.EventTypeWarning, plannedEventReason, message)
+		return
+	}
+	payload, err := json.Marshal(plan)
+	if err != nil {
+		r.Log.ErrorLogWithFixedMessage(ctx, err, "Failed to render the plan")
+		r.Recorder.Event(obj, v1.EventTypeNormal, plannedEventReason, message)
+		return
+	}
+	r.Log.InfoLogWithFixedMessage(ctx, message, "plan", string(payload))
+	r.Recorder.Event(obj, v1.EventTypeNormal, plannedEventReason, string(payload))
+}
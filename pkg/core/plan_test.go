@@ -0,0 +1,167 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package core
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	shared "github.com/oracle/oci-service-operator/pkg/shared"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func TestPlanConfigApplies(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		config    PlanConfig
+		namespace string
+		want      bool
+	}{
+		{name: "disabled", namespace: "default"},
+		{name: "every namespace", config: PlanConfig{Enabled: true}, namespace: "default", want: true},
+		{name: "listed namespace", config: PlanConfig{Enabled: true, Namespaces: []string{"staging", "default"}}, namespace: "default", want: true},
+		{name: "unlisted namespace", config: PlanConfig{Enabled: true, Namespaces: []string{"staging"}}, namespace: "default"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.config.Applies(tt.namespace); got != tt.want {
+				t.Fatalf("Applies(%q) = %t, want %t", tt.namespace, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReconcilePlanRecordsPlannedConditionWithoutFinalizer(t *testing.T) {
+	t.Parallel()
+
+	serviceManager := &planningServiceManager{
+		status:    &shared.OSOKStatus{},
+		operation: servicemanager.PlannedOperation{Operation: shared.OSOKAsyncPhaseCreate},
+	}
+	reconciler, recorder, kubeClient := newTestReconcilerWithLogger(t, serviceManager, testConfigMap("test-delete"), nil)
+	reconciler.Plan = PlanConfig{Enabled: true}
+
+	result, err := reconciler.Reconcile(context.Background(), testRequest(), &corev1.ConfigMap{})
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v, want nil", err)
+	}
+	if result != (ctrl.Result{}) {
+		t.Fatalf("Reconcile() result = %#v, want empty result", result)
+	}
+	if serviceManager.plannedCalls != 1 {
+		t.Fatalf("CreateOrUpdate calls in plan mode = %d, want 1", serviceManager.plannedCalls)
+	}
+	if HasFinalizer(kubeClient.StoredConfigMap(), OSOKFinalizerName) {
+		t.Fatal("finalizer added in plan mode, want the object left untouched")
+	}
+	assertTrailingCondition(t, serviceManager.status, shared.Planned)
+	if got := serviceManager.status.Conditions[len(serviceManager.status.Conditions)-1].Message; got != "Plan mode: would create" {
+		t.Fatalf("Planned condition message = %q", got)
+	}
+	assertContainsEvent(t, drainEvents(recorder), `{"operations":[{"operation":"create"}]}`)
+}
+
+func TestReconcilePlanDeleteKeepsFinalizer(t *testing.T) {
+	t.Parallel()
+
+	serviceManager := &planningServiceManager{
+		status:    &shared.OSOKStatus{Ocid: "ocid1.queue.oc1..planned"},
+		operation: servicemanager.PlannedOperation{Operation: shared.OSOKAsyncPhaseDelete, OCID: "ocid1.queue.oc1..planned"},
+	}
+	reconciler, _, kubeClient := newTestReconcilerWithLogger(t, serviceManager, deletingTestConfigMap("test-delete"), nil)
+	reconciler.Plan = PlanConfig{Enabled: true}
+
+	if _, err := reconciler.Reconcile(context.Background(), testRequest(), &corev1.ConfigMap{}); err != nil {
+		t.Fatalf("Reconcile() error = %v, want nil", err)
+	}
+	if serviceManager.plannedCalls != 1 {
+		t.Fatalf("Delete calls in plan mode = %d, want 1", serviceManager.plannedCalls)
+	}
+	if !HasFinalizer(kubeClient.StoredConfigMap(), OSOKFinalizerName) {
+		t.Fatal("finalizer removed in plan mode, want retained")
+	}
+	assertTrailingCondition(t, serviceManager.status, shared.Planned)
+}
+
+func TestReconcilePlanSkipsUnsupportedServiceManager(t *testing.T) {
+	t.Parallel()
+
+	serviceManager := &planningServiceManager{status: &shared.OSOKStatus{}}
+	reconciler, _, _ := newTestReconcilerWithLogger(t, serviceManager, testConfigMap("test-delete"), nil)
+	reconciler.Plan = PlanConfig{Enabled: true}
+	reconciler.PlanUnsupported = true
+
+	if _, err := reconciler.Reconcile(context.Background(), testRequest(), &corev1.ConfigMap{}); err != nil {
+		t.Fatalf("Reconcile() error = %v, want nil", err)
+	}
+	if serviceManager.plannedCalls+serviceManager.unplannedCalls != 0 {
+		t.Fatalf("service manager calls = %d, want none for an unsupported kind", serviceManager.plannedCalls+serviceManager.unplannedCalls)
+	}
+	assertTrailingCondition(t, serviceManager.status, shared.Planned)
+	if got := serviceManager.status.Conditions[len(serviceManager.status.Conditions)-1].Message; !strings.Contains(got, "no OCI calls were made") {
+		t.Fatalf("Planned condition message = %q, want it to say no OCI calls were made", got)
+	}
+}
+
+func TestReconcileOutsidePlanNamespaceRunsNormally(t *testing.T) {
+	t.Parallel()
+
+	serviceManager := &planningServiceManager{status: &shared.OSOKStatus{}}
+	reconciler, _, kubeClient := newTestReconcilerWithLogger(t, serviceManager, testConfigMap("test-delete"), nil)
+	reconciler.Plan = PlanConfig{Enabled: true, Namespaces: []string{"staging"}}
+
+	if _, err := reconciler.Reconcile(context.Background(), testRequest(), &corev1.ConfigMap{}); err != nil {
+		t.Fatalf("Reconcile() error = %v, want nil", err)
+	}
+	if serviceManager.unplannedCalls != 1 || serviceManager.plannedCalls != 0 {
+		t.Fatalf("service manager calls = %d planned, %d unplanned, want one unplanned", serviceManager.plannedCalls, serviceManager.unplannedCalls)
+	}
+	if !HasFinalizer(kubeClient.StoredConfigMap(), OSOKFinalizerName) {
+		t.Fatal("finalizer missing outside plan mode")
+	}
+}
+
+type planningServiceManager struct {
+	status         *shared.OSOKStatus
+	operation      servicemanager.PlannedOperation
+	plannedCalls   int
+	unplannedCalls int
+}
+
+func (m *planningServiceManager) record(ctx context.Context) {
+	plan := servicemanager.PlanFrom(ctx)
+	if plan == nil {
+		m.unplannedCalls++
+		return
+	}
+	m.plannedCalls++
+	if m.operation.Operation != "" {
+		plan.Record(m.operation)
+	}
+}
+
+func (m *planningServiceManager) CreateOrUpdate(ctx context.Context, _ runtime.Object, _ ctrl.Request) (servicemanager.OSOKResponse, error) {
+	m.record(ctx)
+	return servicemanager.OSOKResponse{IsSuccessful: true}, nil
+}
+
+func (m *planningServiceManager) Delete(ctx context.Context, _ runtime.Object) (bool, error) {
+	m.record(ctx)
+	return false, nil
+}
+
+func (m *planningServiceManager) GetCrdStatus(runtime.Object) (*shared.OSOKStatus, error) {
+	return m.status, nil
+}
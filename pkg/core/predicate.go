@@ -12,6 +12,11 @@ import (
 
 // ReconcilePredicate keeps the existing generation-based filter but still
 // enqueues the first delete-intent update so finalizer-backed deletes can run.
+// A deletion-policy annotation edit on an object that is already deleting is
+// also enqueued, because annotation changes do not bump generation and a
+// delete blocked by an invalid policy should resume as soon as it is fixed.
+// Pause annotation edits are enqueued for the same reason, so that pausing
+// and resuming a resource take effect immediately.
 func ReconcilePredicate() predicate.Predicate {
 	return predicate.Or(
 		predicate.GenerationChangedPredicate{},
@@ -20,8 +25,21 @@ func ReconcilePredicate() predicate.Predicate {
 				if e.ObjectOld == nil || e.ObjectNew == nil {
 					return false
 				}
-				return e.ObjectOld.GetDeletionTimestamp() == nil && e.ObjectNew.GetDeletionTimestamp() != nil
+				if annotationChanged(e, PauseAnnotation) {
+					return true
+				}
+				if e.ObjectNew.GetDeletionTimestamp() == nil {
+					return false
+				}
+				if e.ObjectOld.GetDeletionTimestamp() == nil {
+					return true
+				}
+				return annotationChanged(e, DeletionPolicyAnnotation)
 			},
 		},
 	)
 }
+
+func annotationChanged(e event.UpdateEvent, key string) bool {
+	return e.ObjectOld.GetAnnotations()[key] != e.ObjectNew.GetAnnotations()[key]
+}
@@ -54,3 +54,42 @@ func TestReconcilePredicateRejectsMetadataOnlyUpdate(t *testing.T) {
 		t.Fatal("Update() should reject metadata-only updates without delete intent")
 	}
 }
+
+func TestReconcilePredicateAllowsDeletionPolicyChangeWhileDeleting(t *testing.T) {
+	t.Parallel()
+
+	pred := ReconcilePredicate()
+	now := metav1.Now()
+	oldObj := &metav1.PartialObjectMetadata{}
+	oldObj.SetDeletionTimestamp(&now)
+	oldObj.SetAnnotations(map[string]string{DeletionPolicyAnnotation: "Keep"})
+	newObj := oldObj.DeepCopy()
+	newObj.SetAnnotations(map[string]string{DeletionPolicyAnnotation: "Orphan"})
+
+	if !pred.Update(event.UpdateEvent{ObjectOld: oldObj, ObjectNew: newObj}) {
+		t.Fatal("Update() should allow a deletion-policy change on a deleting object")
+	}
+
+	unrelated := oldObj.DeepCopy()
+	unrelated.SetAnnotations(map[string]string{DeletionPolicyAnnotation: "Keep", "example": "value"})
+	if pred.Update(event.UpdateEvent{ObjectOld: oldObj, ObjectNew: unrelated}) {
+		t.Fatal("Update() should reject unrelated annotation changes on a deleting object")
+	}
+}
+
+func TestReconcilePredicateAllowsPauseAnnotationChange(t *testing.T) {
+	t.Parallel()
+
+	pred := ReconcilePredicate()
+	oldObj := &metav1.PartialObjectMetadata{}
+	oldObj.SetGeneration(1)
+	newObj := oldObj.DeepCopy()
+	newObj.SetAnnotations(map[string]string{PauseAnnotation: "true"})
+
+	if !pred.Update(event.UpdateEvent{ObjectOld: oldObj, ObjectNew: newObj}) {
+		t.Fatal("Update() should allow pausing a resource")
+	}
+	if !pred.Update(event.UpdateEvent{ObjectOld: newObj, ObjectNew: oldObj}) {
+		t.Fatal("Update() should allow resuming a resource")
+	}
+}
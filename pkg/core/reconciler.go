@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
@@ -27,6 +28,7 @@ import (
 	"github.com/oracle/oci-service-operator/pkg/metrics"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
+	"github.com/oracle/oci-service-operator/pkg/tracing"
 	"github.com/oracle/oci-service-operator/pkg/util"
 )
 
@@ -49,6 +51,24 @@ type BaseReconciler struct {
 	Recorder             record.EventRecorder
 	Scheme               *runtime.Scheme
 	AdditionalFinalizers []string
+	// Drift configures periodic drift resync. The zero value disables it.
+	Drift DriftConfig
+	// Plan configures plan mode. The zero value disables it.
+	Plan PlanConfig
+	// PlanUnsupported marks a service manager that calls OCI outside the
+	// generated runtime and so cannot plan. In plan mode its resources are
+	// not reconciled at all.
+	PlanUnsupported bool
+
+	// syncedGenerations remembers the generation last reconciled successfully
+	// per object UID, so a requeue at the same generation can run as a resync.
+	syncedGenerations sync.Map
+	// asyncStarts remembers when each object's pending async operation was
+	// first seen, for the async operation duration metric.
+	asyncStarts sync.Map
+	// throttleAttempts counts each object's consecutive throttled reconciles
+	// for the jittered requeue backoff.
+	throttleAttempts sync.Map
 }
 
 func (r *BaseReconciler) Reconcile(ctx context.Context, req ctrl.Request, obj client.Object) (result ctrl.Result, err error) {
@@ -58,6 +78,7 @@ func (r *BaseReconciler) Reconcile(ctx context.Context, req ctrl.Request, obj cl
 	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
 		if apierrors.IsNotFound(err) {
 			r.Log.ErrorLogWithFixedMessage(ctx, err, "The resource could be in deleting state. Ignoring")
+			metrics.ForgetCR(r.kindOf(obj), req.NamespacedName.String())
 			return ctrl.Result{}, client.IgnoreNotFound(err)
 		}
 		r.Log.ErrorLogWithFixedMessage(ctx, err, "Error while get the Resource from server.")
@@ -65,6 +86,15 @@ func (r *BaseReconciler) Reconcile(ctx context.Context, req ctrl.Request, obj cl
 	}
 
 	r.Log.InfoLogWithFixedMessage(ctx, "Got the status of resource")
+	ctx, span := r.startReconcileSpan(ctx, obj)
+	defer func() { tracing.End(span, err) }()
+
+	if paused, err := ResolvePaused(obj); paused {
+		return r.reconcilePaused(ctx, obj, req, err)
+	}
+	if r.Plan.Applies(obj.GetNamespace()) {
+		return r.reconcilePlan(ctx, obj, req)
+	}
 
 	if obj.GetDeletionTimestamp() != nil {
 		if controllerutil.ContainsFinalizer(obj, OSOKFinalizerName) {
@@ -73,15 +103,23 @@ func (r *BaseReconciler) Reconcile(ctx context.Context, req ctrl.Request, obj cl
 			oldObj := obj.DeepCopyObject().(client.Object)
 			deleteResult, err := r.deleteResourceResult(ctx, obj, req)
 			if err != nil || !deleteResult.Deleted {
+				r.projectStandardStatus(oldObj, obj, true)
 				if patchErr := r.patchDeleteStatusIfChanged(ctx, oldObj, obj, req); patchErr != nil {
 					err = errors.Join(err, patchErr)
+				} else {
+					r.recordOperationMetrics(obj)
+					r.recordAsyncTrace(ctx, obj)
 				}
 			}
 			if err != nil {
 				r.Log.ErrorLogWithFixedMessage(ctx, err, r.messageWithAsyncBreadcrumb(obj, "Requeuing object due to error during delete of CR"))
 				r.Metrics.AddCRDeleteFaultMetrics(ctx, obj.GetObjectKind().GroupVersionKind().Kind,
 					"Requeuing object due to error during delete of CR", req.Name, req.Namespace)
-				return util.RequeueWithError(ctx, err, defaultRequeueTime, r.Log)
+				requeueDuration := defaultRequeueTime
+				if delay, throttled := r.throttledRequeue(obj, err); throttled {
+					requeueDuration = delay
+				}
+				return util.RequeueWithError(ctx, err, requeueDuration, r.Log)
 			}
 			if deleteResult.Deleted {
 				if err := r.removeFinalizer(ctx, obj, strings.Join(r.AdditionalFinalizers, " "), OSOKFinalizerName); err != nil {
@@ -90,9 +128,16 @@ func (r *BaseReconciler) Reconcile(ctx context.Context, req ctrl.Request, obj cl
 						fmt.Sprintf("Failed to remove the finalizer: %s", err.Error()))
 					return util.RequeueWithError(ctx, err, defaultRequeueTime, r.Log)
 				}
-				r.Log.InfoLogWithFixedMessage(ctx, "Deletion of the CR successful")
-				r.Metrics.AddCRDeleteSuccessMetrics(ctx, obj.GetObjectKind().GroupVersionKind().Kind,
-					"Deletion of the CR successful", req.Name, req.Namespace)
+				if deleteResult.Orphaned {
+					r.Log.InfoLogWithFixedMessage(ctx, "Removed the CR and orphaned the OCI resource")
+				} else {
+					r.Log.InfoLogWithFixedMessage(ctx, "Deletion of the CR successful")
+					r.Metrics.AddCRDeleteSuccessMetrics(ctx, obj.GetObjectKind().GroupVersionKind().Kind,
+						"Deletion of the CR successful", req.Name, req.Namespace)
+				}
+				r.syncedGenerations.Delete(obj.GetUID())
+				r.forgetOperationMetrics(obj)
+				r.resetThrottle(obj)
 				r.Recorder.Event(obj, v1.EventTypeNormal, "Success", "Removed finalizer")
 				return util.DoNotRequeue()
 			} else {
@@ -159,7 +204,15 @@ func (r *BaseReconciler) ReconcileResource(ctx context.Context, obj client.Objec
 	ctx = metrics.AddFixedLogMapEntries(ctx, req.Name, req.Namespace)
 
 	oldObj := obj.DeepCopyObject().(client.Object)
-	OSOKResponse, err := r.OSOKServiceManager.CreateOrUpdate(ctx, obj, req)
+	driftPolicy := r.driftPolicy(obj)
+	serviceCtx := servicemanager.WithReferenceReader(r.driftCheckContext(ctx, obj, driftPolicy), r.Client)
+	serviceCtx = r.replacePolicyContext(serviceCtx, obj)
+	serviceCtx, span := tracing.Start(serviceCtx, "CreateOrUpdate")
+	OSOKResponse, err := r.OSOKServiceManager.CreateOrUpdate(serviceCtx, obj, req)
+	tracing.End(span, err)
+	if err == nil && OSOKResponse.DriftDetected {
+		r.recordDrift(obj, driftPolicy)
+	}
 	if err != nil {
 		r.Log.ErrorLogWithFixedMessage(ctx, err, r.messageWithAsyncBreadcrumb(obj, "Create Or Update failed in the Service Manager with error"))
 		r.Metrics.AddReconcileFaultMetrics(ctx, obj.GetObjectKind().GroupVersionKind().Kind,
@@ -168,6 +221,7 @@ func (r *BaseReconciler) ReconcileResource(ctx context.Context, obj client.Objec
 			r.messageWithAsyncBreadcrumb(obj, fmt.Sprintf("Failed to create or update resource: %s", err.Error())))
 	}
 
+	r.projectStandardStatus(oldObj, obj, true)
 	if err := r.Status().Patch(ctx, obj, client.MergeFrom(oldObj)); err != nil {
 		r.Log.ErrorLogWithFixedMessage(ctx, err, r.messageWithAsyncBreadcrumb(obj, "Error updating the status of the Object"))
 		r.Metrics.AddReconcileFaultMetrics(ctx, obj.GetObjectKind().GroupVersionKind().Kind,
@@ -176,18 +230,28 @@ func (r *BaseReconciler) ReconcileResource(ctx context.Context, obj client.Objec
 			r.messageWithAsyncBreadcrumb(obj, fmt.Sprintf("Failed to create or update resource: %s", err.Error())))
 		return util.RequeueWithError(ctx, err, defaultRequeueTime, r.Log)
 	}
+	r.recordOperationMetrics(obj)
+	r.recordAsyncTrace(ctx, obj)
 	r.Metrics.AddCRCountMetrics(ctx, r.Metrics.ServiceName, "Created an Custom resource "+r.Metrics.ServiceName,
 		req.Name, req.Namespace)
 
+	if err == nil && OSOKResponse.DependencyNotReady {
+		return r.waitForDependency(ctx, obj, OSOKResponse)
+	}
 	if OSOKResponse.IsSuccessful {
 		r.Log.InfoLogWithFixedMessage(ctx, r.messageWithAsyncBreadcrumb(obj, "Reconcile Completed"))
 		r.Metrics.AddReconcileSuccessMetrics(ctx, obj.GetObjectKind().GroupVersionKind().Kind,
 			"Create or Update of resource succeeded", req.Name, req.Namespace)
 		r.Recorder.Event(obj, v1.EventTypeNormal, "Success",
 			r.messageWithAsyncBreadcrumb(obj, "Create or Update of resource succeeded"))
+		r.syncedGenerations.Store(obj.GetUID(), obj.GetGeneration())
+		r.resetThrottle(obj)
 		if OSOKResponse.ShouldRequeue {
 			return util.RequeueWithoutError(ctx, OSOKResponse.RequeueDuration, r.Log)
 		}
+		if driftPolicy.ResyncInterval > 0 {
+			return util.RequeueWithoutError(ctx, driftPolicy.ResyncInterval, r.Log)
+		}
 		return util.DoNotRequeue()
 	} else {
 		r.Log.InfoLogWithFixedMessage(ctx, r.messageWithAsyncBreadcrumb(obj, "Reconcile Failed"))
@@ -195,6 +259,9 @@ func (r *BaseReconciler) ReconcileResource(ctx context.Context, obj client.Objec
 			"Failed to create or update resource", req.Name, req.Namespace)
 		r.Recorder.Event(obj, v1.EventTypeWarning, "Failed",
 			r.messageWithAsyncBreadcrumb(obj, "Failed to create or update resource"))
+		if delay, throttled := r.throttledRequeue(obj, err); throttled {
+			return util.RequeueWithError(ctx, err, delay, r.Log)
+		}
 		if OSOKResponse.ShouldRequeue {
 			return ctrl.Result{Requeue: true}, err
 		}
@@ -204,15 +271,25 @@ func (r *BaseReconciler) ReconcileResource(ctx context.Context, obj client.Objec
 
 func (r *BaseReconciler) deleteResourceResult(ctx context.Context, obj client.Object, req ctrl.Request) (servicemanager.OSOKDeleteResult, error) {
 	ctx = metrics.AddFixedLogMapEntries(ctx, req.Name, req.Namespace)
-	var (
-		delResult servicemanager.OSOKDeleteResult
-		err       error
-	)
+	policy, err := ResolveDeletionPolicy(obj)
+	if err != nil {
+		r.Log.ErrorLogWithFixedMessage(ctx, err, "Delete skipped because the deletion policy is invalid")
+		r.Recorder.Event(obj, v1.EventTypeWarning, deleteEventReasonInvalidPolicy,
+			fmt.Sprintf("Delete blocked until %s is corrected: %s", DeletionPolicyAnnotation, err.Error()))
+		return servicemanager.OSOKDeleteResult{}, err
+	}
+	if policy.SkipsOCIDelete() {
+		return r.orphanResourceResult(ctx, obj, req, policy)
+	}
+
+	var delResult servicemanager.OSOKDeleteResult
+	deleteCtx, span := tracing.Start(ctx, "Delete")
 	if manager, ok := r.OSOKServiceManager.(servicemanager.OSOKDeleteResultProvider); ok {
-		delResult, err = manager.DeleteWithResult(ctx, obj)
+		delResult, err = manager.DeleteWithResult(deleteCtx, obj)
 	} else {
-		delResult.Deleted, err = r.OSOKServiceManager.Delete(ctx, obj)
+		delResult.Deleted, err = r.OSOKServiceManager.Delete(deleteCtx, obj)
 	}
+	tracing.End(span, err)
 	if err != nil {
 		classification := errorutil.ClassifyDeleteError(err)
 		if classification.IsUnambiguousNotFound() {
@@ -250,6 +327,30 @@ func (r *BaseReconciler) deleteResourceResult(ctx context.Context, obj client.Ob
 	return delResult, nil
 }
 
+func (r *BaseReconciler) orphanResourceResult(ctx context.Context, obj client.Object, req ctrl.Request,
+	policy DeletionPolicy) (servicemanager.OSOKDeleteResult, error) {
+	if cleaner, ok := r.OSOKServiceManager.(servicemanager.OSOKOrphanCleaner); ok {
+		if err := cleaner.CleanupOrphaned(ctx, obj); err != nil {
+			r.Log.ErrorLogWithFixedMessage(ctx, err, "Failed to clean up Kubernetes artifacts for the orphaned resource")
+			r.Recorder.Event(obj, v1.EventTypeWarning, "Failed",
+				fmt.Sprintf("Failed to clean up secrets for orphaned resource: %s", err.Error()))
+			return servicemanager.OSOKDeleteResult{}, err
+		}
+	}
+
+	message := fmt.Sprintf("OCI resource left in place by %s=%s", DeletionPolicyAnnotation, policy)
+	if status, err := r.OSOKServiceManager.GetCrdStatus(obj); err == nil && status != nil && status.Ocid != "" {
+		message = fmt.Sprintf("OCI resource %s left in place by %s=%s", status.Ocid, DeletionPolicyAnnotation, policy)
+	}
+
+	r.Log.InfoLogWithFixedMessage(ctx, "Skipping OCI delete because the deletion policy orphans the resource",
+		"deletion_policy", string(policy))
+	r.Metrics.AddCROrphanMetrics(ctx, obj.GetObjectKind().GroupVersionKind().Kind,
+		"OCI resource orphaned by deletion policy", req.Name, req.Namespace)
+	r.Recorder.Event(obj, v1.EventTypeNormal, deleteEventReasonOrphaned, message)
+	return servicemanager.OSOKDeleteResult{Deleted: true, Orphaned: true}, nil
+}
+
 func (r *BaseReconciler) DeleteResource(ctx context.Context, obj client.Object, req ctrl.Request) (bool, error) {
 	result, err := r.deleteResourceResult(ctx, obj, req)
 	return result.Deleted, err
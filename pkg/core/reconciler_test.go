@@ -391,8 +391,14 @@ func TestReconcileDeleteRespectsCommonErrorMatrix(t *testing.T) {
 				assertNoEventContains(t, events, "Removed finalizer")
 				assertNoEventContains(t, events, "Failed to delete resource")
 			default:
-				if result.RequeueAfter != defaultRequeueTime {
-					t.Fatalf("Reconcile() requeueAfter = %v, want %v", result.RequeueAfter, defaultRequeueTime)
+				wantRequeue := defaultRequeueTime
+				if candidate.HTTPStatusCode == 429 {
+					// The first throttled retry backs off at most throttledRequeueBase.
+					wantRequeue = throttledRequeueBase
+				}
+				if result.RequeueAfter <= 0 || result.RequeueAfter > wantRequeue ||
+					(candidate.HTTPStatusCode != 429 && result.RequeueAfter != wantRequeue) {
+					t.Fatalf("Reconcile() requeueAfter = %v, want %v", result.RequeueAfter, wantRequeue)
 				}
 				if !HasFinalizer(stored, OSOKFinalizerName) {
 					t.Fatalf("finalizer removed after delete failure %s", candidate.Name())
@@ -812,6 +818,7 @@ type stubServiceManager struct {
 	createOrUpdateBehavior createOrUpdateBehavior
 	deleteBehavior         deleteBehavior
 	currentStatus          *shared.OSOKStatus
+	deleteCalls            int
 }
 
 func (m *stubServiceManager) CreateOrUpdate(context.Context, runtime.Object, ctrl.Request) (servicemanager.OSOKResponse, error) {
@@ -820,6 +827,7 @@ func (m *stubServiceManager) CreateOrUpdate(context.Context, runtime.Object, ctr
 }
 
 func (m *stubServiceManager) Delete(_ context.Context, obj runtime.Object) (bool, error) {
+	m.deleteCalls++
 	if m.deleteBehavior.mutate != nil {
 		m.deleteBehavior.mutate(obj)
 	}
@@ -828,6 +836,7 @@ func (m *stubServiceManager) Delete(_ context.Context, obj runtime.Object) (bool
 }
 
 func (m *stubServiceManager) DeleteWithResult(_ context.Context, obj runtime.Object) (servicemanager.OSOKDeleteResult, error) {
+	m.deleteCalls++
 	if m.deleteBehavior.mutate != nil {
 		m.deleteBehavior.mutate(obj)
 	}
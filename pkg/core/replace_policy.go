@@ -0,0 +1,66 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	shared "github.com/oracle/oci-service-operator/pkg/shared"
+)
+
+// ReplacePolicyAnnotation opts a single resource into replacing its OCI
+// resource when a create-only spec field changes. Without it, such a change
+// fails the reconcile and the admission policy of the package rejects it.
+const ReplacePolicyAnnotation = "oci.oracle.com/replace-policy"
+
+// ReplacePolicyNever is the default value of ReplacePolicyAnnotation.
+const ReplacePolicyNever = "Never"
+
+const replaceEventReasonInvalidPolicy = "InvalidReplacePolicy"
+
+// ResolveReplacePolicy returns the replace strategy requested on obj, or ""
+// when the annotation is missing, blank, or Never. Unknown values are
+// rejected so that a typo never deletes an OCI resource.
+func ResolveReplacePolicy(obj client.Object) (shared.OSOKReplaceStrategy, error) {
+	if obj == nil {
+		return "", nil
+	}
+
+	raw, ok := obj.GetAnnotations()[ReplacePolicyAnnotation]
+	value := strings.TrimSpace(raw)
+	if !ok || value == "" || strings.EqualFold(value, ReplacePolicyNever) {
+		return "", nil
+	}
+
+	for _, strategy := range []shared.OSOKReplaceStrategy{shared.ReplaceCreateBeforeDelete, shared.ReplaceDeleteBeforeCreate} {
+		if strings.EqualFold(value, string(strategy)) {
+			return strategy, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported %s annotation value %q: must be one of %q, %q, or %q",
+		ReplacePolicyAnnotation, raw, ReplacePolicyNever, shared.ReplaceCreateBeforeDelete, shared.ReplaceDeleteBeforeCreate)
+}
+
+// replacePolicyContext attaches the replace strategy of obj to ctx. An invalid
+// annotation is reported and treated as Never.
+func (r *BaseReconciler) replacePolicyContext(ctx context.Context, obj client.Object) context.Context {
+	strategy, err := ResolveReplacePolicy(obj)
+	if err != nil {
+		r.Log.ErrorLogWithFixedMessage(ctx, err, "Ignoring the invalid replace policy annotation")
+		r.Recorder.Event(obj, v1.EventTypeWarning, replaceEventReasonInvalidPolicy, err.Error())
+		return ctx
+	}
+	if strategy == "" {
+		return ctx
+	}
+	return servicemanager.WithReplaceStrategy(ctx, strategy)
+}
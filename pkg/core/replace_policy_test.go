@@ -0,0 +1,113 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package core
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	shared "github.com/oracle/oci-service-operator/pkg/shared"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func TestResolveReplacePolicy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        shared.OSOKReplaceStrategy
+		wantErr     bool
+	}{
+		{name: "missing annotation never replaces"},
+		{name: "blank annotation never replaces", annotations: map[string]string{ReplacePolicyAnnotation: " "}},
+		{name: "never", annotations: map[string]string{ReplacePolicyAnnotation: "Never"}},
+		{name: "create before delete", annotations: map[string]string{ReplacePolicyAnnotation: "CreateBeforeDelete"}, want: shared.ReplaceCreateBeforeDelete},
+		{name: "delete before create is case insensitive", annotations: map[string]string{ReplacePolicyAnnotation: "deletebeforecreate"}, want: shared.ReplaceDeleteBeforeCreate},
+		{name: "unknown value is rejected", annotations: map[string]string{ReplacePolicyAnnotation: "Recreate"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			obj := testConfigMap("policy")
+			obj.Annotations = tt.annotations
+			got, err := ResolveReplacePolicy(obj)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ResolveReplacePolicy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Fatalf("ResolveReplacePolicy() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReconcilePassesReplaceStrategyToServiceManager(t *testing.T) {
+	t.Parallel()
+
+	obj := testConfigMap("test-delete")
+	obj.Annotations = map[string]string{ReplacePolicyAnnotation: "DeleteBeforeCreate"}
+	serviceManager := &replaceStrategyServiceManager{}
+	reconciler, _, _ := newTestReconcilerWithLogger(t, serviceManager, obj, nil)
+
+	if _, err := reconciler.Reconcile(context.Background(), testRequest(), &corev1.ConfigMap{}); err != nil {
+		t.Fatalf("Reconcile() error = %v, want nil", err)
+	}
+	if serviceManager.strategy != shared.ReplaceDeleteBeforeCreate {
+		t.Fatalf("replace strategy = %q, want %q", serviceManager.strategy, shared.ReplaceDeleteBeforeCreate)
+	}
+}
+
+func TestReconcileInvalidReplacePolicyNeverReplaces(t *testing.T) {
+	t.Parallel()
+
+	obj := testConfigMap("test-delete")
+	obj.Annotations = map[string]string{ReplacePolicyAnnotation: "Recreate"}
+	serviceManager := &replaceStrategyServiceManager{strategy: "unset"}
+	reconciler, recorder, _ := newTestReconcilerWithLogger(t, serviceManager, obj, nil)
+
+	if _, err := reconciler.Reconcile(context.Background(), testRequest(), &corev1.ConfigMap{}); err != nil {
+		t.Fatalf("Reconcile() error = %v, want nil", err)
+	}
+	if serviceManager.strategy != "" {
+		t.Fatalf("replace strategy = %q, want none for an invalid annotation", serviceManager.strategy)
+	}
+
+	found := false
+	for len(recorder.Events) > 0 {
+		if event := <-recorder.Events; strings.Contains(event, replaceEventReasonInvalidPolicy) {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected a %s event", replaceEventReasonInvalidPolicy)
+	}
+}
+
+type replaceStrategyServiceManager struct {
+	strategy shared.OSOKReplaceStrategy
+	status   shared.OSOKStatus
+}
+
+func (m *replaceStrategyServiceManager) CreateOrUpdate(ctx context.Context, _ runtime.Object, _ ctrl.Request) (servicemanager.OSOKResponse, error) {
+	m.strategy = servicemanager.ReplaceStrategy(ctx)
+	return servicemanager.OSOKResponse{IsSuccessful: true}, nil
+}
+
+func (m *replaceStrategyServiceManager) Delete(context.Context, runtime.Object) (bool, error) {
+	return true, nil
+}
+
+func (m *replaceStrategyServiceManager) GetCrdStatus(runtime.Object) (*shared.OSOKStatus, error) {
+	return &m.status, nil
+}
@@ -0,0 +1,138 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package core
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	shared "github.com/oracle/oci-service-operator/pkg/shared"
+)
+
+// projectStandardStatus publishes the Ready, Reconciling and Stalled
+// conditions in status.conditions from the OSOK lifecycle condition and async
+// tracker. When observed is true the current generation is recorded in
+// status.observedGeneration first. A paused resource keeps the generation it
+// last reconciled, so GitOps tools can tell its latest spec is not applied.
+// Kinds whose status does not carry the standard fields are left unchanged.
+//
+// The reconciler owns these fields. Service managers that rebuild the whole
+// status struct drop them, so they are first restored from previous, the copy
+// of obj taken before the service manager ran.
+func (r *BaseReconciler) projectStandardStatus(previous client.Object, obj client.Object, observed bool) {
+	conditions, observedGeneration, ok := standardStatusFields(obj)
+	if !ok {
+		return
+	}
+	status, err := r.OSOKServiceManager.GetCrdStatus(obj)
+	if err != nil || status == nil {
+		return
+	}
+	if previousConditions, previousGeneration, ok := standardStatusFields(previous); ok {
+		*conditions = append([]metav1.Condition(nil), *previousConditions...)
+		*observedGeneration = *previousGeneration
+	}
+
+	if observed {
+		*observedGeneration = obj.GetGeneration()
+	}
+	for _, condition := range standardConditions(*status) {
+		condition.ObservedGeneration = *observedGeneration
+		meta.SetStatusCondition(conditions, condition)
+	}
+}
+
+// standardConditions maps the OSOK status onto the kstatus condition set:
+// Active is Ready, in-flight lifecycle or async work is Reconciling, and a
+// failed lifecycle or async operation is Stalled.
+func standardConditions(status shared.OSOKStatus) []metav1.Condition {
+	lifecycle := lifecycleCondition(status)
+	reason := string(lifecycle)
+	reconciling, stalled := false, false
+
+	switch lifecycle {
+	case shared.Failed:
+		stalled = true
+	case shared.Active:
+	case "":
+		reason = shared.ReconcilingConditionType
+		reconciling = true
+	default:
+		reconciling = true
+	}
+
+	if current := status.Async.Current; current != nil && !stalled {
+		switch current.NormalizedClass {
+		case shared.OSOKAsyncClassPending:
+			reconciling = true
+		case shared.OSOKAsyncClassFailed, shared.OSOKAsyncClassCanceled, shared.OSOKAsyncClassAttention:
+			reason = string(shared.Failed)
+			reconciling, stalled = false, true
+		}
+	}
+
+	ready := !reconciling && !stalled
+	return []metav1.Condition{
+		{Type: shared.ReadyConditionType, Status: conditionStatus(ready), Reason: reason, Message: status.Message},
+		{Type: shared.ReconcilingConditionType, Status: conditionStatus(reconciling), Reason: reason, Message: status.Message},
+		{Type: shared.StalledConditionType, Status: conditionStatus(stalled), Reason: reason, Message: status.Message},
+	}
+}
+
+// lifecycleCondition returns the current OSOK lifecycle condition. The status
+// reason is preferred because the conditions list is append-only; annotations
+// such as Paused, DriftDetected, and Planned describe the resource but not its lifecycle.
+func lifecycleCondition(status shared.OSOKStatus) shared.OSOKConditionType {
+	switch reason := shared.OSOKConditionType(status.Reason); reason {
+	case shared.Provisioning, shared.Active, shared.Failed, shared.Terminating, shared.Updating, shared.DependencyNotReady:
+		return reason
+	}
+	for i := len(status.Conditions) - 1; i >= 0; i-- {
+		switch conditionType := status.Conditions[i].Type; conditionType {
+		case shared.Paused, shared.DriftDetected, shared.Planned:
+			continue
+		default:
+			return conditionType
+		}
+	}
+	return ""
+}
+
+func conditionStatus(value bool) metav1.ConditionStatus {
+	if value {
+		return metav1.ConditionTrue
+	}
+	return metav1.ConditionFalse
+}
+
+func standardStatusFields(obj client.Object) (*[]metav1.Condition, *int64, bool) {
+	value := reflect.ValueOf(obj)
+	if value.Kind() != reflect.Pointer || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return nil, nil, false
+	}
+	status := value.Elem().FieldByName("Status")
+	if !status.IsValid() || status.Kind() != reflect.Struct {
+		return nil, nil, false
+	}
+
+	conditionsField := status.FieldByName("Conditions")
+	generationField := status.FieldByName("ObservedGeneration")
+	if !conditionsField.IsValid() || !generationField.IsValid() {
+		return nil, nil, false
+	}
+	conditions, ok := conditionsField.Addr().Interface().(*[]metav1.Condition)
+	if !ok {
+		return nil, nil, false
+	}
+	observedGeneration, ok := generationField.Addr().Interface().(*int64)
+	if !ok {
+		return nil, nil, false
+	}
+	return conditions, observedGeneration, true
+}
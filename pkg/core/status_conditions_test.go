@@ -0,0 +1,174 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package core
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	queuev1beta1 "github.com/oracle/oci-service-operator/api/queue/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/shared"
+)
+
+func TestStandardConditions(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		status      shared.OSOKStatus
+		ready       bool
+		reconciling bool
+		stalled     bool
+		reason      string
+	}{
+		{
+			name:        "no lifecycle yet",
+			reconciling: true,
+			reason:      shared.ReconcilingConditionType,
+		},
+		{
+			name:        "provisioning",
+			status:      osokStatusWithConditions(shared.Provisioning),
+			reconciling: true,
+			reason:      string(shared.Provisioning),
+		},
+		{
+			name:   "active",
+			status: osokStatusWithConditions(shared.Active),
+			ready:  true,
+			reason: string(shared.Active),
+		},
+		{
+			name:    "failed",
+			status:  osokStatusWithConditions(shared.Active, shared.Failed),
+			stalled: true,
+			reason:  string(shared.Failed),
+		},
+		{
+			name:        "dependency not ready",
+			status:      osokStatusWithConditions(shared.DependencyNotReady),
+			reconciling: true,
+			reason:      string(shared.DependencyNotReady),
+		},
+		{
+			name:   "paused and drift annotations keep the lifecycle",
+			status: osokStatusWithConditions(shared.Active, shared.DriftDetected, shared.Paused),
+			ready:  true,
+			reason: string(shared.Active),
+		},
+		{
+			name: "status reason wins over the condition history",
+			status: func() shared.OSOKStatus {
+				status := osokStatusWithConditions(shared.Failed, shared.Updating)
+				status.Reason = string(shared.Active)
+				return status
+			}(),
+			ready:  true,
+			reason: string(shared.Active),
+		},
+		{
+			name: "pending async operation",
+			status: func() shared.OSOKStatus {
+				status := osokStatusWithConditions(shared.Active)
+				status.Async.Current = &shared.OSOKAsyncOperation{NormalizedClass: shared.OSOKAsyncClassPending}
+				return status
+			}(),
+			reconciling: true,
+			reason:      string(shared.Active),
+		},
+		{
+			name: "failed async operation",
+			status: func() shared.OSOKStatus {
+				status := osokStatusWithConditions(shared.Updating)
+				status.Async.Current = &shared.OSOKAsyncOperation{NormalizedClass: shared.OSOKAsyncClassFailed}
+				return status
+			}(),
+			stalled: true,
+			reason:  string(shared.Failed),
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			conditions := standardConditions(tc.status)
+			assertStandardCondition(t, conditions, shared.ReadyConditionType, tc.ready, tc.reason)
+			assertStandardCondition(t, conditions, shared.ReconcilingConditionType, tc.reconciling, tc.reason)
+			assertStandardCondition(t, conditions, shared.StalledConditionType, tc.stalled, tc.reason)
+		})
+	}
+}
+
+func TestReconcileDeleteInProgressPublishesStandardConditions(t *testing.T) {
+	t.Parallel()
+
+	reconciler, _, kubeClient := newDeleteQueueReconciler(t, deleteBehavior{
+		status: &shared.OSOKStatus{
+			Conditions: []shared.OSOKCondition{{Type: shared.Terminating}},
+			Message:    "OCI delete is in progress",
+		},
+		mutate: func(obj runtime.Object) {
+			// Service managers that rebuild status drop the reconciler-owned fields.
+			queue := obj.(*queuev1beta1.Queue)
+			queue.Status = queuev1beta1.QueueStatus{OsokStatus: queue.Status.OsokStatus}
+		},
+	})
+	stored := kubeClient.StoredQueue(t)
+	stored.Generation = 4
+	stored.Status.ObservedGeneration = 3
+	stored.Status.Conditions = []metav1.Condition{{
+		Type:               shared.ReadyConditionType,
+		Status:             metav1.ConditionTrue,
+		Reason:             string(shared.Active),
+		LastTransitionTime: metav1.Now(),
+	}}
+	kubeClient.stored = stored
+
+	if _, err := reconciler.Reconcile(context.Background(), testRequest(), &queuev1beta1.Queue{}); err != nil {
+		t.Fatalf("Reconcile() error = %v, want nil", err)
+	}
+
+	stored = kubeClient.StoredQueue(t)
+	if stored.Status.ObservedGeneration != 4 {
+		t.Fatalf("status.observedGeneration = %d, want 4", stored.Status.ObservedGeneration)
+	}
+	assertStandardCondition(t, stored.Status.Conditions, shared.ReadyConditionType, false, string(shared.Terminating))
+	assertStandardCondition(t, stored.Status.Conditions, shared.ReconcilingConditionType, true, string(shared.Terminating))
+	assertStandardCondition(t, stored.Status.Conditions, shared.StalledConditionType, false, string(shared.Terminating))
+	if ready := meta.FindStatusCondition(stored.Status.Conditions, shared.ReadyConditionType); ready.ObservedGeneration != 4 || ready.Message != "OCI delete is in progress" {
+		t.Fatalf("Ready condition = %#v, want observedGeneration 4 and the OSOK message", ready)
+	}
+}
+
+func osokStatusWithConditions(types ...shared.OSOKConditionType) shared.OSOKStatus {
+	status := shared.OSOKStatus{}
+	for _, conditionType := range types {
+		status.Conditions = append(status.Conditions, shared.OSOKCondition{Type: conditionType})
+	}
+	return status
+}
+
+func assertStandardCondition(t *testing.T, conditions []metav1.Condition, conditionType string, want bool, reason string) {
+	t.Helper()
+
+	condition := meta.FindStatusCondition(conditions, conditionType)
+	if condition == nil {
+		t.Fatalf("%s condition missing from %#v", conditionType, conditions)
+	}
+	wantStatus := metav1.ConditionFalse
+	if want {
+		wantStatus = metav1.ConditionTrue
+	}
+	if condition.Status != wantStatus || condition.Reason != reason {
+		t.Fatalf("%s condition = %s/%s, want %s/%s", conditionType, condition.Status, condition.Reason, wantStatus, reason)
+	}
+}
@@ -0,0 +1,53 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package core
+
+import (
+	"errors"
+	"math/rand"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oracle/oci-service-operator/pkg/errorutil"
+	"github.com/oracle/oci-service-operator/pkg/ratelimit"
+)
+
+const (
+	throttledRequeueBase = 5 * time.Second
+	throttledRequeueMax  = 5 * time.Minute
+)
+
+// throttledRequeue returns how long to wait before reconciling obj again
+// after err, when err shows OCI or the client-side limiter throttled the
+// call, including 429s the generated runtime normalized. Consecutive
+// throttles back off exponentially with jitter so that objects throttled
+// together do not retry together, and never sooner than the Retry-After
+// that OCI asked for.
+func (r *BaseReconciler) throttledRequeue(obj client.Object, err error) (time.Duration, bool) {
+	retryAfter, ok := ratelimit.IsThrottled(err)
+	var tooManyRequests errorutil.TooManyRequestsOciError
+	if !ok && !errors.As(err, &tooManyRequests) {
+		return 0, false
+	}
+	attempts := 0
+	if previous, found := r.throttleAttempts.Load(obj.GetUID()); found {
+		attempts = previous.(int)
+	}
+	r.throttleAttempts.Store(obj.GetUID(), attempts+1)
+
+	backoff := throttledRequeueMax
+	if attempts < 6 {
+		backoff = min(throttledRequeueBase<<attempts, throttledRequeueMax)
+	}
+	delay := backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+	return max(delay, retryAfter), true
+}
+
+// resetThrottle forgets obj's throttled attempts after a call went through.
+func (r *BaseReconciler) resetThrottle(obj client.Object) {
+	r.throttleAttempts.Delete(obj.GetUID())
+}
@@ -0,0 +1,81 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package core
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-service-operator/pkg/ratelimit"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+)
+
+func TestReconcileResourceRequeuesThrottledFailureWithBackoff(t *testing.T) {
+	t.Parallel()
+
+	reconciler, _, kubeClient := newCreateOrUpdateReconcilerWithLogger(t, createOrUpdateBehavior{
+		err: &ratelimit.ThrottledError{Service: "queue", RetryAfter: 20 * time.Second},
+	}, nil)
+
+	for attempt := 0; attempt < 3; attempt++ {
+		result, err := reconciler.ReconcileResource(context.Background(), kubeClient.StoredConfigMap(), testRequestNamed("test-reconcile"))
+		if err != nil {
+			t.Fatalf("ReconcileResource() error = %v, want nil", err)
+		}
+		if result.RequeueAfter < 20*time.Second || result.RequeueAfter > throttledRequeueMax {
+			t.Fatalf("attempt %d RequeueAfter = %s, want between the 20s Retry-After and %s", attempt, result.RequeueAfter, throttledRequeueMax)
+		}
+	}
+}
+
+func TestThrottledRequeueBacksOffAndResets(t *testing.T) {
+	t.Parallel()
+
+	reconciler, _, _ := newCreateOrUpdateReconcilerWithLogger(t, createOrUpdateBehavior{}, nil)
+	obj := testConfigMap("test-throttle")
+	obj.UID = "uid-throttle"
+	throttled := &ratelimit.ThrottledError{Service: "queue"}
+
+	if _, ok := reconciler.throttledRequeue(obj, stderrors.New("apply failed")); ok {
+		t.Fatal("throttledRequeue() ok = true for an error that is not throttling")
+	}
+	for attempt, ceiling := range []time.Duration{5 * time.Second, 10 * time.Second, 20 * time.Second} {
+		delay, ok := reconciler.throttledRequeue(obj, throttled)
+		if !ok || delay < ceiling/2 || delay > ceiling {
+			t.Fatalf("attempt %d delay = %s, ok = %t, want within [%s, %s]", attempt, delay, ok, ceiling/2, ceiling)
+		}
+	}
+	for attempt := 0; attempt < 10; attempt++ {
+		reconciler.throttledRequeue(obj, throttled)
+	}
+	if delay, _ := reconciler.throttledRequeue(obj, throttled); delay > throttledRequeueMax {
+		t.Fatalf("delay = %s, want at most %s", delay, throttledRequeueMax)
+	}
+
+	reconciler.resetThrottle(obj)
+	if delay, _ := reconciler.throttledRequeue(obj, throttled); delay > throttledRequeueBase {
+		t.Fatalf("delay after reset = %s, want at most %s", delay, throttledRequeueBase)
+	}
+}
+
+func TestReconcileResourceSuccessResetsThrottle(t *testing.T) {
+	t.Parallel()
+
+	reconciler, _, kubeClient := newCreateOrUpdateReconcilerWithLogger(t, createOrUpdateBehavior{
+		response: servicemanager.OSOKResponse{IsSuccessful: true},
+	}, nil)
+	obj := kubeClient.StoredConfigMap()
+	reconciler.throttleAttempts.Store(obj.GetUID(), 4)
+
+	if _, err := reconciler.ReconcileResource(context.Background(), obj, testRequestNamed("test-reconcile")); err != nil {
+		t.Fatalf("ReconcileResource() error = %v, want nil", err)
+	}
+	if _, ok := reconciler.throttleAttempts.Load(obj.GetUID()); ok {
+		t.Fatal("throttle attempts still tracked after a successful reconcile")
+	}
+}
@@ -0,0 +1,70 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package core
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	shared "github.com/oracle/oci-service-operator/pkg/shared"
+	"github.com/oracle/oci-service-operator/pkg/tracing"
+)
+
+// TraceParentAnnotation holds the W3C traceparent of the reconcile that
+// started the resource's in-flight OCI operation. Later reconciles link their
+// spans to it, so one trace search finds every reconcile of a slow create.
+// OSOK sets and clears it; users should not edit it.
+const TraceParentAnnotation = "oci.oracle.com/trace-parent"
+
+// startReconcileSpan starts the root span of one reconcile of obj, linked to
+// the reconcile that started its in-flight OCI operation.
+func (r *BaseReconciler) startReconcileSpan(ctx context.Context, obj client.Object) (context.Context, trace.Span) {
+	kind := r.kindOf(obj)
+	return tracing.StartLinked(ctx, "Reconcile "+kind, obj.GetAnnotations()[TraceParentAnnotation],
+		attribute.String("k8s.kind", kind),
+		attribute.String("k8s.namespace", obj.GetNamespace()),
+		attribute.String("k8s.name", obj.GetName()),
+		attribute.String("k8s.uid", string(obj.GetUID())),
+		attribute.Int64("k8s.generation", obj.GetGeneration()))
+}
+
+// recordAsyncTrace keeps TraceParentAnnotation in step with
+// status.async.current: set from ctx when an OCI operation starts pending and
+// removed once it finishes. Failures are logged and do not fail the
+// reconcile.
+func (r *BaseReconciler) recordAsyncTrace(ctx context.Context, obj client.Object) {
+	status, err := r.OSOKServiceManager.GetCrdStatus(obj)
+	if err != nil || status == nil {
+		return
+	}
+	recorded := obj.GetAnnotations()[TraceParentAnnotation]
+	current := status.Async.Current
+	pending := current != nil && current.NormalizedClass == shared.OSOKAsyncClassPending
+
+	annotations := obj.GetAnnotations()
+	switch {
+	case pending && recorded == "":
+		traceParent := tracing.TraceParent(ctx)
+		if traceParent == "" {
+			return
+		}
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[TraceParentAnnotation] = traceParent
+	case !pending && recorded != "":
+		delete(annotations, TraceParentAnnotation)
+	default:
+		return
+	}
+	obj.SetAnnotations(annotations)
+	if err := r.Update(ctx, obj); err != nil {
+		r.Log.ErrorLogWithFixedMessage(ctx, err, "Failed to record the trace of the in-flight OCI operation")
+	}
+}
@@ -0,0 +1,57 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package core
+
+import (
+	"context"
+	"testing"
+
+	shared "github.com/oracle/oci-service-operator/pkg/shared"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestRecordAsyncTraceFollowsPendingOperation(t *testing.T) {
+	t.Parallel()
+
+	pending := statusWithAsyncCurrent(shared.OSOKAsyncPhaseCreate, "ocid1.workrequest.oc1..create")
+	pending.Async.Current.NormalizedClass = shared.OSOKAsyncClassPending
+	serviceManager := &stubServiceManager{currentStatus: pending}
+	reconciler, _, kubeClient := newTestReconcilerWithLogger(t, serviceManager, testConfigMap("test-delete"), nil)
+	obj := testConfigMap("test-delete")
+
+	reconciler.recordAsyncTrace(context.Background(), obj)
+	if _, ok := obj.GetAnnotations()[TraceParentAnnotation]; ok {
+		t.Fatal("trace-parent annotation set without a recorded span")
+	}
+
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{2},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), spanContext)
+	reconciler.recordAsyncTrace(ctx, obj)
+	recorded := kubeClient.StoredConfigMap().Annotations[TraceParentAnnotation]
+	if recorded == "" {
+		t.Fatal("trace-parent annotation missing while the create is pending")
+	}
+
+	later := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{3},
+		SpanID:     trace.SpanID{4},
+		TraceFlags: trace.FlagsSampled,
+	}))
+	reconciler.recordAsyncTrace(later, obj)
+	if got := kubeClient.StoredConfigMap().Annotations[TraceParentAnnotation]; got != recorded {
+		t.Fatalf("trace-parent annotation = %q, want the first reconcile's %q", got, recorded)
+	}
+
+	serviceManager.currentStatus = &shared.OSOKStatus{Reason: string(shared.Active)}
+	reconciler.recordAsyncTrace(later, obj)
+	if _, ok := kubeClient.StoredConfigMap().Annotations[TraceParentAnnotation]; ok {
+		t.Fatal("trace-parent annotation kept after the create finished")
+	}
+}
@@ -3,85 +3,527 @@
   Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
 */
 
+// Package vault stores OSOK credential secrets in OCI Vault instead of
+// Kubernetes Secrets.
 package vault
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"encoding/json"
-	"github.com/go-logr/logr"
+	"fmt"
+	"strings"
+	"sync"
+
 	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/secrets"
 	"github.com/oracle/oci-go-sdk/v65/vault"
-	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/oracle/oci-service-operator/pkg/credhelper"
+	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/metrics"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 )
 
+// Details names the vault, master encryption key, and compartment that hold
+// OSOK secrets.
+type Details struct {
+	VaultId       string
+	KeyId         string
+	CompartmentId string
+}
+
+// ParseDetails reads the VAULTDETAILS setting, a comma-separated list of
+// vaultId=<ocid>, keyId=<ocid>, and compartmentId=<ocid>.
+func ParseDetails(raw string) (Details, error) {
+	details := Details{}
+	for _, field := range strings.Split(raw, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(field, "=")
+		if !ok {
+			return Details{}, fmt.Errorf("vault details entry %q must be key=value", field)
+		}
+		value = strings.TrimSpace(value)
+		switch strings.TrimSpace(key) {
+		case "vaultId":
+			details.VaultId = value
+		case "keyId":
+			details.KeyId = value
+		case "compartmentId":
+			details.CompartmentId = value
+		default:
+			return Details{}, fmt.Errorf("unknown vault details key %q", key)
+		}
+	}
+	switch {
+	case details.VaultId == "":
+		return Details{}, fmt.Errorf("vault details require vaultId")
+	case details.KeyId == "":
+		return Details{}, fmt.Errorf("vault details require keyId")
+	case details.CompartmentId == "":
+		return Details{}, fmt.Errorf("vault details require compartmentId")
+	}
+	return details, nil
+}
+
+type vaultsClient interface {
+	CreateSecret(ctx context.Context, request vault.CreateSecretRequest) (vault.CreateSecretResponse, error)
+	UpdateSecret(ctx context.Context, request vault.UpdateSecretRequest) (vault.UpdateSecretResponse, error)
+	ListSecrets(ctx context.Context, request vault.ListSecretsRequest) (vault.ListSecretsResponse, error)
+	ScheduleSecretDeletion(ctx context.Context, request vault.ScheduleSecretDeletionRequest) (vault.ScheduleSecretDeletionResponse, error)
+	CancelSecretDeletion(ctx context.Context, request vault.CancelSecretDeletionRequest) (vault.CancelSecretDeletionResponse, error)
+}
+
+type secretsClient interface {
+	GetSecretBundle(ctx context.Context, request secrets.GetSecretBundleRequest) (secrets.GetSecretBundleResponse, error)
+}
+
+// VaultClient is a credhelper.CredentialClient backed by OCI Vault. The
+// Kubernetes secret namespace/name maps to the vault secret name
+// "<namespace>.<name>", labels map to freeform tags, and the data map is stored
+// as one JSON secret version. The vault secret OCID is the record UID used by
+// guarded mutations.
 type VaultClient struct {
-	Provider common.ConfigurationProvider
-	Log      logr.Logger
-	KeyId    string
-	VaultId  string
+	Provider      common.ConfigurationProvider
+	Log           loggerutil.OSOKLogger
+	Metrics       *metrics.Metrics
+	KeyId         string
+	VaultId       string
+	CompartmentId string
+
+	mu      sync.Mutex
+	vaults  vaultsClient
+	secrets secretsClient
 }
 
-func NewVaultClient(provider common.ConfigurationProvider, log logr.Logger, keyId string, vaultId string) *VaultClient {
+var _ credhelper.CredentialClient = (*VaultClient)(nil)
+var _ credhelper.SecretRecordReader = (*VaultClient)(nil)
+var _ credhelper.GuardedSecretMutator = (*VaultClient)(nil)
+
+func NewVaultClient(provider common.ConfigurationProvider, log loggerutil.OSOKLogger, metrics *metrics.Metrics, details Details) *VaultClient {
 	return &VaultClient{
-		Provider: provider,
-		Log:      log,
-		KeyId:    keyId,
-		VaultId:  vaultId,
+		Provider:      provider,
+		Log:           log,
+		Metrics:       metrics,
+		KeyId:         details.KeyId,
+		VaultId:       details.VaultId,
+		CompartmentId: details.CompartmentId,
 	}
 }
 
-func getVaultsClient(provider common.ConfigurationProvider) (vault.VaultsClient, error) {
-	vaultsClient, err := vault.NewVaultsClientWithConfigurationProvider(provider)
-	if err != nil {
-		return vaultsClient, errors.Wrap(err, "Error initializing the Vaults Client")
-	}
-	return vaultsClient, nil
+// vaultSecret is the live vault secret behind one credential secret.
+type vaultSecret struct {
+	id    string
+	state vault.SecretSummaryLifecycleStateEnum
+	tags  map[string]string
+}
+
+func (s vaultSecret) pendingDeletion() bool {
+	return s.state == vault.SecretSummaryLifecycleStatePendingDeletion ||
+		s.state == vault.SecretSummaryLifecycleStateSchedulingDeletion
 }
 
 func (v *VaultClient) CreateSecret(ctx context.Context, secretName string, secretNamespace string, labels map[string]string,
 	data map[string][]byte) (bool, error) {
-	vaultsClient, err := getVaultsClient(v.Provider)
+	vaults, _, err := v.clients()
+	if err != nil {
+		return false, err
+	}
+	content, err := encodeSecretData(data)
+	if err != nil {
+		return false, err
+	}
+
+	existing, err := v.findSecret(ctx, secretName, secretNamespace)
+	switch {
+	case err == nil && existing.pendingDeletion():
+		return v.restoreSecret(ctx, existing, secretName, secretNamespace, labels, content)
+	case err == nil:
+		v.Log.InfoLog("Vault secret already exists with provided details, Not creating a new Secret",
+			"Secret Name", secretName, "Secret Namespace", secretNamespace)
+		return false, errors.NewAlreadyExists(v1.Resource("secret"), secretName)
+	case !errors.IsNotFound(err):
+		return false, err
+	}
+
+	name := vaultSecretName(secretName, secretNamespace)
+	v.Log.InfoLog("Creating Vault secret", "Secret Name", secretName, "Secret Namespace", secretNamespace)
+	_, err = vaults.CreateSecret(ctx, vault.CreateSecretRequest{
+		CreateSecretDetails: vault.CreateSecretDetails{
+			CompartmentId: common.String(v.CompartmentId),
+			VaultId:       common.String(v.VaultId),
+			KeyId:         common.String(v.KeyId),
+			SecretName:    common.String(name),
+			Description:   common.String(fmt.Sprintf("OSOK secret %s/%s", secretNamespace, secretName)),
+			FreeformTags:  labels,
+			SecretContent: content,
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+	if v.Metrics != nil {
+		v.Metrics.AddSecretCountMetrics(ctx, "vaultclient", "New Secret got created", secretName, secretNamespace)
+	}
+	v.Log.InfoLog("Vault secret created successfully", "Secret Name", secretName, "Secret Namespace", secretNamespace)
+	return true, nil
+}
+
+// restoreSecret cancels the scheduled deletion of a secret that is created
+// again under the same name and writes the new content as a new version.
+func (v *VaultClient) restoreSecret(ctx context.Context, existing vaultSecret, secretName string, secretNamespace string,
+	labels map[string]string, content vault.Base64SecretContentDetails) (bool, error) {
+	vaults, _, err := v.clients()
 	if err != nil {
 		return false, err
 	}
+	v.Log.InfoLog("Cancelling the scheduled deletion of the Vault secret", "Secret Name", secretName, "Secret Namespace", secretNamespace)
+	if _, err := vaults.CancelSecretDeletion(ctx, vault.CancelSecretDeletionRequest{SecretId: common.String(existing.id)}); err != nil {
+		return false, err
+	}
+	if err := v.updateVaultSecret(ctx, existing.id, labels, &content); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (v *VaultClient) DeleteSecret(ctx context.Context, secretName string, secretNamespace string) (bool, error) {
+	existing, err := v.findActiveSecret(ctx, secretName, secretNamespace)
+	if err != nil {
+		v.Log.ErrorLog(err, "error getting Vault secret", "Secret Name", secretName, "Secret Namespace", secretNamespace)
+		return false, err
+	}
+	if err := v.scheduleDeletion(ctx, existing.id); err != nil {
+		v.Log.ErrorLog(err, "error scheduling Vault secret deletion", "Secret Name", secretName, "Secret Namespace", secretNamespace)
+		return false, err
+	}
+	v.Log.InfoLog("Vault secret deletion scheduled", "Secret Name", secretName, "Secret Namespace", secretNamespace)
+	return true, nil
+}
 
-	secretData, err := json.Marshal(data)
+func (v *VaultClient) DeleteSecretIfCurrent(
+	ctx context.Context,
+	secretName string,
+	secretNamespace string,
+	current credhelper.SecretRecord,
+) (bool, error) {
+	existing, err := v.GetSecretRecord(ctx, secretName, secretNamespace)
 	if err != nil {
-		return false, errors.Wrapf(err, "Error occured while converting the data to json string")
+		return false, err
+	}
+	if err := validateSecretIdentity(secretName, secretNamespace, current, existing); err != nil {
+		v.Log.ErrorLog(err, "guarded delete rejected because the Vault secret changed", "Secret Name", secretName, "Secret Namespace", secretNamespace)
+		return false, err
 	}
+	if err := v.scheduleDeletion(ctx, string(existing.UID)); err != nil {
+		v.Log.ErrorLog(err, "error scheduling Vault secret deletion", "Secret Name", secretName, "Secret Namespace", secretNamespace)
+		return false, err
+	}
+	v.Log.InfoLog("Vault secret deletion scheduled after guarded identity check", "Secret Name", secretName, "Secret Namespace", secretNamespace)
+	return true, nil
+}
 
-	base64Str := base64.StdEncoding.EncodeToString(secretData)
-	secret := vault.Base64SecretContentDetails{
-		Content: &base64Str,
-		Stage:   "",
+func (v *VaultClient) GetSecret(ctx context.Context, secretName string, secretNamespace string) (map[string][]byte, error) {
+	record, err := v.GetSecretRecord(ctx, secretName, secretNamespace)
+	if err != nil {
+		return map[string][]byte{}, err
 	}
+	return record.Data, nil
+}
 
-	secretDetails := vault.CreateSecretDetails{
-		SecretContent: secret,
-		SecretName:    &secretName,
-		VaultId:       &v.VaultId,
-		Description:   &secretName,
-		FreeformTags:  labels,
-		KeyId:         &v.KeyId,
+// GetSecretRecord reads the current version of the secret bundle.
+func (v *VaultClient) GetSecretRecord(ctx context.Context, secretName string, secretNamespace string) (credhelper.SecretRecord, error) {
+	_, secretsAPI, err := v.clients()
+	if err != nil {
+		return credhelper.SecretRecord{}, err
+	}
+	existing, err := v.findActiveSecret(ctx, secretName, secretNamespace)
+	if err != nil {
+		return credhelper.SecretRecord{}, err
 	}
 
-	_, err = vaultsClient.CreateSecret(ctx, vault.CreateSecretRequest{
-		CreateSecretDetails: secretDetails,
+	response, err := secretsAPI.GetSecretBundle(ctx, secrets.GetSecretBundleRequest{
+		SecretId: common.String(existing.id),
+		Stage:    secrets.GetSecretBundleStageCurrent,
 	})
+	if err != nil {
+		v.Log.ErrorLog(err, "error reading Vault secret bundle", "Secret Name", secretName, "Secret Namespace", secretNamespace)
+		return credhelper.SecretRecord{}, err
+	}
+	data, err := decodeSecretBundle(response.SecretBundle)
+	if err != nil {
+		return credhelper.SecretRecord{}, fmt.Errorf("decode Vault secret %s/%s: %w", secretNamespace, secretName, err)
+	}
 
+	v.Log.InfoLog("Vault secret retrieved successfully", "Secret Name", secretName, "Secret Namespace", secretNamespace)
+	return credhelper.SecretRecord{
+		UID:    types.UID(existing.id),
+		Labels: cloneLabels(existing.tags),
+		Data:   data,
+	}, nil
+}
+
+func (v *VaultClient) UpdateSecret(ctx context.Context, secretName string, secretNamespace string, labels map[string]string,
+	data map[string][]byte) (bool, error) {
+	existing, err := v.GetSecretRecord(ctx, secretName, secretNamespace)
 	if err != nil {
 		return false, err
 	}
+	if err := v.updateSecret(ctx, existing, labels, data); err != nil {
+		v.Log.ErrorLog(err, "Failed to update Vault secret", "Secret Name", secretName, "Secret Namespace", secretNamespace)
+		return false, err
+	}
+	v.Log.InfoLog("Vault secret updated successfully", "Secret Name", secretName, "Secret Namespace", secretNamespace)
+	return true, nil
+}
 
+func (v *VaultClient) UpdateSecretIfCurrent(
+	ctx context.Context,
+	secretName string,
+	secretNamespace string,
+	current credhelper.SecretRecord,
+	labels map[string]string,
+	data map[string][]byte,
+) (bool, error) {
+	existing, err := v.GetSecretRecord(ctx, secretName, secretNamespace)
+	if err != nil {
+		return false, err
+	}
+	if err := validateSecretIdentity(secretName, secretNamespace, current, existing); err != nil {
+		v.Log.ErrorLog(err, "guarded update rejected because the Vault secret changed", "Secret Name", secretName, "Secret Namespace", secretNamespace)
+		return false, err
+	}
+	if err := v.updateSecret(ctx, existing, labels, data); err != nil {
+		v.Log.ErrorLog(err, "Failed to update Vault secret", "Secret Name", secretName, "Secret Namespace", secretNamespace)
+		return false, err
+	}
+	v.Log.InfoLog("Vault secret updated successfully after guarded identity check", "Secret Name", secretName, "Secret Namespace", secretNamespace)
 	return true, nil
 }
 
-func (v *VaultClient) DeleteSecret(ctx context.Context, secretName string, secretNamespace string) (bool, error) {
+// updateSecret writes a new secret version only when the data changed, so
+// repeated reconciles do not rotate versions.
+func (v *VaultClient) updateSecret(ctx context.Context, existing credhelper.SecretRecord, labels map[string]string, data map[string][]byte) error {
+	var content *vault.Base64SecretContentDetails
+	if !secretDataEqual(existing.Data, data) {
+		encoded, err := encodeSecretData(data)
+		if err != nil {
+			return err
+		}
+		content = &encoded
+	}
+	if labels != nil && secretLabelsEqual(existing.Labels, labels) {
+		labels = nil
+	}
+	if content == nil && labels == nil {
+		return nil
+	}
+	return v.updateVaultSecret(ctx, string(existing.UID), labels, content)
+}
 
-	return true, nil
+func (v *VaultClient) updateVaultSecret(ctx context.Context, secretID string, labels map[string]string, content *vault.Base64SecretContentDetails) error {
+	vaults, _, err := v.clients()
+	if err != nil {
+		return err
+	}
+	details := vault.UpdateSecretDetails{FreeformTags: labels}
+	if content != nil {
+		details.SecretContent = *content
+	}
+	_, err = vaults.UpdateSecret(ctx, vault.UpdateSecretRequest{
+		SecretId:            common.String(secretID),
+		UpdateSecretDetails: details,
+	})
+	return err
 }
 
-func (v *VaultClient) GetSecret(ctx context.Context, secretName string, secretNamespace string) (map[string][]byte, error) {
-	return nil, nil
+func (v *VaultClient) scheduleDeletion(ctx context.Context, secretID string) error {
+	vaults, _, err := v.clients()
+	if err != nil {
+		return err
+	}
+	_, err = vaults.ScheduleSecretDeletion(ctx, vault.ScheduleSecretDeletionRequest{SecretId: common.String(secretID)})
+	return err
+}
+
+func (v *VaultClient) findActiveSecret(ctx context.Context, secretName string, secretNamespace string) (vaultSecret, error) {
+	existing, err := v.findSecret(ctx, secretName, secretNamespace)
+	if err != nil {
+		return vaultSecret{}, err
+	}
+	if existing.pendingDeletion() {
+		return vaultSecret{}, errors.NewNotFound(v1.Resource("secret"), secretName)
+	}
+	return existing, nil
+}
+
+// findSecret returns the vault secret for namespace/name, including one whose
+// deletion is scheduled. Deleted secrets are ignored.
+func (v *VaultClient) findSecret(ctx context.Context, secretName string, secretNamespace string) (vaultSecret, error) {
+	vaults, _, err := v.clients()
+	if err != nil {
+		return vaultSecret{}, err
+	}
+	request := vault.ListSecretsRequest{
+		CompartmentId: common.String(v.CompartmentId),
+		VaultId:       common.String(v.VaultId),
+		Name:          common.String(vaultSecretName(secretName, secretNamespace)),
+	}
+	for {
+		response, err := vaults.ListSecrets(ctx, request)
+		if err != nil {
+			return vaultSecret{}, err
+		}
+		for _, summary := range response.Items {
+			switch summary.LifecycleState {
+			case vault.SecretSummaryLifecycleStateDeleted, vault.SecretSummaryLifecycleStateDeleting:
+				continue
+			}
+			return vaultSecret{
+				id:    stringValue(summary.Id),
+				state: summary.LifecycleState,
+				tags:  summary.FreeformTags,
+			}, nil
+		}
+		if response.OpcNextPage == nil {
+			return vaultSecret{}, errors.NewNotFound(v1.Resource("secret"), secretName)
+		}
+		request.Page = response.OpcNextPage
+	}
+}
+
+func (v *VaultClient) clients() (vaultsClient, secretsClient, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.vaults == nil {
+		vaultsAPI, err := ociclient.New(v.Provider, vault.NewVaultsClientWithConfigurationProvider)
+		if err != nil {
+			return nil, nil, fmt.Errorf("initialize the Vaults client: %w", err)
+		}
+		v.vaults = vaultsAPI
+	}
+	if v.secrets == nil {
+		secretsAPI, err := ociclient.New(v.Provider, secrets.NewSecretsClientWithConfigurationProvider)
+		if err != nil {
+			return nil, nil, fmt.Errorf("initialize the Secrets client: %w", err)
+		}
+		v.secrets = secretsAPI
+	}
+	return v.vaults, v.secrets, nil
+}
+
+// vaultSecretName maps a Kubernetes namespace/name to a vault secret name.
+// Namespaces cannot contain dots, so the mapping is unambiguous.
+func vaultSecretName(secretName string, secretNamespace string) string {
+	return secretNamespace + "." + secretName
+}
+
+func encodeSecretData(data map[string][]byte) (vault.Base64SecretContentDetails, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return vault.Base64SecretContentDetails{}, fmt.Errorf("encode secret data: %w", err)
+	}
+	return vault.Base64SecretContentDetails{
+		Content: common.String(base64.StdEncoding.EncodeToString(payload)),
+	}, nil
+}
+
+func decodeSecretBundle(bundle secrets.SecretBundle) (map[string][]byte, error) {
+	content, ok := bundle.SecretBundleContent.(secrets.Base64SecretBundleContentDetails)
+	if !ok {
+		return nil, fmt.Errorf("unsupported secret bundle content %T", bundle.SecretBundleContent)
+	}
+	payload, err := base64.StdEncoding.DecodeString(stringValue(content.Content))
+	if err != nil {
+		return nil, err
+	}
+	data := map[string][]byte{}
+	if err := json.Unmarshal(payload, &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func cloneLabels(labels map[string]string) map[string]string {
+	if labels == nil {
+		return nil
+	}
+	cloned := make(map[string]string, len(labels))
+	for key, value := range labels {
+		cloned[key] = value
+	}
+	return cloned
+}
+
+func validateSecretIdentity(
+	secretName string,
+	secretNamespace string,
+	current credhelper.SecretRecord,
+	existing credhelper.SecretRecord,
+) error {
+	expectedUID := strings.TrimSpace(string(current.UID))
+	if expectedUID == "" {
+		return fmt.Errorf("guarded secret mutation requires the previously read Vault secret OCID for %s/%s", secretNamespace, secretName)
+	}
+	actualUID := strings.TrimSpace(string(existing.UID))
+	if actualUID != expectedUID {
+		return errors.NewConflict(
+			v1.Resource("secret"),
+			secretName,
+			fmt.Errorf("secret %s/%s changed OCID from %q to %q", secretNamespace, secretName, expectedUID, actualUID),
+		)
+	}
+	if !secretLabelsEqual(current.Labels, existing.Labels) {
+		return errors.NewConflict(
+			v1.Resource("secret"),
+			secretName,
+			fmt.Errorf("secret %s/%s tags changed since the guarded read", secretNamespace, secretName),
+		)
+	}
+	if !secretDataEqual(current.Data, existing.Data) {
+		return errors.NewConflict(
+			v1.Resource("secret"),
+			secretName,
+			fmt.Errorf("secret %s/%s data changed since the guarded read", secretNamespace, secretName),
+		)
+	}
+	return nil
+}
+
+func secretLabelsEqual(left map[string]string, right map[string]string) bool {
+	if len(left) != len(right) {
+		return false
+	}
+	for key, value := range left {
+		if right[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+func secretDataEqual(left map[string][]byte, right map[string][]byte) bool {
+	if len(left) != len(right) {
+		return false
+	}
+	for key, value := range left {
+		if !bytes.Equal(value, right[key]) {
+			return false
+		}
+	}
+	return true
 }
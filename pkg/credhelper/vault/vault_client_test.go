@@ -0,0 +1,250 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/secrets"
+	"github.com/oracle/oci-go-sdk/v65/vault"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/oracle/oci-service-operator/pkg/credhelper"
+	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+)
+
+const (
+	testSecretName      = "test-secret"
+	testSecretNamespace = "default"
+)
+
+type fakeSecret struct {
+	name     string
+	state    vault.SecretSummaryLifecycleStateEnum
+	tags     map[string]string
+	content  string
+	versions int
+}
+
+// fakeVault keeps vault secrets in memory and serves both the Vaults and the
+// Secrets API.
+type fakeVault struct {
+	secrets map[string]*fakeSecret
+}
+
+func newFakeVault() *fakeVault {
+	return &fakeVault{secrets: map[string]*fakeSecret{}}
+}
+
+func (f *fakeVault) CreateSecret(_ context.Context, request vault.CreateSecretRequest) (vault.CreateSecretResponse, error) {
+	id := fmt.Sprintf("ocid1.vaultsecret.oc1..%d", len(f.secrets)+1)
+	content := request.SecretContent.(vault.Base64SecretContentDetails)
+	f.secrets[id] = &fakeSecret{
+		name:     *request.SecretName,
+		state:    vault.SecretSummaryLifecycleStateActive,
+		tags:     request.FreeformTags,
+		content:  *content.Content,
+		versions: 1,
+	}
+	return vault.CreateSecretResponse{Secret: vault.Secret{Id: common.String(id)}}, nil
+}
+
+func (f *fakeVault) UpdateSecret(_ context.Context, request vault.UpdateSecretRequest) (vault.UpdateSecretResponse, error) {
+	secret := f.secrets[*request.SecretId]
+	if request.FreeformTags != nil {
+		secret.tags = request.FreeformTags
+	}
+	if content, ok := request.SecretContent.(vault.Base64SecretContentDetails); ok {
+		secret.content = *content.Content
+		secret.versions++
+	}
+	return vault.UpdateSecretResponse{}, nil
+}
+
+func (f *fakeVault) ListSecrets(_ context.Context, request vault.ListSecretsRequest) (vault.ListSecretsResponse, error) {
+	response := vault.ListSecretsResponse{}
+	for id, secret := range f.secrets {
+		if secret.name != *request.Name {
+			continue
+		}
+		response.Items = append(response.Items, vault.SecretSummary{
+			Id:             common.String(id),
+			SecretName:     common.String(secret.name),
+			LifecycleState: secret.state,
+			FreeformTags:   secret.tags,
+		})
+	}
+	return response, nil
+}
+
+func (f *fakeVault) ScheduleSecretDeletion(_ context.Context, request vault.ScheduleSecretDeletionRequest) (vault.ScheduleSecretDeletionResponse, error) {
+	f.secrets[*request.SecretId].state = vault.SecretSummaryLifecycleStatePendingDeletion
+	return vault.ScheduleSecretDeletionResponse{}, nil
+}
+
+func (f *fakeVault) CancelSecretDeletion(_ context.Context, request vault.CancelSecretDeletionRequest) (vault.CancelSecretDeletionResponse, error) {
+	f.secrets[*request.SecretId].state = vault.SecretSummaryLifecycleStateActive
+	return vault.CancelSecretDeletionResponse{}, nil
+}
+
+func (f *fakeVault) GetSecretBundle(_ context.Context, request secrets.GetSecretBundleRequest) (secrets.GetSecretBundleResponse, error) {
+	secret := f.secrets[*request.SecretId]
+	return secrets.GetSecretBundleResponse{
+		SecretBundle: secrets.SecretBundle{
+			SecretId:            request.SecretId,
+			SecretBundleContent: secrets.Base64SecretBundleContentDetails{Content: common.String(secret.content)},
+		},
+	}, nil
+}
+
+func newTestVaultClient(store *fakeVault) *VaultClient {
+	client := NewVaultClient(nil, loggerutil.OSOKLogger{Logger: logr.Discard()}, nil, Details{
+		VaultId:       "ocid1.vault.oc1..example",
+		KeyId:         "ocid1.key.oc1..example",
+		CompartmentId: "ocid1.compartment.oc1..example",
+	})
+	client.vaults = store
+	client.secrets = store
+	return client
+}
+
+func mustCreateSecret(t *testing.T, client *VaultClient, labels map[string]string, data map[string][]byte) {
+	t.Helper()
+
+	created, err := client.CreateSecret(context.Background(), testSecretName, testSecretNamespace, labels, data)
+	if err != nil {
+		t.Fatalf("create secret: %v", err)
+	}
+	if !created {
+		t.Fatal("expected secret to be created")
+	}
+}
+
+func TestParseDetails(t *testing.T) {
+	details, err := ParseDetails("vaultId=ocid1.vault.oc1..a, keyId=ocid1.key.oc1..b,compartmentId=ocid1.compartment.oc1..c")
+	if err != nil {
+		t.Fatalf("ParseDetails() error = %v", err)
+	}
+	want := Details{VaultId: "ocid1.vault.oc1..a", KeyId: "ocid1.key.oc1..b", CompartmentId: "ocid1.compartment.oc1..c"}
+	if details != want {
+		t.Fatalf("ParseDetails() = %#v, want %#v", details, want)
+	}
+
+	for _, raw := range []string{"", "vaultId=a,keyId=b", "vaultId=a,keyId=b,compartmentId=c,region=d", "vaultId"} {
+		if _, err := ParseDetails(raw); err == nil {
+			t.Fatalf("ParseDetails(%q) error = nil, want an error", raw)
+		}
+	}
+}
+
+func TestVaultClientCreateGetUpdateDelete(t *testing.T) {
+	store := newFakeVault()
+	client := newTestVaultClient(store)
+	labels := map[string]string{"app": "osok"}
+
+	mustCreateSecret(t, client, labels, map[string][]byte{"password": []byte("one")})
+	if _, err := client.CreateSecret(context.Background(), testSecretName, testSecretNamespace, labels, nil); !apierrors.IsAlreadyExists(err) {
+		t.Fatalf("second CreateSecret() error = %v, want AlreadyExists", err)
+	}
+
+	record, err := client.GetSecretRecord(context.Background(), testSecretName, testSecretNamespace)
+	if err != nil {
+		t.Fatalf("GetSecretRecord() error = %v", err)
+	}
+	secret := store.secrets[string(record.UID)]
+	if secret == nil || secret.name != "default.test-secret" {
+		t.Fatalf("GetSecretRecord() UID = %q, want the vault secret OCID", record.UID)
+	}
+	if !reflect.DeepEqual(record.Labels, labels) || string(record.Data["password"]) != "one" {
+		t.Fatalf("GetSecretRecord() = %#v", record)
+	}
+
+	if _, err := client.UpdateSecret(context.Background(), testSecretName, testSecretNamespace, labels, map[string][]byte{"password": []byte("one")}); err != nil {
+		t.Fatalf("UpdateSecret() error = %v", err)
+	}
+	if secret.versions != 1 {
+		t.Fatalf("versions = %d after an unchanged update, want 1", secret.versions)
+	}
+	if _, err := client.UpdateSecret(context.Background(), testSecretName, testSecretNamespace, labels, map[string][]byte{"password": []byte("two")}); err != nil {
+		t.Fatalf("UpdateSecret() error = %v", err)
+	}
+	if secret.versions != 2 {
+		t.Fatalf("versions = %d after a changed update, want 2", secret.versions)
+	}
+	data, err := client.GetSecret(context.Background(), testSecretName, testSecretNamespace)
+	if err != nil || string(data["password"]) != "two" {
+		t.Fatalf("GetSecret() = %v, %v, want the rotated password", data, err)
+	}
+
+	if _, err := client.DeleteSecret(context.Background(), testSecretName, testSecretNamespace); err != nil {
+		t.Fatalf("DeleteSecret() error = %v", err)
+	}
+	if secret.state != vault.SecretSummaryLifecycleStatePendingDeletion {
+		t.Fatalf("state = %s, want PENDING_DELETION", secret.state)
+	}
+	if _, err := client.GetSecret(context.Background(), testSecretName, testSecretNamespace); !apierrors.IsNotFound(err) {
+		t.Fatalf("GetSecret() after delete error = %v, want NotFound", err)
+	}
+	if _, err := client.DeleteSecret(context.Background(), testSecretName, testSecretNamespace); !apierrors.IsNotFound(err) {
+		t.Fatalf("second DeleteSecret() error = %v, want NotFound", err)
+	}
+}
+
+func TestVaultClientCreateRestoresSecretPendingDeletion(t *testing.T) {
+	store := newFakeVault()
+	client := newTestVaultClient(store)
+
+	mustCreateSecret(t, client, nil, map[string][]byte{"password": []byte("one")})
+	if _, err := client.DeleteSecret(context.Background(), testSecretName, testSecretNamespace); err != nil {
+		t.Fatalf("DeleteSecret() error = %v", err)
+	}
+	mustCreateSecret(t, client, nil, map[string][]byte{"password": []byte("two")})
+
+	if len(store.secrets) != 1 {
+		t.Fatalf("vault secrets = %d, want the restored secret only", len(store.secrets))
+	}
+	data, err := client.GetSecret(context.Background(), testSecretName, testSecretNamespace)
+	if err != nil || string(data["password"]) != "two" {
+		t.Fatalf("GetSecret() = %v, %v, want the new password", data, err)
+	}
+}
+
+func TestVaultClientGuardedMutationsRejectChangedSecret(t *testing.T) {
+	store := newFakeVault()
+	client := newTestVaultClient(store)
+	mustCreateSecret(t, client, nil, map[string][]byte{"password": []byte("one")})
+
+	current, err := client.GetSecretRecord(context.Background(), testSecretName, testSecretNamespace)
+	if err != nil {
+		t.Fatalf("GetSecretRecord() error = %v", err)
+	}
+	if _, err := client.UpdateSecret(context.Background(), testSecretName, testSecretNamespace, nil, map[string][]byte{"password": []byte("other")}); err != nil {
+		t.Fatalf("UpdateSecret() error = %v", err)
+	}
+
+	if _, err := client.UpdateSecretIfCurrent(context.Background(), testSecretName, testSecretNamespace, current, nil, nil); !apierrors.IsConflict(err) {
+		t.Fatalf("UpdateSecretIfCurrent() error = %v, want Conflict", err)
+	}
+	if _, err := client.DeleteSecretIfCurrent(context.Background(), testSecretName, testSecretNamespace, current); !apierrors.IsConflict(err) {
+		t.Fatalf("DeleteSecretIfCurrent() error = %v, want Conflict", err)
+	}
+	if _, err := client.DeleteSecretIfCurrent(context.Background(), testSecretName, testSecretNamespace, credhelper.SecretRecord{}); err == nil {
+		t.Fatal("DeleteSecretIfCurrent() without an OCID error = nil, want an error")
+	}
+
+	current, err = client.GetSecretRecord(context.Background(), testSecretName, testSecretNamespace)
+	if err != nil {
+		t.Fatalf("GetSecretRecord() error = %v", err)
+	}
+	if deleted, err := client.DeleteSecretIfCurrent(context.Background(), testSecretName, testSecretNamespace, current); err != nil || !deleted {
+		t.Fatalf("DeleteSecretIfCurrent() = %t, %v, want deleted", deleted, err)
+	}
+}
@@ -7,12 +7,16 @@ import (
 	"time"
 
 	"github.com/oracle/oci-go-sdk/v65/common"
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/oci/v1beta1"
 	"github.com/oracle/oci-service-operator/go_ensurefips"
 	"github.com/oracle/oci-service-operator/pkg/authhelper"
 	"github.com/oracle/oci-service-operator/pkg/config"
+	"github.com/oracle/oci-service-operator/pkg/credhelper"
 	"github.com/oracle/oci-service-operator/pkg/credhelper/kubesecret"
+	"github.com/oracle/oci-service-operator/pkg/credhelper/vault"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
 	"github.com/oracle/oci-service-operator/pkg/metrics"
+	"github.com/oracle/oci-service-operator/pkg/ociidentity"
 	"github.com/oracle/oci-service-operator/pkg/util"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -31,9 +35,11 @@ type RegisterFunc func(ctrl.Manager, *Dependencies) error
 // Dependencies bundles common clients initialised by Run that individual services can reuse.
 type Dependencies struct {
 	Provider   common.ConfigurationProvider
-	CredClient *kubesecret.KubeSecretClient
+	CredClient credhelper.CredentialClient
 	Metrics    *metrics.Metrics
 	Scheme     *runtime.Scheme
+	// Identities resolves per-resource OCIIdentity credentials.
+	Identities *ociidentity.Resolver
 }
 
 // Options configure shared manager behaviour.
@@ -105,6 +111,9 @@ func Run(opts Options, registrars ...RegisterFunc) error {
 	if opts.Scheme == nil {
 		return fmt.Errorf("manager: scheme must be provided")
 	}
+	if err := ociv1beta1.AddToScheme(opts.Scheme); err != nil {
+		return fmt.Errorf("manager: add oci identity types to scheme: %w", err)
+	}
 	if opts.LeaderElectionID == "" {
 		opts.LeaderElectionID = defaultLeaderElectionID
 	}
@@ -195,17 +204,35 @@ func Run(opts Options, registrars ...RegisterFunc) error {
 
 	metricsClient := metrics.Init(opts.MetricsServiceName, loggerutil.OSOKLogger{Logger: ctrl.Log.WithName("metrics")})
 
-	credClient := &kubesecret.KubeSecretClient{
+	var credClient credhelper.CredentialClient = &kubesecret.KubeSecretClient{
 		Client:  mgr.GetClient(),
 		Log:     loggerutil.OSOKLogger{Logger: ctrl.Log.WithName("credential-helper").WithName("KubeSecretClient")},
 		Metrics: metricsClient,
 	}
+	if vaultDetails := osokCfg.VaultDetails(); vaultDetails != "" {
+		details, err := vault.ParseDetails(vaultDetails)
+		if err != nil {
+			setupLog.ErrorLog(err, "invalid VAULTDETAILS. Exiting setup")
+			return err
+		}
+		setupLog.InfoLog("Storing credential secrets in OCI Vault", "vaultId", details.VaultId)
+		credClient = vault.NewVaultClient(provider,
+			loggerutil.OSOKLogger{Logger: ctrl.Log.WithName("credential-helper").WithName("VaultClient")},
+			metricsClient, details)
+	}
 
 	deps := &Dependencies{
 		Provider:   provider,
 		CredClient: credClient,
 		Metrics:    metricsClient,
 		Scheme:     opts.Scheme,
+		Identities: &ociidentity.Resolver{
+			Client:       mgr.GetClient(),
+			SecretReader: mgr.GetAPIReader(),
+			AuthProvider: &authhelper.AuthConfigProvider{
+				Log: loggerutil.OSOKLogger{Logger: ctrl.Log.WithName("identity").WithName("config")},
+			},
+		},
 	}
 
 	for _, register := range registrars {
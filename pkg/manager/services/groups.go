@@ -22,6 +22,7 @@ func registerGroup(group string) manager.RegisterFunc {
 			Scheme:           deps.Scheme,
 			Metrics:          deps.Metrics,
 		})
+		ctx.Identities = deps.Identities
 		if err := registration.SetupWithManager(ctx); err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ratelimit"
 	"github.com/prometheus/client_golang/prometheus"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 	"strconv"
@@ -22,6 +23,7 @@ const (
 	ReconcileFault   = "oci_service_operator_reconcile_fault"
 	CRDeleteSuccess  = "oci_service_operator_cr_delete_success"
 	CRDeleteFault    = "oci_service_operator_cr_delete_fault"
+	CROrphan         = "oci_service_operator_cr_orphan"
 	CRSuccess        = "oci_service_operator_cr_success"
 	CRFault          = "oci_service_operator_cr_fault"
 	CRCount          = "oci_service_operator_cr_count"
@@ -50,6 +52,11 @@ var (
 		Help: "Total Number of CR Delete with Fault Status",
 	}, []string{"component", "resourcename", "namespace", "state", "message"})
 
+	crOrphanCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: CROrphan,
+		Help: "Total Number of CR Delete that left the OCI resource in place",
+	}, []string{"component", "resourcename", "namespace", "state", "message"})
+
 	crSuccessCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
 		Name: CRSuccess,
 		Help: "Total Number of CR with Success Status",
@@ -86,8 +93,15 @@ func Init(serviceName string, log loggerutil.OSOKLogger) *Metrics {
 		crFaultCounter,
 		crDeleteFaultCounter,
 		crDeleteSuccessCounter,
+		crOrphanCounter,
 		secretCounter,
+		ociRequestDuration,
+		ociThrottled,
+		ociServerErrors,
+		asyncOperationDuration,
+		managedCRs,
 	)
+	metrics.Registry.MustRegister(ratelimit.Collectors()...)
 	return &Metrics{
 		Name:        defaultMetricsNamespace,
 		ServiceName: serviceName,
@@ -131,6 +145,12 @@ func (m *Metrics) AddCRDeleteSuccessMetrics(ctx context.Context, component strin
 	crDeleteSuccessCounter.WithLabelValues(component, resourceName, namespace, "Success", msg).Inc()
 }
 
+func (m *Metrics) AddCROrphanMetrics(ctx context.Context, component string, msg string, resourceName string, namespace string) {
+	ctx = AddFixedLogMapEntries(ctx, resourceName, namespace)
+	m.Logger.InfoLogWithFixedMessage(ctx, fmt.Sprintf("Recording the cr orphan metrics for %s", resourceName))
+	crOrphanCounter.WithLabelValues(component, resourceName, namespace, "Success", msg).Inc()
+}
+
 func (m *Metrics) AddCRCountMetrics(ctx context.Context, component string, msg string, resourceName string, namespace string) {
 	ctx = AddFixedLogMapEntries(ctx, resourceName, namespace)
 	m.Logger.InfoLogWithFixedMessage(ctx, fmt.Sprintf("Recording the cr count metrics for %s", resourceName))
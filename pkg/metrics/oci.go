@@ -0,0 +1,187 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-service-operator/pkg/ratelimit"
+	"github.com/oracle/oci-service-operator/pkg/tracing"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	OCIRequestDuration     = "oci_service_operator_oci_request_duration_seconds"
+	OCIThrottled           = "oci_service_operator_oci_throttled_total"
+	OCIServerErrors        = "oci_service_operator_oci_server_errors_total"
+	AsyncOperationDuration = "oci_service_operator_async_operation_duration_seconds"
+	ManagedCRs             = "oci_service_operator_managed_crs"
+)
+
+// statusCodeTransportError labels calls that failed before OCI returned an
+// HTTP status, such as timeouts and connection errors.
+const statusCodeTransportError = "error"
+
+var (
+	ociRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    OCIRequestDuration,
+		Help:    "Latency of OCI API calls by service, operation, and HTTP status code",
+		Buckets: []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"service", "operation", "status_code"})
+
+	ociThrottled = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: OCIThrottled,
+		Help: "Total Number of OCI API calls rejected with HTTP 429",
+	}, []string{"service", "operation"})
+
+	ociServerErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: OCIServerErrors,
+		Help: "Total Number of OCI API calls that failed with an HTTP 5xx status",
+	}, []string{"service", "operation", "status_code"})
+
+	asyncOperationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    AsyncOperationDuration,
+		Help:    "Time from the start of a create, update, or delete until OCI finished it, by kind, phase, and outcome",
+		Buckets: []float64{10, 30, 60, 120, 300, 600, 1200, 1800, 3600, 7200, 14400},
+	}, []string{"kind", "phase", "outcome"})
+
+	managedCRs = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: ManagedCRs,
+		Help: "Number of CRs managed by the operator by kind and lifecycle condition",
+	}, []string{"kind", "condition"})
+)
+
+// ObserveOCICall records the latency and outcome of one OCI API call.
+// request is the OCI SDK request value: its package names the service and
+// its type names the operation, for example "queue" and "CreateQueue".
+// response may be nil when the call failed.
+func ObserveOCICall(request any, response any, err error, duration time.Duration) {
+	service, operation := OCIOperation(request)
+	if service == "" {
+		return
+	}
+	ObserveOCIOperation(service, operation, response, err, duration)
+}
+
+// CallOCI invokes an OCI SDK client method under the service's shared rate
+// limiter, in a trace span, and records it with ObserveOCICall, for managers
+// that call the SDK directly:
+//
+//	response, err := metrics.CallOCI(ctx, request, client.GetQueue)
+func CallOCI[Request any, Response any](ctx context.Context, request Request, call func(context.Context, Request) (Response, error)) (Response, error) {
+	service, operation := OCIOperation(request)
+	return ratelimit.Call(ctx, service, func(ctx context.Context) (Response, error) {
+		ctx, span := tracing.StartOCICall(ctx, service, operation)
+		start := time.Now()
+		response, err := call(ctx, request)
+		ObserveOCICall(request, response, err, time.Since(start))
+		tracing.EndOCICall(span, response, err)
+		return response, err
+	})
+}
+
+// ObserveOCIOperation is ObserveOCICall for callers that do not hold the SDK
+// request, such as work request hooks.
+func ObserveOCIOperation(service string, operation string, response any, err error, duration time.Duration) {
+	statusCode := ociStatusCode(response, err)
+	ociRequestDuration.WithLabelValues(service, operation, statusCode).Observe(duration.Seconds())
+	switch code, _ := strconv.Atoi(statusCode); {
+	case code == 429:
+		ociThrottled.WithLabelValues(service, operation).Inc()
+	case code >= 500:
+		ociServerErrors.WithLabelValues(service, operation, statusCode).Inc()
+	}
+}
+
+// OCIOperation returns the service and operation labels for an OCI SDK
+// request value.
+func OCIOperation(request any) (string, string) {
+	requestType := reflect.TypeOf(request)
+	for requestType != nil && requestType.Kind() == reflect.Pointer {
+		requestType = requestType.Elem()
+	}
+	if requestType == nil || requestType.Name() == "" {
+		return "", ""
+	}
+	return path.Base(requestType.PkgPath()), strings.TrimSuffix(requestType.Name(), "Request")
+}
+
+func ociStatusCode(response any, err error) string {
+	var serviceErr common.ServiceError
+	if errors.As(err, &serviceErr) {
+		return strconv.Itoa(serviceErr.GetHTTPStatusCode())
+	}
+	if ociResponse, ok := response.(common.OCIResponse); ok && !isNilPointer(ociResponse) {
+		if httpResponse := ociResponse.HTTPResponse(); httpResponse != nil {
+			return strconv.Itoa(httpResponse.StatusCode)
+		}
+	}
+	if err != nil {
+		return statusCodeTransportError
+	}
+	return "200"
+}
+
+func isNilPointer(value any) bool {
+	reflected := reflect.ValueOf(value)
+	return reflected.Kind() == reflect.Pointer && reflected.IsNil()
+}
+
+// ObserveAsyncOperation records how long OCI took to finish an asynchronous
+// create, update, or delete.
+func ObserveAsyncOperation(kind string, phase string, outcome string, duration time.Duration) {
+	asyncOperationDuration.WithLabelValues(kind, phase, outcome).Observe(duration.Seconds())
+}
+
+// crConditions tracks the lifecycle condition of every managed CR so that the
+// managed CR gauge is labeled by kind and condition only.
+var crConditions = struct {
+	sync.Mutex
+	byKind map[string]map[string]string
+}{byKind: map[string]map[string]string{}}
+
+// SetCRCondition records that the CR identified by key, usually
+// "namespace/name", is in condition.
+func SetCRCondition(kind string, key string, condition string) {
+	crConditions.Lock()
+	defer crConditions.Unlock()
+
+	conditions := crConditions.byKind[kind]
+	if conditions == nil {
+		conditions = map[string]string{}
+		crConditions.byKind[kind] = conditions
+	}
+	previous, tracked := conditions[key]
+	if tracked && previous == condition {
+		return
+	}
+	if tracked {
+		managedCRs.WithLabelValues(kind, previous).Dec()
+	}
+	conditions[key] = condition
+	managedCRs.WithLabelValues(kind, condition).Inc()
+}
+
+// ForgetCR stops counting the CR identified by key once it is gone.
+func ForgetCR(kind string, key string) {
+	crConditions.Lock()
+	defer crConditions.Unlock()
+
+	previous, tracked := crConditions.byKind[kind][key]
+	if !tracked {
+		return
+	}
+	delete(crConditions.byKind[kind], key)
+	managedCRs.WithLabelValues(kind, previous).Dec()
+}
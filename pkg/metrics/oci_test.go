@@ -0,0 +1,126 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/queue"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestOCIOperationNamesServiceAndOperation(t *testing.T) {
+	t.Parallel()
+
+	service, operation := OCIOperation(&queue.CreateQueueRequest{})
+	if service != "queue" || operation != "CreateQueue" {
+		t.Fatalf("OCIOperation() = %q, %q, want queue, CreateQueue", service, operation)
+	}
+	if service, operation := OCIOperation(nil); service != "" || operation != "" {
+		t.Fatalf("OCIOperation(nil) = %q, %q, want empty labels", service, operation)
+	}
+}
+
+func TestOCIStatusCode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		response any
+		err      error
+		want     string
+	}{
+		{name: "service error", err: newServiceError(429, "TooManyRequests", "slow down"), want: "429"},
+		{name: "response status", response: queue.GetQueueResponse{RawResponse: &http.Response{StatusCode: 204}}, want: "204"},
+		{name: "nil response pointer", response: (*queue.GetQueueResponse)(nil), want: "200"},
+		{name: "transport error", err: errors.New("connection reset"), want: statusCodeTransportError},
+		{name: "no response", want: "200"},
+	}
+	for _, tt := range tests {
+		if got := ociStatusCode(tt.response, tt.err); got != tt.want {
+			t.Errorf("%s: ociStatusCode() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCallOCICountsThrottlingAndServerErrors(t *testing.T) {
+	t.Parallel()
+
+	call := func(err error) func(context.Context, queue.DeleteQueueRequest) (queue.DeleteQueueResponse, error) {
+		return func(context.Context, queue.DeleteQueueRequest) (queue.DeleteQueueResponse, error) {
+			return queue.DeleteQueueResponse{}, err
+		}
+	}
+	throttled := ociThrottled.WithLabelValues("queue", "DeleteQueue")
+	serverErrors := ociServerErrors.WithLabelValues("queue", "DeleteQueue", "503")
+	throttledBefore, serverErrorsBefore := testutil.ToFloat64(throttled), testutil.ToFloat64(serverErrors)
+
+	if _, err := CallOCI(context.Background(), queue.DeleteQueueRequest{}, call(newServiceError(429, "TooManyRequests", "slow down"))); err == nil {
+		t.Fatal("CallOCI() error = nil, want the call's error")
+	}
+	_, _ = CallOCI(context.Background(), queue.DeleteQueueRequest{}, call(newServiceError(503, "ServiceUnavailable", "try later")))
+
+	if got := testutil.ToFloat64(throttled) - throttledBefore; got != 1 {
+		t.Fatalf("throttled delta = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(serverErrors) - serverErrorsBefore; got != 1 {
+		t.Fatalf("server error delta = %v, want 1", got)
+	}
+}
+
+func TestSetCRConditionMovesCRBetweenConditions(t *testing.T) {
+	t.Parallel()
+
+	const kind = "MetricsTestKind"
+	SetCRCondition(kind, "default/a", "Provisioning")
+	SetCRCondition(kind, "default/b", "Provisioning")
+	SetCRCondition(kind, "default/a", "Active")
+	SetCRCondition(kind, "default/a", "Active")
+
+	if got := testutil.ToFloat64(managedCRs.WithLabelValues(kind, "Provisioning")); got != 1 {
+		t.Fatalf("Provisioning CRs = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(managedCRs.WithLabelValues(kind, "Active")); got != 1 {
+		t.Fatalf("Active CRs = %v, want 1", got)
+	}
+
+	ForgetCR(kind, "default/a")
+	ForgetCR(kind, "default/missing")
+	if got := testutil.ToFloat64(managedCRs.WithLabelValues(kind, "Active")); got != 0 {
+		t.Fatalf("Active CRs after ForgetCR = %v, want 0", got)
+	}
+}
+
+func TestObserveAsyncOperationRecordsDuration(t *testing.T) {
+	t.Parallel()
+
+	ObserveAsyncOperation("MetricsTestAsyncKind", "create", "succeeded", 90*time.Second)
+	if got := testutil.CollectAndCount(asyncOperationDuration, AsyncOperationDuration); got == 0 {
+		t.Fatal("async operation duration series = 0, want at least one")
+	}
+}
+
+// serviceError is a minimal common.ServiceError; errortest cannot be used
+// here because it imports this package through servicemanager.
+type serviceError struct {
+	statusCode int
+	code       string
+	message    string
+}
+
+func newServiceError(statusCode int, code string, message string) serviceError {
+	return serviceError{statusCode: statusCode, code: code, message: message}
+}
+
+func (e serviceError) Error() string           { return e.message }
+func (e serviceError) GetHTTPStatusCode() int  { return e.statusCode }
+func (e serviceError) GetMessage() string      { return e.message }
+func (e serviceError) GetCode() string         { return e.code }
+func (e serviceError) GetOpcRequestID() string { return "opc-request-id" }
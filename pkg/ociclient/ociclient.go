@@ -0,0 +1,181 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+// Package ociclient builds every OCI SDK client the operator uses, so that
+// endpoints, trusted CAs, and the HTTP proxy can be set once in the manager
+// config. With no config the SDK defaults are used unchanged.
+package ociclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+)
+
+// clientTimeout matches the SDK's default HTTP client timeout.
+const clientTimeout = 60 * time.Second
+
+// Config overrides how SDK clients reach OCI.
+type Config struct {
+	// Endpoints maps an OCI SDK package name such as "core", or a package
+	// and client type such as "core.ComputeClient", to the endpoint used
+	// instead of the region's, like "https://oci-fake.test:8443". The
+	// client type entry wins.
+	Endpoints map[string]string
+	// CABundle is a PEM file of CAs trusted in addition to the system pool.
+	CABundle string
+	// Proxy is the HTTP proxy URL for OCI calls. Empty uses the
+	// HTTPS_PROXY and NO_PROXY environment variables.
+	Proxy string
+}
+
+// Validate reports a malformed endpoint or proxy URL.
+func (c Config) Validate() error {
+	for service, endpoint := range c.Endpoints {
+		if service == "" {
+			return fmt.Errorf("endpoint override has an empty service name")
+		}
+		if err := validateURL(endpoint); err != nil {
+			return fmt.Errorf("endpoint override for %s: %w", service, err)
+		}
+	}
+	if c.Proxy != "" {
+		if err := validateURL(c.Proxy); err != nil {
+			return fmt.Errorf("proxy: %w", err)
+		}
+	}
+	return nil
+}
+
+func validateURL(value string) error {
+	parsed, err := url.Parse(value)
+	if err != nil {
+		return err
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" || parsed.Host == "" {
+		return fmt.Errorf("%q is not an http or https URL", value)
+	}
+	return nil
+}
+
+var shared struct {
+	sync.RWMutex
+	endpoints  map[string]string
+	httpClient *http.Client
+}
+
+// Configure applies cfg to every SDK client built afterwards. It reads the
+// CA bundle once, so a missing or unreadable bundle fails here rather than
+// on the first OCI call.
+func Configure(cfg Config) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	httpClient, err := newHTTPClient(cfg)
+	if err != nil {
+		return err
+	}
+
+	shared.Lock()
+	defer shared.Unlock()
+	shared.endpoints = make(map[string]string, len(cfg.Endpoints))
+	for service, endpoint := range cfg.Endpoints {
+		shared.endpoints[service] = strings.TrimSuffix(endpoint, "/")
+	}
+	shared.httpClient = httpClient
+	return nil
+}
+
+// newHTTPClient returns nil when cfg keeps the SDK's own HTTP client.
+func newHTTPClient(cfg Config) (*http.Client, error) {
+	if cfg.CABundle == "" && cfg.Proxy == "" {
+		return nil, nil
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if cfg.Proxy != "" {
+		proxyURL, err := url.Parse(cfg.Proxy)
+		if err != nil {
+			return nil, fmt.Errorf("proxy: %w", err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+	if cfg.CABundle != "" {
+		pem, err := os.ReadFile(cfg.CABundle)
+		if err != nil {
+			return nil, fmt.Errorf("read CA bundle: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("CA bundle %s contains no PEM certificates", cfg.CABundle)
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+	return &http.Client{Timeout: clientTimeout, Transport: transport}, nil
+}
+
+// New builds an SDK client with its WithConfigurationProvider constructor
+// and applies the configured endpoint, CA bundle, and proxy:
+//
+//	client, err := ociclient.New(provider, queuesdk.NewQueueAdminClientWithConfigurationProvider)
+func New[Client any](provider common.ConfigurationProvider, constructor func(common.ConfigurationProvider) (Client, error)) (Client, error) {
+	client, err := constructor(provider)
+	if err != nil {
+		return client, err
+	}
+	if base := baseClient(&client); base != nil {
+		apply(base, reflect.TypeOf(client))
+	}
+	return client, nil
+}
+
+// baseClient returns the common.BaseClient embedded in an SDK client.
+func baseClient(client any) *common.BaseClient {
+	value := reflect.ValueOf(client)
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil
+	}
+	field := value.FieldByName("BaseClient")
+	if !field.IsValid() || !field.CanAddr() {
+		return nil
+	}
+	base, _ := field.Addr().Interface().(*common.BaseClient)
+	return base
+}
+
+func apply(base *common.BaseClient, clientType reflect.Type) {
+	shared.RLock()
+	defer shared.RUnlock()
+	for clientType.Kind() == reflect.Pointer {
+		clientType = clientType.Elem()
+	}
+	service := path.Base(clientType.PkgPath())
+	if endpoint, ok := shared.endpoints[service+"."+clientType.Name()]; ok {
+		base.Host = endpoint
+	} else if endpoint, ok := shared.endpoints[service]; ok {
+		base.Host = endpoint
+	}
+	if shared.httpClient != nil {
+		base.HTTPClient = shared.httpClient
+	}
+}
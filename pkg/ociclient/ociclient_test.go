@@ -0,0 +1,138 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package ociclient
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/identity"
+)
+
+func testProvider(t *testing.T) common.ConfigurationProvider {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	return common.NewRawConfigurationProvider("ocid1.tenancy.oc1..test", "ocid1.user.oc1..test", "us-ashburn-1",
+		"aa:bb:cc", string(privateKey), nil)
+}
+
+func resetConfig(t *testing.T) {
+	t.Helper()
+	t.Cleanup(func() {
+		if err := Configure(Config{}); err != nil {
+			t.Fatalf("Configure() reset error = %v", err)
+		}
+	})
+}
+
+func TestNewSendsCallsToTheEndpointOverride(t *testing.T) {
+	resetConfig(t)
+
+	var requestPath string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestPath = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"name":"AD-1"}]`))
+	}))
+	defer server.Close()
+
+	caBundle := filepath.Join(t.TempDir(), "ca.pem")
+	certificate := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caBundle, certificate, 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if err := Configure(Config{Endpoints: map[string]string{"identity": server.URL + "/"}, CABundle: caBundle}); err != nil {
+		t.Fatalf("Configure() error = %v", err)
+	}
+
+	client, err := New(testProvider(t), identity.NewIdentityClientWithConfigurationProvider)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if client.Host != server.URL {
+		t.Fatalf("client.Host = %q, want %q", client.Host, server.URL)
+	}
+	tenancy := "ocid1.tenancy.oc1..test"
+	response, err := client.ListAvailabilityDomains(context.Background(), identity.ListAvailabilityDomainsRequest{CompartmentId: &tenancy})
+	if err != nil {
+		t.Fatalf("ListAvailabilityDomains() error = %v", err)
+	}
+	if len(response.Items) != 1 || *response.Items[0].Name != "AD-1" {
+		t.Fatalf("ListAvailabilityDomains() items = %#v, want AD-1", response.Items)
+	}
+	if requestPath != "/20160918/availabilityDomains" {
+		t.Fatalf("request path = %q, want the availability domains path", requestPath)
+	}
+}
+
+func TestNewPrefersTheClientTypeEndpoint(t *testing.T) {
+	resetConfig(t)
+
+	if err := Configure(Config{Endpoints: map[string]string{
+		"identity":                "https://identity.test",
+		"identity.IdentityClient": "https://identity-client.test",
+	}}); err != nil {
+		t.Fatalf("Configure() error = %v", err)
+	}
+	client, err := New(testProvider(t), identity.NewIdentityClientWithConfigurationProvider)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if client.Host != "https://identity-client.test" {
+		t.Fatalf("client.Host = %q, want the IdentityClient override", client.Host)
+	}
+}
+
+func TestNewKeepsSDKDefaultsWithoutConfig(t *testing.T) {
+	resetConfig(t)
+
+	client, err := New(testProvider(t), identity.NewIdentityClientWithConfigurationProvider)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if !strings.Contains(client.Host, "us-ashburn-1") {
+		t.Fatalf("client.Host = %q, want the region endpoint", client.Host)
+	}
+	if _, ok := client.HTTPClient.(*http.Client); !ok || client.HTTPClient.(*http.Client).Transport == nil {
+		t.Fatalf("client.HTTPClient = %#v, want the SDK dispatcher", client.HTTPClient)
+	}
+}
+
+func TestConfigureRejectsInvalidSettings(t *testing.T) {
+	resetConfig(t)
+
+	tests := []struct {
+		name string
+		cfg  Config
+		want string
+	}{
+		{name: "endpoint without scheme", cfg: Config{Endpoints: map[string]string{"core": "iaas.test"}}, want: "endpoint override for core"},
+		{name: "proxy without host", cfg: Config{Proxy: "http://"}, want: "proxy"},
+		{name: "missing CA bundle", cfg: Config{CABundle: filepath.Join(t.TempDir(), "missing.pem")}, want: "read CA bundle"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Configure(tt.cfg); err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("Configure() error = %v, want it to mention %q", err, tt.want)
+			}
+		})
+	}
+}
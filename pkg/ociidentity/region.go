@@ -0,0 +1,56 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package ociidentity
+
+import (
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oracle/oci-service-operator/pkg/shared"
+)
+
+// RegionAnnotation selects the OCI region a resource is created in. Without it
+// the region of the resource's identity applies.
+const RegionAnnotation = "oci.oracle.com/region"
+
+// regionalProvider keeps the credentials of the wrapped provider and only
+// changes the region, and so the endpoint, that SDK clients call.
+type regionalProvider struct {
+	common.ConfigurationProvider
+	region string
+}
+
+func (p regionalProvider) Region() (string, error) {
+	return p.region, nil
+}
+
+func withRegion(provider common.ConfigurationProvider, region string) common.ConfigurationProvider {
+	if region == "" || provider == nil {
+		return provider
+	}
+	return regionalProvider{ConfigurationProvider: provider, region: region}
+}
+
+// requestedRegion returns the normalized region named by RegionAnnotation. Short
+// names such as "iad" resolve to their full region name.
+func requestedRegion(obj client.Object) string {
+	region := strings.TrimSpace(obj.GetAnnotations()[RegionAnnotation])
+	if region == "" {
+		return ""
+	}
+	return string(common.StringToRegion(region))
+}
+
+// recordedRegion returns the region recorded for a resource that already has
+// an OCID.
+func recordedRegion(status *shared.OSOKStatus) string {
+	if status == nil || strings.TrimSpace(string(status.Ocid)) == "" {
+		return ""
+	}
+	return strings.TrimSpace(status.Region)
+}
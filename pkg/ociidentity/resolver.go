@@ -0,0 +1,244 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+// Package ociidentity selects the OCIIdentity or ClusterOCIIdentity and the OCI
+// region that apply to an OSOK resource and builds the OCI configuration
+// provider for them.
+package ociidentity
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/oci/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/authhelper"
+	"github.com/oracle/oci-service-operator/pkg/config"
+)
+
+const (
+	// IdentityAnnotation names the OCIIdentity, in the resource's namespace,
+	// whose credentials OSOK uses for the resource.
+	IdentityAnnotation = "oci.oracle.com/identity"
+	// ClusterIdentityAnnotation names the ClusterOCIIdentity whose credentials
+	// OSOK uses for the resource.
+	ClusterIdentityAnnotation = "oci.oracle.com/cluster-identity"
+	// DefaultIdentityName is the OCIIdentity that applies to every resource in
+	// its namespace that does not select an identity.
+	DefaultIdentityName = "default"
+)
+
+// Identity is the identity selected for one resource. The zero Identity means
+// the operator-wide provider applies.
+type Identity struct {
+	// Key names the identity, for example "OCIIdentity/team-a/default".
+	Key string
+	// Fingerprint changes whenever the identity spec or a Secret it reads
+	// changes.
+	Fingerprint string
+	Provider    common.ConfigurationProvider
+}
+
+// IsDefault reports whether the operator-wide provider applies.
+func (i Identity) IsDefault() bool {
+	return i.Key == ""
+}
+
+// Resolver resolves identities and caches one provider per identity. A cached
+// provider is rebuilt when its identity or one of its Secrets changes, so
+// rotated credentials take effect on the next reconcile.
+type Resolver struct {
+	// Client reads OCIIdentity and ClusterOCIIdentity resources.
+	Client client.Reader
+	// SecretReader reads the Secrets identities reference. Client is used when
+	// it is nil.
+	SecretReader client.Reader
+	// AuthProvider builds a provider from the auth settings of an identity.
+	AuthProvider authhelper.AuthProvider
+
+	mu        sync.Mutex
+	providers map[string]cachedProvider
+}
+
+type cachedProvider struct {
+	fingerprint string
+	provider    common.ConfigurationProvider
+}
+
+type identityRef struct {
+	key  string
+	spec ociv1beta1.OCIIdentitySpec
+	// namespace is where referenced Secrets live. It is empty for a
+	// ClusterOCIIdentity, whose selectors carry their own namespace.
+	namespace string
+}
+
+// Resolve returns the identity that applies to obj. Resources select an
+// identity with IdentityAnnotation or ClusterIdentityAnnotation; otherwise an
+// OCIIdentity named DefaultIdentityName in the resource's namespace applies
+// when it exists.
+func (r *Resolver) Resolve(ctx context.Context, obj client.Object) (Identity, error) {
+	ref, err := r.lookup(ctx, obj)
+	if err != nil || ref.key == "" {
+		return Identity{}, err
+	}
+
+	authCfg, err := r.authConfig(ctx, ref)
+	if err != nil {
+		return Identity{}, fmt.Errorf("%s: %w", ref.key, err)
+	}
+	fingerprint, err := fingerprintOf(authCfg)
+	if err != nil {
+		return Identity{}, fmt.Errorf("%s: %w", ref.key, err)
+	}
+
+	r.mu.Lock()
+	cached, ok := r.providers[ref.key]
+	r.mu.Unlock()
+	if ok && cached.fingerprint == fingerprint {
+		return Identity{Key: ref.key, Fingerprint: fingerprint, Provider: cached.provider}, nil
+	}
+
+	provider, err := r.AuthProvider.GetAuthProvider(config.NewOsokConfig(authCfg))
+	if err != nil {
+		return Identity{}, fmt.Errorf("build OCI provider for %s: %w", ref.key, err)
+	}
+
+	r.mu.Lock()
+	if r.providers == nil {
+		r.providers = map[string]cachedProvider{}
+	}
+	r.providers[ref.key] = cachedProvider{fingerprint: fingerprint, provider: provider}
+	r.mu.Unlock()
+
+	return Identity{Key: ref.key, Fingerprint: fingerprint, Provider: provider}, nil
+}
+
+func (r *Resolver) lookup(ctx context.Context, obj client.Object) (identityRef, error) {
+	annotations := obj.GetAnnotations()
+	name := strings.TrimSpace(annotations[IdentityAnnotation])
+	clusterName := strings.TrimSpace(annotations[ClusterIdentityAnnotation])
+	namespace := obj.GetNamespace()
+
+	switch {
+	case name != "" && clusterName != "":
+		return identityRef{}, fmt.Errorf("annotations %s and %s cannot both be set", IdentityAnnotation, ClusterIdentityAnnotation)
+	case clusterName != "":
+		identity := &ociv1beta1.ClusterOCIIdentity{}
+		if err := r.Client.Get(ctx, types.NamespacedName{Name: clusterName}, identity); err != nil {
+			return identityRef{}, fmt.Errorf("get ClusterOCIIdentity %s: %w", clusterName, err)
+		}
+		return identityRef{key: "ClusterOCIIdentity/" + clusterName, spec: identity.Spec}, nil
+	case name != "":
+		identity := &ociv1beta1.OCIIdentity{}
+		if err := r.Client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, identity); err != nil {
+			return identityRef{}, fmt.Errorf("get OCIIdentity %s/%s: %w", namespace, name, err)
+		}
+		return identityRef{key: "OCIIdentity/" + namespace + "/" + name, spec: identity.Spec, namespace: namespace}, nil
+	}
+
+	if namespace == "" {
+		return identityRef{}, nil
+	}
+	identity := &ociv1beta1.OCIIdentity{}
+	err := r.Client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: DefaultIdentityName}, identity)
+	switch {
+	case err == nil:
+		return identityRef{key: "OCIIdentity/" + namespace + "/" + DefaultIdentityName, spec: identity.Spec, namespace: namespace}, nil
+	case apierrors.IsNotFound(err), meta.IsNoMatchError(err):
+		return identityRef{}, nil
+	default:
+		return identityRef{}, fmt.Errorf("get OCIIdentity %s/%s: %w", namespace, DefaultIdentityName, err)
+	}
+}
+
+func (r *Resolver) authConfig(ctx context.Context, ref identityRef) (config.UserAuthConfig, error) {
+	spec := ref.spec
+	authCfg := config.UserAuthConfig{
+		AuthType:                             spec.AuthType,
+		Region:                               spec.Region,
+		Tenancy:                              spec.Tenancy,
+		User:                                 spec.User,
+		Fingerprint:                          spec.Fingerprint,
+		ConfigFilePath:                       spec.ConfigFilePath,
+		ConfigFileProfile:                    spec.ConfigFileProfile,
+		InstancePrincipalLeafCertificatePath: spec.InstancePrincipalLeafCertificatePath,
+		InstancePrincipalLeafPrivateKeyPath:  spec.InstancePrincipalLeafPrivateKeyPath,
+		InstancePrincipalIntermediateCertPathList: strings.Join(spec.InstancePrincipalIntermediateCertificatePaths, ","),
+	}
+
+	privateKey, err := r.secretValue(ctx, spec.PrivateKey, ref.namespace)
+	if err != nil {
+		return authCfg, err
+	}
+	authCfg.PrivateKey = privateKey
+
+	passphrase, err := r.secretValue(ctx, spec.Passphrase, ref.namespace)
+	if err != nil {
+		return authCfg, err
+	}
+	delegationToken, err := r.secretValue(ctx, spec.DelegationToken, ref.namespace)
+	if err != nil {
+		return authCfg, err
+	}
+
+	switch authCfg.EffectiveAuthType() {
+	case config.AuthTypeInstancePrincipalWithCerts:
+		authCfg.InstancePrincipalLeafPrivateKeyPassphrase = passphrase
+	case config.AuthTypeInstancePrincipalDelegationToken:
+		authCfg.InstancePrincipalDelegationToken = delegationToken
+	case config.AuthTypeResourcePrincipalDelegationToken:
+		authCfg.ResourcePrincipalDelegationToken = delegationToken
+	default:
+		authCfg.Passphrase = passphrase
+	}
+	return authCfg, nil
+}
+
+func (r *Resolver) secretValue(ctx context.Context, selector *ociv1beta1.SecretKeySelector, namespace string) (string, error) {
+	if selector == nil {
+		return "", nil
+	}
+	if namespace == "" {
+		namespace = strings.TrimSpace(selector.Namespace)
+	}
+	if namespace == "" {
+		return "", fmt.Errorf("secret %q must set namespace when referenced from a ClusterOCIIdentity", selector.Name)
+	}
+
+	reader := r.SecretReader
+	if reader == nil {
+		reader = r.Client
+	}
+	secret := &corev1.Secret{}
+	if err := reader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: selector.Name}, secret); err != nil {
+		return "", fmt.Errorf("get secret %s/%s: %w", namespace, selector.Name, err)
+	}
+	value, ok := secret.Data[selector.Key]
+	if !ok {
+		return "", fmt.Errorf("secret %s/%s has no key %q", namespace, selector.Name, selector.Key)
+	}
+	return string(value), nil
+}
+
+func fingerprintOf(authCfg config.UserAuthConfig) (string, error) {
+	payload, err := json.Marshal(authCfg)
+	if err != nil {
+		return "", fmt.Errorf("fingerprint auth config: %w", err)
+	}
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:]), nil
+}
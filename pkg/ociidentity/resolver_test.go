@@ -0,0 +1,219 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package ociidentity
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlclientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/oci/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/config"
+)
+
+type recordingAuthProvider struct {
+	configs []config.UserAuthConfig
+}
+
+func (p *recordingAuthProvider) GetAuthProvider(cfg config.OsokConfig) (common.ConfigurationProvider, error) {
+	auth := cfg.Auth()
+	p.configs = append(p.configs, auth)
+	return common.NewRawConfigurationProvider(auth.Tenancy, auth.User, auth.Region, auth.Fingerprint, auth.PrivateKey, nil), nil
+}
+
+func newTestClient(t *testing.T, objects ...client.Object) client.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("add client-go scheme: %v", err)
+	}
+	if err := ociv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add oci scheme: %v", err)
+	}
+	return ctrlclientfake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+}
+
+func userPrincipalIdentity(namespace string, name string, tenancy string) *ociv1beta1.OCIIdentity {
+	return &ociv1beta1.OCIIdentity{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: ociv1beta1.OCIIdentitySpec{
+			AuthType:    config.AuthTypeUserPrincipal,
+			Region:      "us-ashburn-1",
+			Tenancy:     tenancy,
+			User:        "ocid1.user.oc1..team",
+			Fingerprint: "aa:bb",
+			PrivateKey:  &ociv1beta1.SecretKeySelector{Name: "oci-key", Key: "key.pem"},
+		},
+	}
+}
+
+func keySecret(namespace string, value string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "oci-key"},
+		Data:       map[string][]byte{"key.pem": []byte(value)},
+	}
+}
+
+func resourceIn(namespace string, annotations map[string]string) client.Object {
+	return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "resource", Annotations: annotations}}
+}
+
+func TestResolveUsesOperatorDefaultWithoutIdentity(t *testing.T) {
+	authProvider := &recordingAuthProvider{}
+	resolver := &Resolver{Client: newTestClient(t), AuthProvider: authProvider}
+
+	identity, err := resolver.Resolve(context.Background(), resourceIn("team-a", nil))
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if !identity.IsDefault() {
+		t.Fatalf("Resolve() = %q, want the operator default", identity.Key)
+	}
+	if len(authProvider.configs) != 0 {
+		t.Fatalf("GetAuthProvider() calls = %d, want 0", len(authProvider.configs))
+	}
+}
+
+func TestResolveUsesNamespaceDefaultIdentity(t *testing.T) {
+	authProvider := &recordingAuthProvider{}
+	resolver := &Resolver{
+		Client:       newTestClient(t, userPrincipalIdentity("team-a", DefaultIdentityName, "ocid1.tenancy.oc1..a"), keySecret("team-a", "PEM-A")),
+		AuthProvider: authProvider,
+	}
+
+	identity, err := resolver.Resolve(context.Background(), resourceIn("team-a", nil))
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if identity.Key != "OCIIdentity/team-a/default" {
+		t.Fatalf("Resolve() key = %q, want OCIIdentity/team-a/default", identity.Key)
+	}
+	tenancy, err := identity.Provider.TenancyOCID()
+	if err != nil || tenancy != "ocid1.tenancy.oc1..a" {
+		t.Fatalf("provider tenancy = %q, %v, want ocid1.tenancy.oc1..a", tenancy, err)
+	}
+	if got := authProvider.configs[0].PrivateKey; got != "PEM-A" {
+		t.Fatalf("auth config private key = %q, want PEM-A", got)
+	}
+
+	other, err := resolver.Resolve(context.Background(), resourceIn("team-b", nil))
+	if err != nil {
+		t.Fatalf("Resolve() in another namespace error = %v", err)
+	}
+	if !other.IsDefault() {
+		t.Fatalf("Resolve() in another namespace = %q, want the operator default", other.Key)
+	}
+}
+
+func TestResolveSelectsAnnotatedIdentity(t *testing.T) {
+	resolver := &Resolver{
+		Client: newTestClient(t,
+			userPrincipalIdentity("team-a", DefaultIdentityName, "ocid1.tenancy.oc1..a"),
+			userPrincipalIdentity("team-a", "prod", "ocid1.tenancy.oc1..prod"),
+			keySecret("team-a", "PEM-A"),
+		),
+		AuthProvider: &recordingAuthProvider{},
+	}
+
+	identity, err := resolver.Resolve(context.Background(), resourceIn("team-a", map[string]string{IdentityAnnotation: "prod"}))
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if identity.Key != "OCIIdentity/team-a/prod" {
+		t.Fatalf("Resolve() key = %q, want OCIIdentity/team-a/prod", identity.Key)
+	}
+}
+
+func TestResolveReadsClusterIdentitySecretsFromSelectorNamespace(t *testing.T) {
+	authProvider := &recordingAuthProvider{}
+	clusterIdentity := &ociv1beta1.ClusterOCIIdentity{
+		ObjectMeta: metav1.ObjectMeta{Name: "shared"},
+		Spec: ociv1beta1.OCIIdentitySpec{
+			AuthType:        config.AuthTypeInstancePrincipalDelegationToken,
+			Region:          "us-phoenix-1",
+			DelegationToken: &ociv1beta1.SecretKeySelector{Name: "oci-key", Key: "key.pem", Namespace: "platform"},
+		},
+	}
+	resolver := &Resolver{
+		Client:       newTestClient(t, clusterIdentity, keySecret("platform", "TOKEN")),
+		AuthProvider: authProvider,
+	}
+
+	identity, err := resolver.Resolve(context.Background(), resourceIn("team-a", map[string]string{ClusterIdentityAnnotation: "shared"}))
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if identity.Key != "ClusterOCIIdentity/shared" {
+		t.Fatalf("Resolve() key = %q, want ClusterOCIIdentity/shared", identity.Key)
+	}
+	if got := authProvider.configs[0].InstancePrincipalDelegationToken; got != "TOKEN" {
+		t.Fatalf("auth config delegation token = %q, want TOKEN", got)
+	}
+}
+
+func TestResolveRejectsBothIdentityAnnotations(t *testing.T) {
+	resolver := &Resolver{Client: newTestClient(t), AuthProvider: &recordingAuthProvider{}}
+
+	_, err := resolver.Resolve(context.Background(), resourceIn("team-a", map[string]string{
+		IdentityAnnotation:        "prod",
+		ClusterIdentityAnnotation: "shared",
+	}))
+	if err == nil || !strings.Contains(err.Error(), "cannot both be set") {
+		t.Fatalf("Resolve() error = %v, want a conflicting annotations error", err)
+	}
+}
+
+func TestResolveFailsWhenAnnotatedIdentityIsMissing(t *testing.T) {
+	resolver := &Resolver{Client: newTestClient(t), AuthProvider: &recordingAuthProvider{}}
+
+	_, err := resolver.Resolve(context.Background(), resourceIn("team-a", map[string]string{IdentityAnnotation: "prod"}))
+	if err == nil || !strings.Contains(err.Error(), "get OCIIdentity team-a/prod") {
+		t.Fatalf("Resolve() error = %v, want a missing OCIIdentity error", err)
+	}
+}
+
+func TestResolveCachesProviderUntilSecretRotates(t *testing.T) {
+	authProvider := &recordingAuthProvider{}
+	secret := keySecret("team-a", "PEM-A")
+	kubeClient := newTestClient(t, userPrincipalIdentity("team-a", DefaultIdentityName, "ocid1.tenancy.oc1..a"), secret)
+	resolver := &Resolver{Client: kubeClient, AuthProvider: authProvider}
+
+	first, err := resolver.Resolve(context.Background(), resourceIn("team-a", nil))
+	if err != nil {
+		t.Fatalf("first Resolve() error = %v", err)
+	}
+	second, err := resolver.Resolve(context.Background(), resourceIn("team-a", nil))
+	if err != nil {
+		t.Fatalf("second Resolve() error = %v", err)
+	}
+	if len(authProvider.configs) != 1 || first.Provider != second.Provider {
+		t.Fatalf("GetAuthProvider() calls = %d, want one cached provider", len(authProvider.configs))
+	}
+
+	secret.Data["key.pem"] = []byte("PEM-B")
+	if err := kubeClient.Update(context.Background(), secret); err != nil {
+		t.Fatalf("rotate secret: %v", err)
+	}
+	rotated, err := resolver.Resolve(context.Background(), resourceIn("team-a", nil))
+	if err != nil {
+		t.Fatalf("rotated Resolve() error = %v", err)
+	}
+	if len(authProvider.configs) != 2 || rotated.Fingerprint == first.Fingerprint {
+		t.Fatalf("GetAuthProvider() calls = %d, want the provider rebuilt after rotation", len(authProvider.configs))
+	}
+	if got := authProvider.configs[1].PrivateKey; got != "PEM-B" {
+		t.Fatalf("rotated private key = %q, want PEM-B", got)
+	}
+}
@@ -0,0 +1,192 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package ociidentity
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	"github.com/oracle/oci-service-operator/pkg/shared"
+	"github.com/oracle/oci-service-operator/pkg/util"
+)
+
+// ServiceManager routes each resource to a service manager built with the OCI
+// provider of the resource's identity and region. Resources without an
+// identity or region use the manager built with the operator-wide provider.
+type ServiceManager struct {
+	factory        servicemanager.Factory
+	deps           servicemanager.RuntimeDeps
+	resolver       *Resolver
+	defaultManager servicemanager.OSOKServiceManager
+
+	mu       sync.Mutex
+	managers map[string]cachedManager
+}
+
+type cachedManager struct {
+	fingerprint string
+	manager     servicemanager.OSOKServiceManager
+}
+
+// target is the manager chosen for one resource and the provider it was built
+// with.
+type target struct {
+	manager  servicemanager.OSOKServiceManager
+	provider common.ConfigurationProvider
+}
+
+var (
+	_ servicemanager.OSOKServiceManager       = &ServiceManager{}
+	_ servicemanager.OSOKDeleteResultProvider = &ServiceManager{}
+	_ servicemanager.OSOKOrphanCleaner        = &ServiceManager{}
+)
+
+// NewServiceManager wraps factory so that each resource gets a manager built
+// with its identity's provider and region. When resolver is nil every resource
+// uses the operator-wide credentials and only the region is routed.
+func NewServiceManager(
+	factory servicemanager.Factory,
+	deps servicemanager.RuntimeDeps,
+	resolver *Resolver,
+) servicemanager.OSOKServiceManager {
+	return &ServiceManager{
+		factory:        factory,
+		deps:           deps,
+		resolver:       resolver,
+		defaultManager: factory(deps),
+		managers:       map[string]cachedManager{},
+	}
+}
+
+func (m *ServiceManager) CreateOrUpdate(ctx context.Context, obj runtime.Object, req ctrl.Request) (servicemanager.OSOKResponse, error) {
+	target, err := m.targetFor(ctx, obj, true)
+	if err != nil {
+		m.markFailure(obj, err)
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+	response, err := target.manager.CreateOrUpdate(ctx, obj, req)
+	m.recordRegion(obj, target)
+	return response, err
+}
+
+func (m *ServiceManager) Delete(ctx context.Context, obj runtime.Object) (bool, error) {
+	target, err := m.targetFor(ctx, obj, false)
+	if err != nil {
+		return false, err
+	}
+	return target.manager.Delete(ctx, obj)
+}
+
+func (m *ServiceManager) DeleteWithResult(ctx context.Context, obj runtime.Object) (servicemanager.OSOKDeleteResult, error) {
+	target, err := m.targetFor(ctx, obj, false)
+	if err != nil {
+		return servicemanager.OSOKDeleteResult{}, err
+	}
+	if provider, ok := target.manager.(servicemanager.OSOKDeleteResultProvider); ok {
+		return provider.DeleteWithResult(ctx, obj)
+	}
+	deleted, err := target.manager.Delete(ctx, obj)
+	return servicemanager.OSOKDeleteResult{Deleted: deleted}, err
+}
+
+func (m *ServiceManager) CleanupOrphaned(ctx context.Context, obj runtime.Object) error {
+	target, err := m.targetFor(ctx, obj, false)
+	if err != nil {
+		return err
+	}
+	if cleaner, ok := target.manager.(servicemanager.OSOKOrphanCleaner); ok {
+		return cleaner.CleanupOrphaned(ctx, obj)
+	}
+	return nil
+}
+
+func (m *ServiceManager) GetCrdStatus(obj runtime.Object) (*shared.OSOKStatus, error) {
+	return m.defaultManager.GetCrdStatus(obj)
+}
+
+// targetFor picks the manager for obj. A resource that already has an OCID
+// keeps calling the region recorded in its status; when strict is set, a
+// region annotation that names another region is an error.
+func (m *ServiceManager) targetFor(ctx context.Context, obj runtime.Object, strict bool) (target, error) {
+	defaultTarget := target{manager: m.defaultManager, provider: m.deps.Provider}
+	object, ok := obj.(client.Object)
+	if !ok {
+		return defaultTarget, nil
+	}
+
+	identity := Identity{}
+	if m.resolver != nil {
+		var err error
+		if identity, err = m.resolver.Resolve(ctx, object); err != nil {
+			return target{}, err
+		}
+	}
+
+	status, _ := m.defaultManager.GetCrdStatus(obj)
+	region := requestedRegion(object)
+	if recorded := recordedRegion(status); recorded != "" {
+		if strict && region != "" && region != recorded {
+			return target{}, fmt.Errorf("annotation %s is %q but the resource lives in region %q; OSOK does not move resources between regions",
+				RegionAnnotation, region, recorded)
+		}
+		region = recorded
+	}
+
+	if identity.IsDefault() && region == "" {
+		return defaultTarget, nil
+	}
+
+	provider := m.deps.Provider
+	if !identity.IsDefault() {
+		provider = identity.Provider
+	}
+	provider = withRegion(provider, region)
+
+	key := identity.Key + "@" + region
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if cached, ok := m.managers[key]; ok && cached.fingerprint == identity.Fingerprint {
+		return target{manager: cached.manager, provider: provider}, nil
+	}
+	deps := m.deps
+	deps.Provider = provider
+	manager := m.factory(deps)
+	m.managers[key] = cachedManager{fingerprint: identity.Fingerprint, manager: manager}
+	return target{manager: manager, provider: provider}, nil
+}
+
+// recordRegion stores the region of the provider that created the resource,
+// so later calls keep going to that region.
+func (m *ServiceManager) recordRegion(obj runtime.Object, target target) {
+	status, err := m.defaultManager.GetCrdStatus(obj)
+	if err != nil || status == nil || status.Ocid == "" || status.Region != "" || target.provider == nil {
+		return
+	}
+	if region, err := target.provider.Region(); err == nil {
+		status.Region = region
+	}
+}
+
+func (m *ServiceManager) markFailure(obj runtime.Object, err error) {
+	status, statusErr := m.defaultManager.GetCrdStatus(obj)
+	if statusErr != nil || status == nil {
+		return
+	}
+	now := metav1.Now()
+	status.Message = err.Error()
+	status.Reason = string(shared.Failed)
+	status.UpdatedAt = &now
+	*status = util.UpdateOSOKStatusCondition(*status, shared.Failed, v1.ConditionFalse, "", err.Error(), m.deps.Log)
+}
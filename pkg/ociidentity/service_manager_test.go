@@ -0,0 +1,177 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package ociidentity
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	"github.com/oracle/oci-service-operator/pkg/shared"
+)
+
+// providerServiceManager records the provider it was built with. Its resource
+// is given an OCID on the first CreateOrUpdate.
+type providerServiceManager struct {
+	provider common.ConfigurationProvider
+	status   *shared.OSOKStatus
+	calls    int
+}
+
+func (m *providerServiceManager) CreateOrUpdate(context.Context, runtime.Object, ctrl.Request) (servicemanager.OSOKResponse, error) {
+	m.calls++
+	if m.status != nil && m.status.Ocid == "" {
+		m.status.Ocid = "ocid1.queue.oc1..example"
+	}
+	return servicemanager.OSOKResponse{IsSuccessful: true}, nil
+}
+
+func (m *providerServiceManager) Delete(context.Context, runtime.Object) (bool, error) {
+	m.calls++
+	return true, nil
+}
+
+func (m *providerServiceManager) GetCrdStatus(runtime.Object) (*shared.OSOKStatus, error) {
+	if m.status == nil {
+		return &shared.OSOKStatus{}, nil
+	}
+	return m.status, nil
+}
+
+func TestServiceManagerRoutesByIdentity(t *testing.T) {
+	defaultProvider := common.NewRawConfigurationProvider("ocid1.tenancy.oc1..operator", "", "", "", "", nil)
+	var built []*providerServiceManager
+	factory := func(deps servicemanager.RuntimeDeps) servicemanager.OSOKServiceManager {
+		manager := &providerServiceManager{provider: deps.Provider}
+		built = append(built, manager)
+		return manager
+	}
+	resolver := &Resolver{
+		Client: newTestClient(t,
+			userPrincipalIdentity("team-a", DefaultIdentityName, "ocid1.tenancy.oc1..a"),
+			keySecret("team-a", "PEM-A"),
+		),
+		AuthProvider: &recordingAuthProvider{},
+	}
+	manager := NewServiceManager(factory, servicemanager.RuntimeDeps{Provider: defaultProvider}, resolver)
+
+	for i := 0; i < 2; i++ {
+		if _, err := manager.CreateOrUpdate(context.Background(), resourceIn("team-a", nil), ctrl.Request{}); err != nil {
+			t.Fatalf("CreateOrUpdate() error = %v", err)
+		}
+	}
+	if _, err := manager.Delete(context.Background(), resourceIn("team-b", nil)); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	if len(built) != 2 {
+		t.Fatalf("factory calls = %d, want the default manager and one identity manager", len(built))
+	}
+	if built[0].provider != defaultProvider || built[0].calls != 1 {
+		t.Fatalf("default manager calls = %d, want 1 with the operator provider", built[0].calls)
+	}
+	tenancy, err := built[1].provider.TenancyOCID()
+	if err != nil || tenancy != "ocid1.tenancy.oc1..a" {
+		t.Fatalf("identity manager tenancy = %q, %v, want ocid1.tenancy.oc1..a", tenancy, err)
+	}
+	if built[1].calls != 2 {
+		t.Fatalf("identity manager calls = %d, want 2", built[1].calls)
+	}
+}
+
+func TestServiceManagerDeleteWithResultFallsBackToDelete(t *testing.T) {
+	manager := NewServiceManager(
+		func(servicemanager.RuntimeDeps) servicemanager.OSOKServiceManager { return &providerServiceManager{} },
+		servicemanager.RuntimeDeps{},
+		&Resolver{Client: newTestClient(t), AuthProvider: &recordingAuthProvider{}},
+	)
+
+	result, err := manager.(servicemanager.OSOKDeleteResultProvider).DeleteWithResult(context.Background(), resourceIn("team-a", nil))
+	if err != nil {
+		t.Fatalf("DeleteWithResult() error = %v", err)
+	}
+	if !result.Deleted {
+		t.Fatalf("DeleteWithResult() = %#v, want Deleted", result)
+	}
+}
+
+func TestServiceManagerRoutesRegionAnnotationAndRecordsRegion(t *testing.T) {
+	status := &shared.OSOKStatus{}
+	var built []*providerServiceManager
+	manager := NewServiceManager(
+		func(deps servicemanager.RuntimeDeps) servicemanager.OSOKServiceManager {
+			manager := &providerServiceManager{provider: deps.Provider, status: status}
+			built = append(built, manager)
+			return manager
+		},
+		servicemanager.RuntimeDeps{Provider: common.NewRawConfigurationProvider("", "", "us-ashburn-1", "", "", nil)},
+		nil,
+	)
+
+	resource := resourceIn("team-a", map[string]string{RegionAnnotation: "phx"})
+	if _, err := manager.CreateOrUpdate(context.Background(), resource, ctrl.Request{}); err != nil {
+		t.Fatalf("CreateOrUpdate() error = %v", err)
+	}
+	if len(built) != 2 || built[1].calls != 1 {
+		t.Fatalf("factory calls = %d, want the default manager and one regional manager", len(built))
+	}
+	if region, _ := built[1].provider.Region(); region != "us-phoenix-1" {
+		t.Fatalf("regional provider region = %q, want us-phoenix-1", region)
+	}
+	if status.Region != "us-phoenix-1" {
+		t.Fatalf("status.region = %q, want us-phoenix-1", status.Region)
+	}
+
+	resource.SetAnnotations(nil)
+	if _, err := manager.Delete(context.Background(), resource); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if built[1].calls != 2 || built[0].calls != 0 {
+		t.Fatalf("Delete() went to the default manager, want the recorded region")
+	}
+}
+
+func TestServiceManagerRecordsDefaultRegion(t *testing.T) {
+	status := &shared.OSOKStatus{}
+	manager := NewServiceManager(
+		func(deps servicemanager.RuntimeDeps) servicemanager.OSOKServiceManager {
+			return &providerServiceManager{provider: deps.Provider, status: status}
+		},
+		servicemanager.RuntimeDeps{Provider: common.NewRawConfigurationProvider("", "", "us-ashburn-1", "", "", nil)},
+		nil,
+	)
+
+	if _, err := manager.CreateOrUpdate(context.Background(), resourceIn("team-a", nil), ctrl.Request{}); err != nil {
+		t.Fatalf("CreateOrUpdate() error = %v", err)
+	}
+	if status.Region != "us-ashburn-1" {
+		t.Fatalf("status.region = %q, want us-ashburn-1", status.Region)
+	}
+}
+
+func TestServiceManagerRejectsRegionChangeForExistingResource(t *testing.T) {
+	status := &shared.OSOKStatus{Ocid: "ocid1.queue.oc1..example", Region: "us-ashburn-1"}
+	manager := NewServiceManager(
+		func(deps servicemanager.RuntimeDeps) servicemanager.OSOKServiceManager {
+			return &providerServiceManager{provider: deps.Provider, status: status}
+		},
+		servicemanager.RuntimeDeps{},
+		nil,
+	)
+
+	_, err := manager.CreateOrUpdate(context.Background(), resourceIn("team-a", map[string]string{RegionAnnotation: "us-phoenix-1"}), ctrl.Request{})
+	if err == nil || !strings.Contains(err.Error(), "does not move resources between regions") {
+		t.Fatalf("CreateOrUpdate() error = %v, want a region change error", err)
+	}
+	if status.Reason != string(shared.Failed) {
+		t.Fatalf("status.reason = %q, want Failed", status.Reason)
+	}
+}
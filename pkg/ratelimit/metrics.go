@@ -0,0 +1,44 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package ratelimit
+
+import "github.com/prometheus/client_golang/prometheus"
+
+const (
+	RateLimitWait      = "oci_service_operator_oci_rate_limit_wait_seconds"
+	RateLimitRejected  = "oci_service_operator_oci_rate_limit_rejected_total"
+	CircuitBreakerOpen = "oci_service_operator_oci_circuit_breaker_open"
+)
+
+// Reasons a call is rejected before it is sent.
+const (
+	rejectReasonRateLimit   = "rate_limit"
+	rejectReasonRetryAfter  = "retry_after"
+	rejectReasonCircuitOpen = "circuit_open"
+)
+
+var (
+	waitSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    RateLimitWait,
+		Help:    "Time OCI API calls waited for a client-side rate limit token, by service",
+		Buckets: []float64{0, 0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+	}, []string{"service"})
+
+	rejected = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: RateLimitRejected,
+		Help: "Total Number of OCI API calls rejected on the client by service and reason",
+	}, []string{"service", "reason"})
+
+	circuitOpen = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: CircuitBreakerOpen,
+		Help: "Whether the circuit breaker for an OCI service is open (1) or not (0)",
+	}, []string{"service"})
+)
+
+// Collectors returns the limiter's Prometheus collectors for registration.
+func Collectors() []prometheus.Collector {
+	return []prometheus.Collector{waitSeconds, rejected, circuitOpen}
+}
@@ -0,0 +1,354 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+// Package ratelimit throttles OCI API calls on the client side. Every call
+// for one OCI service shares a token bucket and a circuit breaker, and a 429
+// pauses the service for its Retry-After before any further call is sent.
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/sony/gobreaker"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// maxWait is the longest a call blocks for a token or a Retry-After
+	// pause. Longer waits fail the call so the reconcile is requeued instead
+	// of holding a worker.
+	maxWait = 10 * time.Second
+	// defaultRetryAfter pauses a service after a 429 without Retry-After.
+	defaultRetryAfter = time.Second
+	// defaultOpenTimeout is how long an open circuit rejects calls.
+	defaultOpenTimeout = 30 * time.Second
+)
+
+// Policy limits the calls made to one OCI service.
+type Policy struct {
+	// QPS is the sustained calls per second. Zero leaves the rate unlimited.
+	QPS float64
+	// Burst is the number of calls allowed at once. Values below one mean
+	// one call when QPS is set.
+	Burst int
+	// FailureThreshold is the number of consecutive 429 or 5xx responses
+	// that opens the circuit. Zero disables the breaker.
+	FailureThreshold uint32
+	// OpenTimeout is how long an open circuit rejects calls before one trial
+	// call is let through. Zero means 30 seconds.
+	OpenTimeout time.Duration
+}
+
+// Config holds the default policy and per-service overrides, keyed by the
+// OCI SDK package name such as "core" or "mysql".
+type Config struct {
+	Default  Policy
+	Services map[string]Policy
+}
+
+// PolicyFor returns the policy for service.
+func (c Config) PolicyFor(service string) Policy {
+	if policy, ok := c.Services[service]; ok {
+		return policy
+	}
+	return c.Default
+}
+
+// ThrottledError reports a call that was throttled, either by OCI with a
+// 429 or locally before it was sent. Err is the OCI error, if any.
+type ThrottledError struct {
+	Service    string
+	RetryAfter time.Duration
+	Err        error
+}
+
+func (e *ThrottledError) Error() string {
+	if e.Err != nil {
+		return e.Err.Error()
+	}
+	return "OCI " + e.Service + " calls are throttled on the client; retry after " + e.RetryAfter.String()
+}
+
+func (e *ThrottledError) Unwrap() error { return e.Err }
+
+// CircuitOpenError reports a call rejected because the service's circuit is
+// open after repeated 429 or 5xx responses.
+type CircuitOpenError struct {
+	Service    string
+	RetryAfter time.Duration
+}
+
+func (e *CircuitOpenError) Error() string {
+	return "OCI " + e.Service + " calls are suspended after repeated failures; retry after " + e.RetryAfter.String()
+}
+
+// IsThrottled reports whether err came from throttling or an open circuit,
+// and how long OCI or the limiter asked callers to wait. The duration is
+// zero when nothing was asked.
+func IsThrottled(err error) (time.Duration, bool) {
+	var throttled *ThrottledError
+	if errors.As(err, &throttled) {
+		return throttled.RetryAfter, true
+	}
+	var open *CircuitOpenError
+	if errors.As(err, &open) {
+		return open.RetryAfter, true
+	}
+	var serviceErr common.ServiceError
+	if errors.As(err, &serviceErr) && serviceErr.GetHTTPStatusCode() == http.StatusTooManyRequests {
+		return 0, true
+	}
+	return 0, false
+}
+
+// Limiter applies a Config to OCI calls.
+type Limiter struct {
+	config Config
+
+	mu       sync.Mutex
+	services map[string]*serviceState
+}
+
+type serviceState struct {
+	name        string
+	bucket      *rate.Limiter
+	breaker     *gobreaker.CircuitBreaker
+	openTimeout time.Duration
+
+	mu          sync.Mutex
+	pausedUntil time.Time
+	openedAt    time.Time
+}
+
+// New returns a Limiter for cfg.
+func New(cfg Config) *Limiter {
+	return &Limiter{config: cfg, services: map[string]*serviceState{}}
+}
+
+var shared struct {
+	sync.RWMutex
+	limiter *Limiter
+}
+
+func init() {
+	shared.limiter = New(Config{})
+}
+
+// Configure replaces the limiter shared by every OCI service client.
+func Configure(cfg Config) {
+	shared.Lock()
+	defer shared.Unlock()
+	shared.limiter = New(cfg)
+}
+
+// Shared returns the limiter shared by every OCI service client.
+func Shared() *Limiter {
+	shared.RLock()
+	defer shared.RUnlock()
+	return shared.limiter
+}
+
+// Call runs call through the shared limiter as one call to service.
+func Call[Response any](ctx context.Context, service string, call func(context.Context) (Response, error)) (Response, error) {
+	return Do(ctx, Shared(), service, call)
+}
+
+// Do runs call as one call to service under l. It waits for a token and for
+// any Retry-After pause, fails fast while the circuit is open, and turns a
+// 429 into a ThrottledError that carries the Retry-After.
+func Do[Response any](ctx context.Context, l *Limiter, service string, call func(context.Context) (Response, error)) (Response, error) {
+	var zero Response
+	state := l.state(service)
+	if err := state.admit(ctx); err != nil {
+		return zero, err
+	}
+	if state.breaker == nil {
+		response, err := call(ctx)
+		return response, state.observe(response, err)
+	}
+
+	var response Response
+	var callErr error
+	_, err := state.breaker.Execute(func() (any, error) {
+		response, callErr = call(ctx)
+		return nil, callErr
+	})
+	if errors.Is(err, gobreaker.ErrOpenState) || errors.Is(err, gobreaker.ErrTooManyRequests) {
+		rejected.WithLabelValues(service, rejectReasonCircuitOpen).Inc()
+		return zero, &CircuitOpenError{Service: service, RetryAfter: state.openRemaining()}
+	}
+	return response, state.observe(response, callErr)
+}
+
+func (l *Limiter) state(service string) *serviceState {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if state, ok := l.services[service]; ok {
+		return state
+	}
+	state := newServiceState(service, l.config.PolicyFor(service))
+	l.services[service] = state
+	return state
+}
+
+func newServiceState(service string, policy Policy) *serviceState {
+	state := &serviceState{name: service, openTimeout: policy.OpenTimeout}
+	if policy.QPS > 0 {
+		burst := policy.Burst
+		if burst < 1 {
+			burst = 1
+		}
+		state.bucket = rate.NewLimiter(rate.Limit(policy.QPS), burst)
+	}
+	if policy.FailureThreshold > 0 {
+		if state.openTimeout <= 0 {
+			state.openTimeout = defaultOpenTimeout
+		}
+		threshold := policy.FailureThreshold
+		state.breaker = gobreaker.NewCircuitBreaker(gobreaker.Settings{
+			Name:    service,
+			Timeout: state.openTimeout,
+			ReadyToTrip: func(counts gobreaker.Counts) bool {
+				return counts.ConsecutiveFailures >= threshold
+			},
+			IsSuccessful: func(err error) bool { return !isServiceFailure(err) },
+			OnStateChange: func(name string, _ gobreaker.State, to gobreaker.State) {
+				state.mu.Lock()
+				if to == gobreaker.StateOpen {
+					state.openedAt = time.Now()
+				}
+				state.mu.Unlock()
+				open := 0.0
+				if to == gobreaker.StateOpen {
+					open = 1
+				}
+				circuitOpen.WithLabelValues(name).Set(open)
+			},
+		})
+	}
+	return state
+}
+
+// admit blocks until a call to the service may be sent.
+func (s *serviceState) admit(ctx context.Context) error {
+	s.mu.Lock()
+	pause := time.Until(s.pausedUntil)
+	s.mu.Unlock()
+	if pause > 0 {
+		if pause > maxWait {
+			rejected.WithLabelValues(s.name, rejectReasonRetryAfter).Inc()
+			return &ThrottledError{Service: s.name, RetryAfter: pause}
+		}
+		if err := sleep(ctx, pause); err != nil {
+			return err
+		}
+	}
+
+	if s.bucket == nil {
+		return nil
+	}
+	reservation := s.bucket.Reserve()
+	delay := reservation.Delay()
+	if !reservation.OK() || delay > maxWait {
+		reservation.Cancel()
+		rejected.WithLabelValues(s.name, rejectReasonRateLimit).Inc()
+		return &ThrottledError{Service: s.name, RetryAfter: delay}
+	}
+	waitSeconds.WithLabelValues(s.name).Observe(delay.Seconds())
+	if err := sleep(ctx, delay); err != nil {
+		reservation.Cancel()
+		return err
+	}
+	return nil
+}
+
+// observe records a 429 from the call and pauses the service for its
+// Retry-After.
+func (s *serviceState) observe(response any, err error) error {
+	var serviceErr common.ServiceError
+	if !errors.As(err, &serviceErr) || serviceErr.GetHTTPStatusCode() != http.StatusTooManyRequests {
+		return err
+	}
+	retryAfter := retryAfterHeader(response)
+	if retryAfter <= 0 {
+		retryAfter = defaultRetryAfter
+	}
+	s.mu.Lock()
+	if until := time.Now().Add(retryAfter); until.After(s.pausedUntil) {
+		s.pausedUntil = until
+	}
+	s.mu.Unlock()
+	return &ThrottledError{Service: s.name, RetryAfter: retryAfter, Err: err}
+}
+
+func (s *serviceState) openRemaining() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if remaining := time.Until(s.openedAt.Add(s.openTimeout)); remaining > 0 {
+		return remaining
+	}
+	return s.openTimeout
+}
+
+// isServiceFailure reports whether err counts against the circuit breaker:
+// throttling and server errors do, client errors such as 404 do not.
+func isServiceFailure(err error) bool {
+	var serviceErr common.ServiceError
+	if !errors.As(err, &serviceErr) {
+		return false
+	}
+	code := serviceErr.GetHTTPStatusCode()
+	return code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+}
+
+// retryAfterHeader reads the Retry-After header, in seconds or as an HTTP
+// date, from the raw response the SDK returns alongside an error.
+func retryAfterHeader(response any) time.Duration {
+	ociResponse, ok := response.(common.OCIResponse)
+	if !ok || isNilPointer(ociResponse) {
+		return 0
+	}
+	httpResponse := ociResponse.HTTPResponse()
+	if httpResponse == nil {
+		return 0
+	}
+	value := httpResponse.Header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return time.Until(at)
+	}
+	return 0
+}
+
+func isNilPointer(value any) bool {
+	reflected := reflect.ValueOf(value)
+	return reflected.Kind() == reflect.Pointer && reflected.IsNil()
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
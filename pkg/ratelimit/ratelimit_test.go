@@ -0,0 +1,150 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// serviceError is a minimal common.ServiceError; errortest cannot be used
+// here because it imports this package through servicemanager.
+type serviceError struct {
+	statusCode int
+}
+
+func (e serviceError) Error() string           { return http.StatusText(e.statusCode) }
+func (e serviceError) GetHTTPStatusCode() int  { return e.statusCode }
+func (e serviceError) GetMessage() string      { return e.Error() }
+func (e serviceError) GetCode() string         { return "" }
+func (e serviceError) GetOpcRequestID() string { return "opc-request-id" }
+
+// response is a minimal common.OCIResponse.
+type response struct {
+	raw *http.Response
+}
+
+func (r response) HTTPResponse() *http.Response { return r.raw }
+
+func throttledResponse(retryAfter string) response {
+	header := http.Header{}
+	if retryAfter != "" {
+		header.Set("Retry-After", retryAfter)
+	}
+	return response{raw: &http.Response{StatusCode: http.StatusTooManyRequests, Header: header}}
+}
+
+func TestConfigPolicyForPrefersServiceOverride(t *testing.T) {
+	t.Parallel()
+
+	cfg := Config{Default: Policy{QPS: 10}, Services: map[string]Policy{"core": {QPS: 2}}}
+	if got := cfg.PolicyFor("core").QPS; got != 2 {
+		t.Fatalf("PolicyFor(core).QPS = %v, want 2", got)
+	}
+	if got := cfg.PolicyFor("queue").QPS; got != 10 {
+		t.Fatalf("PolicyFor(queue).QPS = %v, want the default 10", got)
+	}
+}
+
+func TestDoPausesServiceForRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	limiter := New(Config{})
+	_, err := Do(context.Background(), limiter, "queue", func(context.Context) (response, error) {
+		return throttledResponse("30"), serviceError{statusCode: http.StatusTooManyRequests}
+	})
+	var throttled *ThrottledError
+	if !errors.As(err, &throttled) || throttled.RetryAfter != 30*time.Second {
+		t.Fatalf("Do() error = %#v, want a ThrottledError with a 30s Retry-After", err)
+	}
+	var serviceErr serviceError
+	if !errors.As(err, &serviceErr) {
+		t.Fatalf("Do() error = %v, want it to wrap the OCI error", err)
+	}
+
+	calls := 0
+	_, err = Do(context.Background(), limiter, "queue", func(context.Context) (response, error) {
+		calls++
+		return response{}, nil
+	})
+	if retryAfter, ok := IsThrottled(err); !ok || retryAfter <= maxWait || calls != 0 {
+		t.Fatalf("second Do() error = %v, calls = %d, want it rejected locally for the remaining pause", err, calls)
+	}
+
+	if _, err := Do(context.Background(), limiter, "mysql", func(context.Context) (response, error) {
+		return response{}, nil
+	}); err != nil {
+		t.Fatalf("Do() for another service error = %v, want nil", err)
+	}
+}
+
+func TestDoRejectsCallsBeyondTheBucket(t *testing.T) {
+	t.Parallel()
+
+	limiter := New(Config{Default: Policy{QPS: 0.01, Burst: 1}})
+	call := func(context.Context) (response, error) { return response{}, nil }
+	if _, err := Do(context.Background(), limiter, "core", call); err != nil {
+		t.Fatalf("first Do() error = %v, want nil", err)
+	}
+	_, err := Do(context.Background(), limiter, "core", call)
+	if retryAfter, ok := IsThrottled(err); !ok || retryAfter <= maxWait {
+		t.Fatalf("second Do() error = %v, want a ThrottledError for the next token", err)
+	}
+}
+
+func TestDoOpensCircuitAfterServerErrors(t *testing.T) {
+	t.Parallel()
+
+	limiter := New(Config{Default: Policy{FailureThreshold: 2, OpenTimeout: time.Minute}})
+	notFound := func(context.Context) (response, error) {
+		return response{}, serviceError{statusCode: http.StatusNotFound}
+	}
+	unavailable := func(context.Context) (response, error) {
+		return response{}, serviceError{statusCode: http.StatusServiceUnavailable}
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := Do(context.Background(), limiter, "nosql", notFound); err == nil {
+			t.Fatal("Do() error = nil, want the 404")
+		}
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := Do(context.Background(), limiter, "nosql", unavailable); !errors.As(err, new(serviceError)) {
+			t.Fatalf("Do() error = %v, want the 503", err)
+		}
+	}
+	_, err := Do(context.Background(), limiter, "nosql", notFound)
+	var open *CircuitOpenError
+	if !errors.As(err, &open) || open.RetryAfter <= 0 || open.RetryAfter > time.Minute {
+		t.Fatalf("Do() error = %#v, want a CircuitOpenError", err)
+	}
+}
+
+func TestRetryAfterHeader(t *testing.T) {
+	t.Parallel()
+
+	var nilResponse *response
+	tests := []struct {
+		name     string
+		response any
+		want     time.Duration
+	}{
+		{name: "seconds", response: throttledResponse("7"), want: 7 * time.Second},
+		{name: "missing", response: throttledResponse(""), want: 0},
+		{name: "not a response", response: "body", want: 0},
+		{name: "nil pointer", response: nilResponse, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := retryAfterHeader(tt.response); got != tt.want {
+				t.Fatalf("retryAfterHeader() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
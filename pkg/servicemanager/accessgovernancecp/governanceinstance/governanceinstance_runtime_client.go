@@ -16,6 +16,7 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/common"
 	accessgovernancecpv1beta1 "github.com/oracle/oci-service-operator/api/accessgovernancecp/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
 )
@@ -47,7 +48,7 @@ func newGovernanceInstanceSDKClient(manager *GovernanceInstanceServiceManager) (
 		return nil, fmt.Errorf("GovernanceInstance service manager is nil")
 	}
 
-	client, err := accessgovernancecpsdk.NewAccessGovernanceCPClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, accessgovernancecpsdk.NewAccessGovernanceCPClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
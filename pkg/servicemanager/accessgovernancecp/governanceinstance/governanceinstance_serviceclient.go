@@ -13,6 +13,7 @@ import (
 
 	accessgovernancecpsdk "github.com/oracle/oci-go-sdk/v65/accessgovernancecp"
 	accessgovernancecpv1beta1 "github.com/oracle/oci-service-operator/api/accessgovernancecp/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultGovernanceInstanceServiceClient struct {
 var _ GovernanceInstanceServiceClient = defaultGovernanceInstanceServiceClient{}
 
 var newGovernanceInstanceServiceClient = func(manager *GovernanceInstanceServiceManager) GovernanceInstanceServiceClient {
-	sdkClient, err := accessgovernancecpsdk.NewAccessGovernanceCPClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, accessgovernancecpsdk.NewAccessGovernanceCPClientWithConfigurationProvider)
 	hooks := newGovernanceInstanceRuntimeHooks(manager, sdkClient)
 	config := buildGovernanceInstanceGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -17,6 +17,7 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/common"
 	admv1beta1 "github.com/oracle/oci-service-operator/api/adm/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -57,7 +58,7 @@ func newKnowledgeBaseSDKClient(manager *KnowledgeBaseServiceManager) (knowledgeB
 	if manager == nil {
 		return nil, fmt.Errorf("KnowledgeBase service manager is nil")
 	}
-	client, err := admsdk.NewApplicationDependencyManagementClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, admsdk.NewApplicationDependencyManagementClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -13,6 +13,7 @@ import (
 
 	admsdk "github.com/oracle/oci-go-sdk/v65/adm"
 	admv1beta1 "github.com/oracle/oci-service-operator/api/adm/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultKnowledgeBaseServiceClient struct {
 var _ KnowledgeBaseServiceClient = defaultKnowledgeBaseServiceClient{}
 
 var newKnowledgeBaseServiceClient = func(manager *KnowledgeBaseServiceManager) KnowledgeBaseServiceClient {
-	sdkClient, err := admsdk.NewApplicationDependencyManagementClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, admsdk.NewApplicationDependencyManagementClientWithConfigurationProvider)
 	hooks := newKnowledgeBaseRuntimeHooks(manager, sdkClient)
 	config := buildKnowledgeBaseGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -19,6 +19,7 @@ import (
 	aidataplatformv1beta1 "github.com/oracle/oci-service-operator/api/aidataplatform/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -80,7 +81,7 @@ func newAiDataPlatformSDKClient(manager *AiDataPlatformServiceManager) (aiDataPl
 	if manager == nil {
 		return nil, fmt.Errorf("AiDataPlatform service manager is nil")
 	}
-	client, err := aidataplatformsdk.NewAiDataPlatformClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, aidataplatformsdk.NewAiDataPlatformClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -13,6 +13,7 @@ import (
 
 	aidataplatformsdk "github.com/oracle/oci-go-sdk/v65/aidataplatform"
 	aidataplatformv1beta1 "github.com/oracle/oci-service-operator/api/aidataplatform/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultAiDataPlatformServiceClient struct {
 var _ AiDataPlatformServiceClient = defaultAiDataPlatformServiceClient{}
 
 var newAiDataPlatformServiceClient = func(manager *AiDataPlatformServiceManager) AiDataPlatformServiceClient {
-	sdkClient, err := aidataplatformsdk.NewAiDataPlatformClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, aidataplatformsdk.NewAiDataPlatformClientWithConfigurationProvider)
 	hooks := newAiDataPlatformRuntimeHooks(manager, sdkClient)
 	config := buildAiDataPlatformGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
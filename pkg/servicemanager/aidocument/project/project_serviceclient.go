@@ -13,6 +13,7 @@ import (
 
 	aidocumentsdk "github.com/oracle/oci-go-sdk/v65/aidocument"
 	aidocumentv1beta1 "github.com/oracle/oci-service-operator/api/aidocument/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultProjectServiceClient struct {
 var _ ProjectServiceClient = defaultProjectServiceClient{}
 
 var newProjectServiceClient = func(manager *ProjectServiceManager) ProjectServiceClient {
-	sdkClient, err := aidocumentsdk.NewAIServiceDocumentClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, aidocumentsdk.NewAIServiceDocumentClientWithConfigurationProvider)
 	hooks := newProjectRuntimeHooks(manager, sdkClient)
 	config := buildProjectGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
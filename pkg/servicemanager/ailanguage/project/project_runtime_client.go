@@ -18,6 +18,7 @@ import (
 	ailanguagev1beta1 "github.com/oracle/oci-service-operator/api/ailanguage/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -74,7 +75,7 @@ func newProjectSDKClient(manager *ProjectServiceManager) (projectOCIClient, erro
 	if manager == nil {
 		return nil, fmt.Errorf("Project service manager is nil")
 	}
-	client, err := ailanguagesdk.NewAIServiceLanguageClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, ailanguagesdk.NewAIServiceLanguageClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
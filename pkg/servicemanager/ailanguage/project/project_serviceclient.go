@@ -13,6 +13,7 @@ import (
 
 	ailanguagesdk "github.com/oracle/oci-go-sdk/v65/ailanguage"
 	ailanguagev1beta1 "github.com/oracle/oci-service-operator/api/ailanguage/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultProjectServiceClient struct {
 var _ ProjectServiceClient = defaultProjectServiceClient{}
 
 var newProjectServiceClient = func(manager *ProjectServiceManager) ProjectServiceClient {
-	sdkClient, err := ailanguagesdk.NewAIServiceLanguageClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, ailanguagesdk.NewAIServiceLanguageClientWithConfigurationProvider)
 	hooks := newProjectRuntimeHooks(manager, sdkClient)
 	config := buildProjectGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
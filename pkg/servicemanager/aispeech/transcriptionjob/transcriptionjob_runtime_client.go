@@ -17,6 +17,7 @@ import (
 	aispeechv1beta1 "github.com/oracle/oci-service-operator/api/aispeech/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -56,7 +57,7 @@ func newTranscriptionJobSDKClient(manager *TranscriptionJobServiceManager) (tran
 	if manager == nil {
 		return nil, fmt.Errorf("TranscriptionJob service manager is nil")
 	}
-	client, err := aispeech.NewAIServiceSpeechClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, aispeech.NewAIServiceSpeechClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -13,6 +13,7 @@ import (
 
 	aispeechsdk "github.com/oracle/oci-go-sdk/v65/aispeech"
 	aispeechv1beta1 "github.com/oracle/oci-service-operator/api/aispeech/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultTranscriptionJobServiceClient struct {
 var _ TranscriptionJobServiceClient = defaultTranscriptionJobServiceClient{}
 
 var newTranscriptionJobServiceClient = func(manager *TranscriptionJobServiceManager) TranscriptionJobServiceClient {
-	sdkClient, err := aispeechsdk.NewAIServiceSpeechClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, aispeechsdk.NewAIServiceSpeechClientWithConfigurationProvider)
 	hooks := newTranscriptionJobRuntimeHooks(manager, sdkClient)
 	config := buildTranscriptionJobGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	analyticssdk "github.com/oracle/oci-go-sdk/v65/analytics"
 	analyticsv1beta1 "github.com/oracle/oci-service-operator/api/analytics/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultAnalyticsInstanceServiceClient struct {
 var _ AnalyticsInstanceServiceClient = defaultAnalyticsInstanceServiceClient{}
 
 var newAnalyticsInstanceServiceClient = func(manager *AnalyticsInstanceServiceManager) AnalyticsInstanceServiceClient {
-	sdkClient, err := analyticssdk.NewAnalyticsClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, analyticssdk.NewAnalyticsClientWithConfigurationProvider)
 	hooks := newAnalyticsInstanceRuntimeHooks(manager, sdkClient)
 	config := buildAnalyticsInstanceGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	announcementsservicesdk "github.com/oracle/oci-go-sdk/v65/announcementsservice"
 	announcementsservicev1beta1 "github.com/oracle/oci-service-operator/api/announcementsservice/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultAnnouncementSubscriptionServiceClient struct {
 var _ AnnouncementSubscriptionServiceClient = defaultAnnouncementSubscriptionServiceClient{}
 
 var newAnnouncementSubscriptionServiceClient = func(manager *AnnouncementSubscriptionServiceManager) AnnouncementSubscriptionServiceClient {
-	sdkClient, err := announcementsservicesdk.NewAnnouncementSubscriptionClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, announcementsservicesdk.NewAnnouncementSubscriptionClientWithConfigurationProvider)
 	hooks := newAnnouncementSubscriptionRuntimeHooks(manager, sdkClient)
 	config := buildAnnouncementSubscriptionGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -18,6 +18,7 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/common"
 	apiaccesscontrolv1beta1 "github.com/oracle/oci-service-operator/api/apiaccesscontrol/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -69,7 +70,7 @@ func newPrivilegedApiControlWorkRequestClient(
 	if manager == nil {
 		return nil, fmt.Errorf("PrivilegedApiControl service manager is nil")
 	}
-	client, err := apiaccesscontrolsdk.NewPrivilegedApiWorkRequestClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, apiaccesscontrolsdk.NewPrivilegedApiWorkRequestClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -13,6 +13,7 @@ import (
 
 	apiaccesscontrolsdk "github.com/oracle/oci-go-sdk/v65/apiaccesscontrol"
 	apiaccesscontrolv1beta1 "github.com/oracle/oci-service-operator/api/apiaccesscontrol/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultPrivilegedApiControlServiceClient struct {
 var _ PrivilegedApiControlServiceClient = defaultPrivilegedApiControlServiceClient{}
 
 var newPrivilegedApiControlServiceClient = func(manager *PrivilegedApiControlServiceManager) PrivilegedApiControlServiceClient {
-	sdkClient, err := apiaccesscontrolsdk.NewPrivilegedApiControlClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, apiaccesscontrolsdk.NewPrivilegedApiControlClientWithConfigurationProvider)
 	hooks := newPrivilegedApiControlRuntimeHooks(manager, sdkClient)
 	config := buildPrivilegedApiControlGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -14,6 +14,8 @@ import (
 	apigatewaysdk "github.com/oracle/oci-go-sdk/v65/apigateway"
 	"github.com/oracle/oci-go-sdk/v65/common"
 	apigatewayv1beta1 "github.com/oracle/oci-service-operator/api/apigateway/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/metrics"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
 	"github.com/oracle/oci-service-operator/pkg/util"
@@ -34,7 +36,7 @@ func (c *DeploymentServiceManager) getDeploymentClientOrCreate() (DeploymentClie
 	if c.ociClient != nil {
 		return c.ociClient, nil
 	}
-	return apigatewaysdk.NewDeploymentClientWithConfigurationProvider(c.Provider)
+	return ociclient.New(c.Provider, apigatewaysdk.NewDeploymentClientWithConfigurationProvider)
 }
 
 // buildApiSpecification converts CRD route specs into the OCI SDK ApiSpecification type.
@@ -109,7 +111,7 @@ func (c *DeploymentServiceManager) CreateDeployment(ctx context.Context, dep api
 	req := apigatewaysdk.CreateDeploymentRequest{
 		CreateDeploymentDetails: details,
 	}
-	return client.CreateDeployment(ctx, req)
+	return metrics.CallOCI(ctx, req, client.CreateDeployment)
 }
 
 // GetDeployment retrieves an API Gateway Deployment by OCID.
@@ -126,7 +128,7 @@ func (c *DeploymentServiceManager) GetDeployment(ctx context.Context, deployment
 		req.RequestMetadata.RetryPolicy = retryPolicy
 	}
 
-	resp, err := client.GetDeployment(ctx, req)
+	resp, err := metrics.CallOCI(ctx, req, client.GetDeployment)
 	if err != nil {
 		return nil, err
 	}
@@ -147,7 +149,7 @@ func (c *DeploymentServiceManager) GetDeploymentOcid(ctx context.Context, dep ap
 		Limit:         common.Int(1),
 	}
 
-	resp, err := client.ListDeployments(ctx, req)
+	resp, err := metrics.CallOCI(ctx, req, client.ListDeployments)
 	if err != nil {
 		c.Log.ErrorLog(err, "Error listing ApiGatewayDeployments")
 		return nil, err
@@ -189,12 +191,12 @@ func (c *DeploymentServiceManager) UpdateDeployment(ctx context.Context, dep *ap
 
 	if dep.Spec.CompartmentId != "" &&
 		(existing.CompartmentId == nil || *existing.CompartmentId != string(dep.Spec.CompartmentId)) {
-		response, err := client.ChangeDeploymentCompartment(ctx, apigatewaysdk.ChangeDeploymentCompartmentRequest{
+		response, err := metrics.CallOCI(ctx, apigatewaysdk.ChangeDeploymentCompartmentRequest{
 			DeploymentId: common.String(string(targetID)),
 			ChangeDeploymentCompartmentDetails: apigatewaysdk.ChangeDeploymentCompartmentDetails{
 				CompartmentId: common.String(string(dep.Spec.CompartmentId)),
 			},
-		})
+		}, client.ChangeDeploymentCompartment)
 		if err != nil {
 			servicemanager.RecordErrorOpcRequestID(&dep.Status.OsokStatus, err)
 			return err
@@ -211,7 +213,7 @@ func (c *DeploymentServiceManager) UpdateDeployment(ctx context.Context, dep *ap
 		DeploymentId:            common.String(string(targetID)),
 		UpdateDeploymentDetails: updateDetails,
 	}
-	response, err := client.UpdateDeployment(ctx, req)
+	response, err := metrics.CallOCI(ctx, req, client.UpdateDeployment)
 	if err != nil {
 		servicemanager.RecordErrorOpcRequestID(&dep.Status.OsokStatus, err)
 		return err
@@ -269,7 +271,7 @@ func (c *DeploymentServiceManager) DeleteDeployment(ctx context.Context, dep *ap
 		DeploymentId: common.String(string(deploymentID)),
 	}
 
-	response, err := client.DeleteDeployment(ctx, req)
+	response, err := metrics.CallOCI(ctx, req, client.DeleteDeployment)
 	if dep != nil {
 		if err != nil {
 			servicemanager.RecordErrorOpcRequestID(&dep.Status.OsokStatus, err)
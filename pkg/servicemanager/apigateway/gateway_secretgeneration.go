@@ -11,15 +11,16 @@ import (
 
 	apigatewaysdk "github.com/oracle/oci-go-sdk/v65/apigateway"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	"github.com/oracle/oci-service-operator/pkg/shared"
 )
 
-func (c *GatewayServiceManager) addToSecret(ctx context.Context, namespace string, gatewayName string,
-	gw apigatewaysdk.Gateway) (bool, error) {
+func (c *GatewayServiceManager) addToSecret(ctx context.Context, target *shared.ConnectionSecretTarget, namespace string,
+	gatewayName string, gw apigatewaysdk.Gateway) (bool, error) {
 	c.Log.InfoLog("Creating the ApiGateway connection secret")
 	credMap := getGatewayCredentialMap(gw)
 
 	c.Log.InfoLog(fmt.Sprintf("Creating secret for ApiGateway %s in namespace %s", gatewayName, namespace))
-	return servicemanager.EnsureOwnedSecret(ctx, c.CredentialClient, gatewayName, namespace, "ApiGateway", gatewayName, credMap)
+	return servicemanager.EnsureOwnedConnectionSecret(ctx, c.CredentialClient, target, namespace, "ApiGateway", gatewayName, credMap)
 }
 
 func getGatewayCredentialMap(gw apigatewaysdk.Gateway) map[string][]byte {
@@ -14,6 +14,8 @@ import (
 	apigatewaysdk "github.com/oracle/oci-go-sdk/v65/apigateway"
 	"github.com/oracle/oci-go-sdk/v65/common"
 	apigatewayv1beta1 "github.com/oracle/oci-service-operator/api/apigateway/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/metrics"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
 	"github.com/oracle/oci-service-operator/pkg/util"
@@ -34,7 +36,7 @@ func (c *GatewayServiceManager) getGatewayClientOrCreate() (GatewayClientInterfa
 	if c.ociClient != nil {
 		return c.ociClient, nil
 	}
-	return apigatewaysdk.NewGatewayClientWithConfigurationProvider(c.Provider)
+	return ociclient.New(c.Provider, apigatewaysdk.NewGatewayClientWithConfigurationProvider)
 }
 
 // CreateGateway calls the OCI API to create a new API Gateway.
@@ -71,7 +73,7 @@ func (c *GatewayServiceManager) CreateGateway(ctx context.Context, gw apigateway
 	req := apigatewaysdk.CreateGatewayRequest{
 		CreateGatewayDetails: details,
 	}
-	return client.CreateGateway(ctx, req)
+	return metrics.CallOCI(ctx, req, client.CreateGateway)
 }
 
 // GetGateway retrieves an API Gateway by OCID.
@@ -88,7 +90,7 @@ func (c *GatewayServiceManager) GetGateway(ctx context.Context, gatewayID shared
 		req.RequestMetadata.RetryPolicy = retryPolicy
 	}
 
-	resp, err := client.GetGateway(ctx, req)
+	resp, err := metrics.CallOCI(ctx, req, client.GetGateway)
 	if err != nil {
 		return nil, err
 	}
@@ -108,7 +110,7 @@ func (c *GatewayServiceManager) GetGatewayOcid(ctx context.Context, gw apigatewa
 		Limit:         common.Int(1),
 	}
 
-	resp, err := client.ListGateways(ctx, req)
+	resp, err := metrics.CallOCI(ctx, req, client.ListGateways)
 	if err != nil {
 		c.Log.ErrorLog(err, "Error listing ApiGateways")
 		return nil, err
@@ -150,12 +152,12 @@ func (c *GatewayServiceManager) UpdateGateway(ctx context.Context, gw *apigatewa
 
 	if gw.Spec.CompartmentId != "" &&
 		(existing.CompartmentId == nil || *existing.CompartmentId != string(gw.Spec.CompartmentId)) {
-		response, err := client.ChangeGatewayCompartment(ctx, apigatewaysdk.ChangeGatewayCompartmentRequest{
+		response, err := metrics.CallOCI(ctx, apigatewaysdk.ChangeGatewayCompartmentRequest{
 			GatewayId: common.String(string(targetID)),
 			ChangeGatewayCompartmentDetails: apigatewaysdk.ChangeGatewayCompartmentDetails{
 				CompartmentId: common.String(string(gw.Spec.CompartmentId)),
 			},
-		})
+		}, client.ChangeGatewayCompartment)
 		if err != nil {
 			servicemanager.RecordErrorOpcRequestID(&gw.Status.OsokStatus, err)
 			return err
@@ -172,7 +174,7 @@ func (c *GatewayServiceManager) UpdateGateway(ctx context.Context, gw *apigatewa
 		GatewayId:            common.String(string(targetID)),
 		UpdateGatewayDetails: updateDetails,
 	}
-	response, err := client.UpdateGateway(ctx, req)
+	response, err := metrics.CallOCI(ctx, req, client.UpdateGateway)
 	if err != nil {
 		servicemanager.RecordErrorOpcRequestID(&gw.Status.OsokStatus, err)
 		return err
@@ -264,7 +266,7 @@ func (c *GatewayServiceManager) DeleteGateway(ctx context.Context, gw *apigatewa
 		GatewayId: common.String(string(gatewayID)),
 	}
 
-	response, err := client.DeleteGateway(ctx, req)
+	response, err := metrics.CallOCI(ctx, req, client.DeleteGateway)
 	if gw != nil {
 		if err != nil {
 			servicemanager.RecordErrorOpcRequestID(&gw.Status.OsokStatus, err)
@@ -81,7 +81,7 @@ func (c *GatewayServiceManager) CreateOrUpdate(ctx context.Context, obj runtime.
 		return response, nil
 	}
 
-	if _, err := c.addToSecret(ctx, gw.Namespace, gw.Name, *gwInstance); err != nil && !apierrors.IsAlreadyExists(err) {
+	if _, err := c.addToSecret(ctx, gw.Spec.WriteConnectionSecretToRef, gw.Namespace, gw.Name, *gwInstance); err != nil && !apierrors.IsAlreadyExists(err) {
 		c.Log.InfoLog("ApiGateway secret creation failed")
 		return servicemanager.OSOKResponse{IsSuccessful: false}, err
 	}
@@ -13,6 +13,7 @@ import (
 
 	apiplatformsdk "github.com/oracle/oci-go-sdk/v65/apiplatform"
 	apiplatformv1beta1 "github.com/oracle/oci-service-operator/api/apiplatform/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultApiPlatformInstanceServiceClient struct {
 var _ ApiPlatformInstanceServiceClient = defaultApiPlatformInstanceServiceClient{}
 
 var newApiPlatformInstanceServiceClient = func(manager *ApiPlatformInstanceServiceManager) ApiPlatformInstanceServiceClient {
-	sdkClient, err := apiplatformsdk.NewApiPlatformClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, apiplatformsdk.NewApiPlatformClientWithConfigurationProvider)
 	hooks := newApiPlatformInstanceRuntimeHooks(manager, sdkClient)
 	config := buildApiPlatformInstanceGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
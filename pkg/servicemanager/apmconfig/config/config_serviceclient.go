@@ -13,6 +13,7 @@ import (
 
 	apmconfigsdk "github.com/oracle/oci-go-sdk/v65/apmconfig"
 	apmconfigv1beta1 "github.com/oracle/oci-service-operator/api/apmconfig/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultConfigServiceClient struct {
 var _ ConfigServiceClient = defaultConfigServiceClient{}
 
 var newConfigServiceClient = func(manager *ConfigServiceManager) ConfigServiceClient {
-	sdkClient, err := apmconfigsdk.NewConfigClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, apmconfigsdk.NewConfigClientWithConfigurationProvider)
 	hooks := newConfigRuntimeHooks(manager, sdkClient)
 	config := buildConfigGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
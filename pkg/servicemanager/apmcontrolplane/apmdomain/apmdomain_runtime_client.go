@@ -17,6 +17,7 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/common"
 	apmcontrolplanev1beta1 "github.com/oracle/oci-service-operator/api/apmcontrolplane/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -57,7 +58,7 @@ func newApmDomainSDKClient(manager *ApmDomainServiceManager) (apmDomainOCIClient
 		return nil, fmt.Errorf("ApmDomain service manager is nil")
 	}
 
-	client, err := apmcontrolplanesdk.NewApmDomainClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, apmcontrolplanesdk.NewApmDomainClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -13,6 +13,7 @@ import (
 
 	apmcontrolplanesdk "github.com/oracle/oci-go-sdk/v65/apmcontrolplane"
 	apmcontrolplanev1beta1 "github.com/oracle/oci-service-operator/api/apmcontrolplane/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultApmDomainServiceClient struct {
 var _ ApmDomainServiceClient = defaultApmDomainServiceClient{}
 
 var newApmDomainServiceClient = func(manager *ApmDomainServiceManager) ApmDomainServiceClient {
-	sdkClient, err := apmcontrolplanesdk.NewApmDomainClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, apmcontrolplanesdk.NewApmDomainClientWithConfigurationProvider)
 	hooks := newApmDomainRuntimeHooks(manager, sdkClient)
 	config := buildApmDomainGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	apmsyntheticssdk "github.com/oracle/oci-go-sdk/v65/apmsynthetics"
 	apmsyntheticsv1beta1 "github.com/oracle/oci-service-operator/api/apmsynthetics/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultScriptServiceClient struct {
 var _ ScriptServiceClient = defaultScriptServiceClient{}
 
 var newScriptServiceClient = func(manager *ScriptServiceManager) ScriptServiceClient {
-	sdkClient, err := apmsyntheticssdk.NewApmSyntheticClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, apmsyntheticssdk.NewApmSyntheticClientWithConfigurationProvider)
 	hooks := newScriptRuntimeHooks(manager, sdkClient)
 	config := buildScriptGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
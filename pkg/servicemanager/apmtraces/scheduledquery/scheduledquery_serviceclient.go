@@ -13,6 +13,7 @@ import (
 
 	apmtracessdk "github.com/oracle/oci-go-sdk/v65/apmtraces"
 	apmtracesv1beta1 "github.com/oracle/oci-service-operator/api/apmtraces/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultScheduledQueryServiceClient struct {
 var _ ScheduledQueryServiceClient = defaultScheduledQueryServiceClient{}
 
 var newScheduledQueryServiceClient = func(manager *ScheduledQueryServiceManager) ScheduledQueryServiceClient {
-	sdkClient, err := apmtracessdk.NewScheduledQueryClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, apmtracessdk.NewScheduledQueryClientWithConfigurationProvider)
 	hooks := newScheduledQueryRuntimeHooks(manager, sdkClient)
 	config := buildScheduledQueryGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -18,6 +18,7 @@ import (
 	artifactsv1beta1 "github.com/oracle/oci-service-operator/api/artifacts/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	"github.com/oracle/oci-service-operator/pkg/util"
@@ -80,7 +81,7 @@ func applyRepositoryRuntimeHooks(manager *RepositoryServiceManager, hooks *Repos
 		return
 	}
 	hooks.WrapGeneratedClient = append(hooks.WrapGeneratedClient, func(RepositoryServiceClient) RepositoryServiceClient {
-		sdkClient, err := artifactssdk.NewArtifactsClientWithConfigurationProvider(manager.Provider)
+		sdkClient, err := ociclient.New(manager.Provider, artifactssdk.NewArtifactsClientWithConfigurationProvider)
 		customHooks := newRepositoryDefaultRuntimeHooks(sdkClient)
 		applyRepositoryRuntimeHookSettings(&customHooks)
 		config := buildRepositoryConcreteGeneratedRuntimeConfig(manager, customHooks)
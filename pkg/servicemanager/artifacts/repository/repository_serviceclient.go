@@ -13,6 +13,7 @@ import (
 
 	artifactssdk "github.com/oracle/oci-go-sdk/v65/artifacts"
 	artifactsv1beta1 "github.com/oracle/oci-service-operator/api/artifacts/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultRepositoryServiceClient struct {
 var _ RepositoryServiceClient = defaultRepositoryServiceClient{}
 
 var newRepositoryServiceClient = func(manager *RepositoryServiceManager) RepositoryServiceClient {
-	sdkClient, err := artifactssdk.NewArtifactsClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, artifactssdk.NewArtifactsClientWithConfigurationProvider)
 	hooks := newRepositoryRuntimeHooks(manager, sdkClient)
 	config := buildRepositoryGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
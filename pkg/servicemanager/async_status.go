@@ -215,6 +215,7 @@ func ApplyAsyncOperation(status *shared.OSOKStatus, current *shared.OSOKAsyncOpe
 		PercentComplete:  cloneFloat32Ptr(current.PercentComplete),
 		Message:          message,
 		UpdatedAt:        updatedAt.DeepCopy(),
+		Replacement:      current.Replacement.DeepCopy(),
 	}
 	status.UpdatedAt = updatedAt.DeepCopy()
 	status.Message = message
@@ -13,6 +13,7 @@ import (
 
 	autoscalingsdk "github.com/oracle/oci-go-sdk/v65/autoscaling"
 	autoscalingv1beta1 "github.com/oracle/oci-service-operator/api/autoscaling/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultAutoScalingPolicyServiceClient struct {
 var _ AutoScalingPolicyServiceClient = defaultAutoScalingPolicyServiceClient{}
 
 var newAutoScalingPolicyServiceClient = func(manager *AutoScalingPolicyServiceManager) AutoScalingPolicyServiceClient {
-	sdkClient, err := autoscalingsdk.NewAutoScalingClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, autoscalingsdk.NewAutoScalingClientWithConfigurationProvider)
 	hooks := newAutoScalingPolicyRuntimeHooks(manager, sdkClient)
 	config := buildAutoScalingPolicyGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -15,6 +15,7 @@ import (
 	bastionv1beta1 "github.com/oracle/oci-service-operator/api/bastion/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -83,7 +84,7 @@ func newBastionOCIClient(manager *BastionServiceManager) (bastionOCIClient, erro
 	if manager == nil {
 		return nil, fmt.Errorf("bastion service manager is nil")
 	}
-	client, err := bastionsdk.NewBastionClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, bastionsdk.NewBastionClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
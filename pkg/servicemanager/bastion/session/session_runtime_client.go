@@ -16,6 +16,7 @@ import (
 	bastionv1beta1 "github.com/oracle/oci-service-operator/api/bastion/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -80,7 +81,7 @@ func newSessionSDKClient(manager *SessionServiceManager) (sessionOCIClient, erro
 	if manager == nil {
 		return nil, fmt.Errorf("%s service manager is nil", sessionKind)
 	}
-	client, err := bastionsdk.NewBastionClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, bastionsdk.NewBastionClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
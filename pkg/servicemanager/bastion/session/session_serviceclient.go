@@ -13,6 +13,7 @@ import (
 
 	bastionsdk "github.com/oracle/oci-go-sdk/v65/bastion"
 	bastionv1beta1 "github.com/oracle/oci-service-operator/api/bastion/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultSessionServiceClient struct {
 var _ SessionServiceClient = defaultSessionServiceClient{}
 
 var newSessionServiceClient = func(manager *SessionServiceManager) SessionServiceClient {
-	sdkClient, err := bastionsdk.NewBastionClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, bastionsdk.NewBastionClientWithConfigurationProvider)
 	hooks := newSessionRuntimeHooks(manager, sdkClient)
 	config := buildSessionGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	batchsdk "github.com/oracle/oci-go-sdk/v65/batch"
 	batchv1beta1 "github.com/oracle/oci-service-operator/api/batch/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultBatchJobPoolServiceClient struct {
 var _ BatchJobPoolServiceClient = defaultBatchJobPoolServiceClient{}
 
 var newBatchJobPoolServiceClient = func(manager *BatchJobPoolServiceManager) BatchJobPoolServiceClient {
-	sdkClient, err := batchsdk.NewBatchComputingClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, batchsdk.NewBatchComputingClientWithConfigurationProvider)
 	hooks := newBatchJobPoolRuntimeHooks(manager, sdkClient)
 	config := buildBatchJobPoolGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
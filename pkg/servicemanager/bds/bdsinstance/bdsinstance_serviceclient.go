@@ -13,6 +13,7 @@ import (
 
 	bdssdk "github.com/oracle/oci-go-sdk/v65/bds"
 	bdsv1beta1 "github.com/oracle/oci-service-operator/api/bds/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultBdsInstanceServiceClient struct {
 var _ BdsInstanceServiceClient = defaultBdsInstanceServiceClient{}
 
 var newBdsInstanceServiceClient = func(manager *BdsInstanceServiceManager) BdsInstanceServiceClient {
-	sdkClient, err := bdssdk.NewBdsClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, bdssdk.NewBdsClientWithConfigurationProvider)
 	hooks := newBdsInstanceRuntimeHooks(manager, sdkClient)
 	config := buildBdsInstanceGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
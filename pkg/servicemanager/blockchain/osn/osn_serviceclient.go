@@ -13,6 +13,7 @@ import (
 
 	blockchainsdk "github.com/oracle/oci-go-sdk/v65/blockchain"
 	blockchainv1beta1 "github.com/oracle/oci-service-operator/api/blockchain/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultOsnServiceClient struct {
 var _ OsnServiceClient = defaultOsnServiceClient{}
 
 var newOsnServiceClient = func(manager *OsnServiceManager) OsnServiceClient {
-	sdkClient, err := blockchainsdk.NewBlockchainPlatformClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, blockchainsdk.NewBlockchainPlatformClientWithConfigurationProvider)
 	hooks := newOsnRuntimeHooks(manager, sdkClient)
 	config := buildOsnGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	blockchainsdk "github.com/oracle/oci-go-sdk/v65/blockchain"
 	blockchainv1beta1 "github.com/oracle/oci-service-operator/api/blockchain/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultPeerServiceClient struct {
 var _ PeerServiceClient = defaultPeerServiceClient{}
 
 var newPeerServiceClient = func(manager *PeerServiceManager) PeerServiceClient {
-	sdkClient, err := blockchainsdk.NewBlockchainPlatformClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, blockchainsdk.NewBlockchainPlatformClientWithConfigurationProvider)
 	hooks := newPeerRuntimeHooks(manager, sdkClient)
 	config := buildPeerGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	budgetsdk "github.com/oracle/oci-go-sdk/v65/budget"
 	budgetv1beta1 "github.com/oracle/oci-service-operator/api/budget/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultBudgetServiceClient struct {
 var _ BudgetServiceClient = defaultBudgetServiceClient{}
 
 var newBudgetServiceClient = func(manager *BudgetServiceManager) BudgetServiceClient {
-	sdkClient, err := budgetsdk.NewBudgetClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, budgetsdk.NewBudgetClientWithConfigurationProvider)
 	hooks := newBudgetRuntimeHooks(manager, sdkClient)
 	config := buildBudgetGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	capacitymanagementsdk "github.com/oracle/oci-go-sdk/v65/capacitymanagement"
 	capacitymanagementv1beta1 "github.com/oracle/oci-service-operator/api/capacitymanagement/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultOccCapacityRequestServiceClient struct {
 var _ OccCapacityRequestServiceClient = defaultOccCapacityRequestServiceClient{}
 
 var newOccCapacityRequestServiceClient = func(manager *OccCapacityRequestServiceManager) OccCapacityRequestServiceClient {
-	sdkClient, err := capacitymanagementsdk.NewCapacityManagementClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, capacitymanagementsdk.NewCapacityManagementClientWithConfigurationProvider)
 	hooks := newOccCapacityRequestRuntimeHooks(manager, sdkClient)
 	config := buildOccCapacityRequestGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	certificatesmanagementsdk "github.com/oracle/oci-go-sdk/v65/certificatesmanagement"
 	certificatesmanagementv1beta1 "github.com/oracle/oci-service-operator/api/certificatesmanagement/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultCaBundleServiceClient struct {
 var _ CaBundleServiceClient = defaultCaBundleServiceClient{}
 
 var newCaBundleServiceClient = func(manager *CaBundleServiceManager) CaBundleServiceClient {
-	sdkClient, err := certificatesmanagementsdk.NewCertificatesManagementClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, certificatesmanagementsdk.NewCertificatesManagementClientWithConfigurationProvider)
 	hooks := newCaBundleRuntimeHooks(manager, sdkClient)
 	config := buildCaBundleGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
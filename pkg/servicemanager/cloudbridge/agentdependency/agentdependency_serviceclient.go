@@ -13,6 +13,7 @@ import (
 
 	cloudbridgesdk "github.com/oracle/oci-go-sdk/v65/cloudbridge"
 	cloudbridgev1beta1 "github.com/oracle/oci-service-operator/api/cloudbridge/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultAgentDependencyServiceClient struct {
 var _ AgentDependencyServiceClient = defaultAgentDependencyServiceClient{}
 
 var newAgentDependencyServiceClient = func(manager *AgentDependencyServiceManager) AgentDependencyServiceClient {
-	sdkClient, err := cloudbridgesdk.NewOcbAgentSvcClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, cloudbridgesdk.NewOcbAgentSvcClientWithConfigurationProvider)
 	hooks := newAgentDependencyRuntimeHooks(manager, sdkClient)
 	config := buildAgentDependencyGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	cloudbridgesdk "github.com/oracle/oci-go-sdk/v65/cloudbridge"
 	cloudbridgev1beta1 "github.com/oracle/oci-service-operator/api/cloudbridge/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultAssetSourceServiceClient struct {
 var _ AssetSourceServiceClient = defaultAssetSourceServiceClient{}
 
 var newAssetSourceServiceClient = func(manager *AssetSourceServiceManager) AssetSourceServiceClient {
-	sdkClient, err := cloudbridgesdk.NewDiscoveryClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, cloudbridgesdk.NewDiscoveryClientWithConfigurationProvider)
 	hooks := newAssetSourceRuntimeHooks(manager, sdkClient)
 	config := buildAssetSourceGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -19,6 +19,7 @@ import (
 	cloudguardv1beta1 "github.com/oracle/oci-service-operator/api/cloudguard/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -64,7 +65,7 @@ func newDataSourceWorkRequestClient(manager *DataSourceServiceManager) (dataSour
 	if manager == nil {
 		return nil, fmt.Errorf("DataSource service manager is nil")
 	}
-	return cloudguardsdk.NewCloudGuardClientWithConfigurationProvider(manager.Provider)
+	return ociclient.New(manager.Provider, cloudguardsdk.NewCloudGuardClientWithConfigurationProvider)
 }
 
 func applyDataSourceRuntimeHooksWithWorkRequestClient(
@@ -13,6 +13,7 @@ import (
 
 	cloudguardsdk "github.com/oracle/oci-go-sdk/v65/cloudguard"
 	cloudguardv1beta1 "github.com/oracle/oci-service-operator/api/cloudguard/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultDetectorRecipeServiceClient struct {
 var _ DetectorRecipeServiceClient = defaultDetectorRecipeServiceClient{}
 
 var newDetectorRecipeServiceClient = func(manager *DetectorRecipeServiceManager) DetectorRecipeServiceClient {
-	sdkClient, err := cloudguardsdk.NewCloudGuardClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, cloudguardsdk.NewCloudGuardClientWithConfigurationProvider)
 	hooks := newDetectorRecipeRuntimeHooks(manager, sdkClient)
 	config := buildDetectorRecipeGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	cloudmigrationssdk "github.com/oracle/oci-go-sdk/v65/cloudmigrations"
 	cloudmigrationsv1beta1 "github.com/oracle/oci-service-operator/api/cloudmigrations/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultMigrationServiceClient struct {
 var _ MigrationServiceClient = defaultMigrationServiceClient{}
 
 var newMigrationServiceClient = func(manager *MigrationServiceManager) MigrationServiceClient {
-	sdkClient, err := cloudmigrationssdk.NewMigrationClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, cloudmigrationssdk.NewMigrationClientWithConfigurationProvider)
 	hooks := newMigrationRuntimeHooks(manager, sdkClient)
 	config := buildMigrationGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
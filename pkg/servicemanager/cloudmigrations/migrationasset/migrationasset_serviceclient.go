@@ -13,6 +13,7 @@ import (
 
 	cloudmigrationssdk "github.com/oracle/oci-go-sdk/v65/cloudmigrations"
 	cloudmigrationsv1beta1 "github.com/oracle/oci-service-operator/api/cloudmigrations/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultMigrationAssetServiceClient struct {
 var _ MigrationAssetServiceClient = defaultMigrationAssetServiceClient{}
 
 var newMigrationAssetServiceClient = func(manager *MigrationAssetServiceManager) MigrationAssetServiceClient {
-	sdkClient, err := cloudmigrationssdk.NewMigrationClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, cloudmigrationssdk.NewMigrationClientWithConfigurationProvider)
 	hooks := newMigrationAssetRuntimeHooks(manager, sdkClient)
 	config := buildMigrationAssetGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -17,6 +17,7 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/common"
 	clusterplacementgroupsv1beta1 "github.com/oracle/oci-service-operator/api/clusterplacementgroups/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -58,7 +59,7 @@ func newClusterPlacementGroupSDKClient(manager *ClusterPlacementGroupServiceMana
 	if manager == nil {
 		return nil, fmt.Errorf("ClusterPlacementGroup service manager is nil")
 	}
-	client, err := clusterplacementgroupssdk.NewClusterPlacementGroupsCPClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, clusterplacementgroupssdk.NewClusterPlacementGroupsCPClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -13,6 +13,7 @@ import (
 
 	clusterplacementgroupssdk "github.com/oracle/oci-go-sdk/v65/clusterplacementgroups"
 	clusterplacementgroupsv1beta1 "github.com/oracle/oci-service-operator/api/clusterplacementgroups/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultClusterPlacementGroupServiceClient struct {
 var _ ClusterPlacementGroupServiceClient = defaultClusterPlacementGroupServiceClient{}
 
 var newClusterPlacementGroupServiceClient = func(manager *ClusterPlacementGroupServiceManager) ClusterPlacementGroupServiceClient {
-	sdkClient, err := clusterplacementgroupssdk.NewClusterPlacementGroupsCPClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, clusterplacementgroupssdk.NewClusterPlacementGroupsCPClientWithConfigurationProvider)
 	hooks := newClusterPlacementGroupRuntimeHooks(manager, sdkClient)
 	config := buildClusterPlacementGroupGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
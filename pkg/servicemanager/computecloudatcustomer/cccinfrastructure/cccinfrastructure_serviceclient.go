@@ -13,6 +13,7 @@ import (
 
 	computecloudatcustomersdk "github.com/oracle/oci-go-sdk/v65/computecloudatcustomer"
 	computecloudatcustomerv1beta1 "github.com/oracle/oci-service-operator/api/computecloudatcustomer/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultCccInfrastructureServiceClient struct {
 var _ CccInfrastructureServiceClient = defaultCccInfrastructureServiceClient{}
 
 var newCccInfrastructureServiceClient = func(manager *CccInfrastructureServiceManager) CccInfrastructureServiceClient {
-	sdkClient, err := computecloudatcustomersdk.NewComputeCloudAtCustomerClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, computecloudatcustomersdk.NewComputeCloudAtCustomerClientWithConfigurationProvider)
 	hooks := newCccInfrastructureRuntimeHooks(manager, sdkClient)
 	config := buildCccInfrastructureGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
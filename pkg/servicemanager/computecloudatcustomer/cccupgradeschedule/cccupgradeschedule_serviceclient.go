@@ -13,6 +13,7 @@ import (
 
 	computecloudatcustomersdk "github.com/oracle/oci-go-sdk/v65/computecloudatcustomer"
 	computecloudatcustomerv1beta1 "github.com/oracle/oci-service-operator/api/computecloudatcustomer/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultCccUpgradeScheduleServiceClient struct {
 var _ CccUpgradeScheduleServiceClient = defaultCccUpgradeScheduleServiceClient{}
 
 var newCccUpgradeScheduleServiceClient = func(manager *CccUpgradeScheduleServiceManager) CccUpgradeScheduleServiceClient {
-	sdkClient, err := computecloudatcustomersdk.NewComputeCloudAtCustomerClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, computecloudatcustomersdk.NewComputeCloudAtCustomerClientWithConfigurationProvider)
 	hooks := newCccUpgradeScheduleRuntimeHooks(manager, sdkClient)
 	config := buildCccUpgradeScheduleGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
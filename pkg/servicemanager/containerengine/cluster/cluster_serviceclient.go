@@ -13,6 +13,7 @@ import (
 
 	containerenginesdk "github.com/oracle/oci-go-sdk/v65/containerengine"
 	containerenginev1beta1 "github.com/oracle/oci-service-operator/api/containerengine/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultClusterServiceClient struct {
 var _ ClusterServiceClient = defaultClusterServiceClient{}
 
 var newClusterServiceClient = func(manager *ClusterServiceManager) ClusterServiceClient {
-	sdkClient, err := containerenginesdk.NewContainerEngineClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, containerenginesdk.NewContainerEngineClientWithConfigurationProvider)
 	hooks := newClusterRuntimeHooks(manager, sdkClient)
 	config := buildClusterGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
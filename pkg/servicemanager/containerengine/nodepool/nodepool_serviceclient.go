@@ -13,6 +13,7 @@ import (
 
 	containerenginesdk "github.com/oracle/oci-go-sdk/v65/containerengine"
 	containerenginev1beta1 "github.com/oracle/oci-service-operator/api/containerengine/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultNodePoolServiceClient struct {
 var _ NodePoolServiceClient = defaultNodePoolServiceClient{}
 
 var newNodePoolServiceClient = func(manager *NodePoolServiceManager) NodePoolServiceClient {
-	sdkClient, err := containerenginesdk.NewContainerEngineClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, containerenginesdk.NewContainerEngineClientWithConfigurationProvider)
 	hooks := newNodePoolRuntimeHooks(manager, sdkClient)
 	config := buildNodePoolGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
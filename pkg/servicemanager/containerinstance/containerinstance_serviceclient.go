@@ -16,6 +16,8 @@ import (
 	containerinstancessdk "github.com/oracle/oci-go-sdk/v65/containerinstances"
 	coresdk "github.com/oracle/oci-go-sdk/v65/core"
 	containerinstancesv1beta1 "github.com/oracle/oci-service-operator/api/containerinstances/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/metrics"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
 	"github.com/oracle/oci-service-operator/pkg/util"
@@ -36,11 +38,11 @@ type ContainerInstanceVnicClientInterface interface {
 }
 
 func getContainerInstanceClient(provider common.ConfigurationProvider) (containerinstancessdk.ContainerInstanceClient, error) {
-	return containerinstancessdk.NewContainerInstanceClientWithConfigurationProvider(provider)
+	return ociclient.New(provider, containerinstancessdk.NewContainerInstanceClientWithConfigurationProvider)
 }
 
 func getContainerInstanceVnicClient(provider common.ConfigurationProvider) (coresdk.VirtualNetworkClient, error) {
-	return coresdk.NewVirtualNetworkClientWithConfigurationProvider(provider)
+	return ociclient.New(provider, coresdk.NewVirtualNetworkClientWithConfigurationProvider)
 }
 
 // getOCIClient returns the injected client if set, otherwise creates one from the provider.
@@ -126,7 +128,7 @@ func (c *ContainerInstanceServiceManager) CreateContainerInstance(ctx context.Co
 	req := containerinstancessdk.CreateContainerInstanceRequest{
 		CreateContainerInstanceDetails: details,
 	}
-	return client.CreateContainerInstance(ctx, req)
+	return metrics.CallOCI(ctx, req, client.CreateContainerInstance)
 }
 
 // GetContainerInstance retrieves a container instance by OCID.
@@ -144,7 +146,7 @@ func (c *ContainerInstanceServiceManager) GetContainerInstance(ctx context.Conte
 		req.RequestMetadata.RetryPolicy = retryPolicy
 	}
 
-	resp, err := client.GetContainerInstance(ctx, req)
+	resp, err := metrics.CallOCI(ctx, req, client.GetContainerInstance)
 	if err != nil {
 		return nil, err
 	}
@@ -175,7 +177,7 @@ func (c *ContainerInstanceServiceManager) GetContainerInstanceOcid(ctx context.C
 				Page:               page,
 			}
 
-			resp, err := client.ListContainerInstances(ctx, req)
+			resp, err := metrics.CallOCI(ctx, req, client.ListContainerInstances)
 			if err != nil {
 				c.Log.ErrorLog(err, "Error listing ContainerInstances")
 				return nil, err
@@ -237,7 +239,7 @@ func (c *ContainerInstanceServiceManager) UpdateContainerInstance(ctx context.Co
 		ContainerInstanceId:            common.String(targetID),
 		UpdateContainerInstanceDetails: updateDetails,
 	}
-	response, err := client.UpdateContainerInstance(ctx, req)
+	response, err := metrics.CallOCI(ctx, req, client.UpdateContainerInstance)
 	if err != nil {
 		servicemanager.RecordErrorOpcRequestID(&ci.Status.OsokStatus, err)
 		return err
@@ -278,7 +280,7 @@ func (c *ContainerInstanceServiceManager) DeleteContainerInstance(ctx context.Co
 	req := containerinstancessdk.DeleteContainerInstanceRequest{
 		ContainerInstanceId: common.String(string(ciID)),
 	}
-	response, err := client.DeleteContainerInstance(ctx, req)
+	response, err := metrics.CallOCI(ctx, req, client.DeleteContainerInstance)
 	if ci != nil {
 		if err != nil {
 			servicemanager.RecordErrorOpcRequestID(&ci.Status.OsokStatus, err)
@@ -741,9 +743,9 @@ func (c *ContainerInstanceServiceManager) validateContainerDrift(ctx context.Con
 			}
 			continue
 		}
-		resp, err := client.GetContainer(ctx, containerinstancessdk.GetContainerRequest{
+		resp, err := metrics.CallOCI(ctx, containerinstancessdk.GetContainerRequest{
 			ContainerId: common.String(containerID),
-		})
+		}, client.GetContainer)
 		if err != nil {
 			return fmt.Errorf("get container %s for create-only drift validation: %w", containerID, err)
 		}
@@ -1050,7 +1052,7 @@ func (c *ContainerInstanceServiceManager) validateVnicDrift(ctx context.Context,
 			}
 			continue
 		}
-		resp, err := client.GetVnic(ctx, coresdk.GetVnicRequest{VnicId: common.String(vnicID)})
+		resp, err := metrics.CallOCI(ctx, coresdk.GetVnicRequest{VnicId: common.String(vnicID)}, client.GetVnic)
 		if err != nil {
 			return fmt.Errorf("get VNIC %s for create-only drift validation: %w", vnicID, err)
 		}
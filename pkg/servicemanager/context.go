@@ -0,0 +1,124 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package servicemanager
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	shared "github.com/oracle/oci-service-operator/pkg/shared"
+)
+
+type observeOnlyContextKey struct{}
+
+// WithObserveOnly marks ctx as read-only. BaseReconciler uses it while a
+// resource is paused so that an in-flight status.async.current work request
+// keeps being polled. Service clients must not issue OCI create, update, or
+// delete calls under such a context; they should only resume the tracked
+// work request and project what they observe into status.
+func WithObserveOnly(ctx context.Context) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, observeOnlyContextKey{}, true)
+}
+
+// IsObserveOnly reports whether ctx was marked by WithObserveOnly.
+func IsObserveOnly(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	observeOnly, _ := ctx.Value(observeOnlyContextKey{}).(bool)
+	return observeOnly
+}
+
+type driftCheckContextKey struct{}
+
+// WithDriftCheck marks ctx as a periodic drift resync of a resource that is
+// already reconciled at its current generation. Service clients compare the
+// live OCI resource against spec and set OSOKResponse.DriftDetected when the
+// mutable fields differ. They only update the OCI resource when autoCorrect
+// is true.
+func WithDriftCheck(ctx context.Context, autoCorrect bool) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, driftCheckContextKey{}, autoCorrect)
+}
+
+// DriftCheck reports whether ctx was marked by WithDriftCheck, and whether
+// drift should be corrected.
+func DriftCheck(ctx context.Context) (resync bool, autoCorrect bool) {
+	if ctx == nil {
+		return false, false
+	}
+	autoCorrect, resync = ctx.Value(driftCheckContextKey{}).(bool)
+	return resync, autoCorrect
+}
+
+type referenceReaderContextKey struct{}
+
+// WithReferenceReader attaches the Kubernetes reader that service clients use
+// to resolve spec *Ref fields to the OCID of the referenced OSOK resource.
+func WithReferenceReader(ctx context.Context, reader client.Reader) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, referenceReaderContextKey{}, reader)
+}
+
+// ReferenceReader returns the reader attached by WithReferenceReader, or nil.
+func ReferenceReader(ctx context.Context) client.Reader {
+	if ctx == nil {
+		return nil
+	}
+	reader, _ := ctx.Value(referenceReaderContextKey{}).(client.Reader)
+	return reader
+}
+
+type replaceStrategyContextKey struct{}
+
+// WithReplaceStrategy opts the resource reconciled under ctx into replacing
+// its OCI resource when a create-only spec field changes. Without it, such a
+// change fails the reconcile.
+func WithReplaceStrategy(ctx context.Context, strategy shared.OSOKReplaceStrategy) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, replaceStrategyContextKey{}, strategy)
+}
+
+// ReplaceStrategy returns the strategy attached by WithReplaceStrategy, or ""
+// when the resource has not opted in.
+func ReplaceStrategy(ctx context.Context) shared.OSOKReplaceStrategy {
+	if ctx == nil {
+		return ""
+	}
+	strategy, _ := ctx.Value(replaceStrategyContextKey{}).(shared.OSOKReplaceStrategy)
+	return strategy
+}
+
+type planContextKey struct{}
+
+// WithPlan runs the reconcile under ctx in plan mode. Service clients still
+// read from OCI and build their requests, but record each create, update, or
+// delete in plan instead of sending it.
+func WithPlan(ctx context.Context, plan *Plan) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, planContextKey{}, plan)
+}
+
+// PlanFrom returns the plan attached by WithPlan, or nil outside plan mode.
+func PlanFrom(ctx context.Context) *Plan {
+	if ctx == nil {
+		return nil
+	}
+	plan, _ := ctx.Value(planContextKey{}).(*Plan)
+	return plan
+}
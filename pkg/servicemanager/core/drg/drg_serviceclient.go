@@ -13,6 +13,7 @@ import (
 
 	coresdk "github.com/oracle/oci-go-sdk/v65/core"
 	corev1beta1 "github.com/oracle/oci-service-operator/api/core/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultDrgServiceClient struct {
 var _ DrgServiceClient = defaultDrgServiceClient{}
 
 var newDrgServiceClient = func(manager *DrgServiceManager) DrgServiceClient {
-	sdkClient, err := coresdk.NewVirtualNetworkClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, coresdk.NewVirtualNetworkClientWithConfigurationProvider)
 	hooks := newDrgRuntimeHooks(manager, sdkClient)
 	config := buildDrgGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -37,6 +37,7 @@ type InstanceRuntimeHooks struct {
 	List                runtimeOperationHooks[coresdk.ListInstancesRequest, coresdk.ListInstancesResponse]
 	Update              runtimeOperationHooks[coresdk.UpdateInstanceRequest, coresdk.UpdateInstanceResponse]
 	Delete              runtimeOperationHooks[coresdk.TerminateInstanceRequest, coresdk.TerminateInstanceResponse]
+	References          []generatedruntime.ReferenceField
 	WrapGeneratedClient []func(InstanceServiceClient) InstanceServiceClient
 }
 
@@ -142,6 +143,10 @@ func newInstanceDefaultRuntimeHooks(sdkClient coresdk.ComputeClient) InstanceRun
 				return sdkClient.TerminateInstance(ctx, request)
 			},
 		},
+		References: []generatedruntime.ReferenceField{
+			{FieldName: "CompartmentId", RefFieldName: "CompartmentRef", APIVersion: "identity.oracle.com/v1beta1", Kind: "Compartment"},
+			{FieldName: "SubnetId", RefFieldName: "SubnetRef", APIVersion: "core.oracle.com/v1beta1", Kind: "Subnet"},
+		},
 		WrapGeneratedClient: []func(InstanceServiceClient) InstanceServiceClient{},
 	}
 }
@@ -13,6 +13,7 @@ import (
 
 	coresdk "github.com/oracle/oci-go-sdk/v65/core"
 	corev1beta1 "github.com/oracle/oci-service-operator/api/core/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultInstanceServiceClient struct {
 var _ InstanceServiceClient = defaultInstanceServiceClient{}
 
 var newInstanceServiceClient = func(manager *InstanceServiceManager) InstanceServiceClient {
-	sdkClient, err := coresdk.NewComputeClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, coresdk.NewComputeClientWithConfigurationProvider)
 	hooks := newInstanceRuntimeHooks(manager, sdkClient)
 	config := buildInstanceGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -42,5 +43,7 @@ var newInstanceServiceClient = func(manager *InstanceServiceManager) InstanceSer
 	delegate := defaultInstanceServiceClient{
 		ServiceClient: generatedruntime.NewServiceClient[*corev1beta1.Instance](config),
 	}
-	return wrapInstanceGeneratedClient(hooks, delegate)
+	return generatedruntime.WithReferenceResolution[*corev1beta1.Instance](
+		"Instance", manager.Log, hooks.References, wrapInstanceGeneratedClient(hooks, delegate),
+	)
 }
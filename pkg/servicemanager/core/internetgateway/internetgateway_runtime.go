@@ -17,6 +17,7 @@ import (
 	coresdk "github.com/oracle/oci-go-sdk/v65/core"
 	corev1beta1 "github.com/oracle/oci-service-operator/api/core/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -139,7 +140,7 @@ func newInternetGatewayRuntimeClient(
 		return runtimeClient
 	}
 
-	sdkClient, err := coresdk.NewVirtualNetworkClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, coresdk.NewVirtualNetworkClientWithConfigurationProvider)
 	runtimeClient.client = sdkClient
 	if err != nil {
 		runtimeClient.initErr = fmt.Errorf("initialize InternetGateway OCI client: %w", err)
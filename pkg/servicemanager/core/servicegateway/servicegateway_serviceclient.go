@@ -13,6 +13,7 @@ import (
 
 	coresdk "github.com/oracle/oci-go-sdk/v65/core"
 	corev1beta1 "github.com/oracle/oci-service-operator/api/core/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultServiceGatewayServiceClient struct {
 var _ ServiceGatewayServiceClient = defaultServiceGatewayServiceClient{}
 
 var newServiceGatewayServiceClient = func(manager *ServiceGatewayServiceManager) ServiceGatewayServiceClient {
-	sdkClient, err := coresdk.NewVirtualNetworkClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, coresdk.NewVirtualNetworkClientWithConfigurationProvider)
 	hooks := newServiceGatewayRuntimeHooks(manager, sdkClient)
 	config := buildServiceGatewayGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
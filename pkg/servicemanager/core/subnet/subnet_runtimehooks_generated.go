@@ -37,6 +37,7 @@ type SubnetRuntimeHooks struct {
 	List                runtimeOperationHooks[coresdk.ListSubnetsRequest, coresdk.ListSubnetsResponse]
 	Update              runtimeOperationHooks[coresdk.UpdateSubnetRequest, coresdk.UpdateSubnetResponse]
 	Delete              runtimeOperationHooks[coresdk.DeleteSubnetRequest, coresdk.DeleteSubnetResponse]
+	References          []generatedruntime.ReferenceField
 	WrapGeneratedClient []func(SubnetServiceClient) SubnetServiceClient
 }
 
@@ -142,6 +143,12 @@ func newSubnetDefaultRuntimeHooks(sdkClient coresdk.VirtualNetworkClient) Subnet
 				return sdkClient.DeleteSubnet(ctx, request)
 			},
 		},
+		References: []generatedruntime.ReferenceField{
+			{FieldName: "CompartmentId", RefFieldName: "CompartmentRef", APIVersion: "identity.oracle.com/v1beta1", Kind: "Compartment"},
+			{FieldName: "VcnId", RefFieldName: "VcnRef", APIVersion: "core.oracle.com/v1beta1", Kind: "Vcn"},
+			{FieldName: "RouteTableId", RefFieldName: "RouteTableRef", APIVersion: "core.oracle.com/v1beta1", Kind: "RouteTable"},
+			{FieldName: "SecurityListIds", RefFieldName: "SecurityListRefs", APIVersion: "core.oracle.com/v1beta1", Kind: "SecurityList"},
+		},
 		WrapGeneratedClient: []func(SubnetServiceClient) SubnetServiceClient{},
 	}
 }
@@ -13,6 +13,7 @@ import (
 
 	coresdk "github.com/oracle/oci-go-sdk/v65/core"
 	corev1beta1 "github.com/oracle/oci-service-operator/api/core/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultSubnetServiceClient struct {
 var _ SubnetServiceClient = defaultSubnetServiceClient{}
 
 var newSubnetServiceClient = func(manager *SubnetServiceManager) SubnetServiceClient {
-	sdkClient, err := coresdk.NewVirtualNetworkClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, coresdk.NewVirtualNetworkClientWithConfigurationProvider)
 	hooks := newSubnetRuntimeHooks(manager, sdkClient)
 	config := buildSubnetGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -42,5 +43,7 @@ var newSubnetServiceClient = func(manager *SubnetServiceManager) SubnetServiceCl
 	delegate := defaultSubnetServiceClient{
 		ServiceClient: generatedruntime.NewServiceClient[*corev1beta1.Subnet](config),
 	}
-	return wrapSubnetGeneratedClient(hooks, delegate)
+	return generatedruntime.WithReferenceResolution[*corev1beta1.Subnet](
+		"Subnet", manager.Log, hooks.References, wrapSubnetGeneratedClient(hooks, delegate),
+	)
 }
@@ -37,6 +37,7 @@ type VcnRuntimeHooks struct {
 	List                runtimeOperationHooks[coresdk.ListVcnsRequest, coresdk.ListVcnsResponse]
 	Update              runtimeOperationHooks[coresdk.UpdateVcnRequest, coresdk.UpdateVcnResponse]
 	Delete              runtimeOperationHooks[coresdk.DeleteVcnRequest, coresdk.DeleteVcnResponse]
+	References          []generatedruntime.ReferenceField
 	WrapGeneratedClient []func(VcnServiceClient) VcnServiceClient
 }
 
@@ -142,6 +143,9 @@ func newVcnDefaultRuntimeHooks(sdkClient coresdk.VirtualNetworkClient) VcnRuntim
 				return sdkClient.DeleteVcn(ctx, request)
 			},
 		},
+		References: []generatedruntime.ReferenceField{
+			{FieldName: "CompartmentId", RefFieldName: "CompartmentRef", APIVersion: "identity.oracle.com/v1beta1", Kind: "Compartment"},
+		},
 		WrapGeneratedClient: []func(VcnServiceClient) VcnServiceClient{},
 	}
 }
@@ -13,6 +13,7 @@ import (
 
 	coresdk "github.com/oracle/oci-go-sdk/v65/core"
 	corev1beta1 "github.com/oracle/oci-service-operator/api/core/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultVcnServiceClient struct {
 var _ VcnServiceClient = defaultVcnServiceClient{}
 
 var newVcnServiceClient = func(manager *VcnServiceManager) VcnServiceClient {
-	sdkClient, err := coresdk.NewVirtualNetworkClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, coresdk.NewVirtualNetworkClientWithConfigurationProvider)
 	hooks := newVcnRuntimeHooks(manager, sdkClient)
 	config := buildVcnGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -42,5 +43,7 @@ var newVcnServiceClient = func(manager *VcnServiceManager) VcnServiceClient {
 	delegate := defaultVcnServiceClient{
 		ServiceClient: generatedruntime.NewServiceClient[*corev1beta1.Vcn](config),
 	}
-	return wrapVcnGeneratedClient(hooks, delegate)
+	return generatedruntime.WithReferenceResolution[*corev1beta1.Vcn](
+		"Vcn", manager.Log, hooks.References, wrapVcnGeneratedClient(hooks, delegate),
+	)
 }
@@ -13,6 +13,7 @@ import (
 
 	dashboardservicesdk "github.com/oracle/oci-go-sdk/v65/dashboardservice"
 	dashboardservicev1beta1 "github.com/oracle/oci-service-operator/api/dashboardservice/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultDashboardGroupServiceClient struct {
 var _ DashboardGroupServiceClient = defaultDashboardGroupServiceClient{}
 
 var newDashboardGroupServiceClient = func(manager *DashboardGroupServiceManager) DashboardGroupServiceClient {
-	sdkClient, err := dashboardservicesdk.NewDashboardGroupClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, dashboardservicesdk.NewDashboardGroupClientWithConfigurationProvider)
 	hooks := newDashboardGroupRuntimeHooks(manager, sdkClient)
 	config := buildDashboardGroupGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
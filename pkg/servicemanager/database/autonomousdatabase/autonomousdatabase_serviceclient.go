@@ -13,6 +13,7 @@ import (
 
 	databasesdk "github.com/oracle/oci-go-sdk/v65/database"
 	databasev1beta1 "github.com/oracle/oci-service-operator/api/database/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultAutonomousDatabaseServiceClient struct {
 var _ AutonomousDatabaseServiceClient = defaultAutonomousDatabaseServiceClient{}
 
 var newAutonomousDatabaseServiceClient = func(manager *AutonomousDatabaseServiceManager) AutonomousDatabaseServiceClient {
-	sdkClient, err := databasesdk.NewDatabaseClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, databasesdk.NewDatabaseClientWithConfigurationProvider)
 	hooks := newAutonomousDatabaseRuntimeHooks(manager, sdkClient)
 	config := buildAutonomousDatabaseGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
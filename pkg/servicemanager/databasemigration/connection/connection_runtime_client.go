@@ -16,6 +16,7 @@ import (
 	databasemigrationsdk "github.com/oracle/oci-go-sdk/v65/databasemigration"
 	databasemigrationv1beta1 "github.com/oracle/oci-service-operator/api/databasemigration/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -57,7 +58,7 @@ func newConnectionSDKClient(manager *ConnectionServiceManager) (connectionOCICli
 		return nil, fmt.Errorf("Connection service manager is nil")
 	}
 
-	client, err := databasemigrationsdk.NewDatabaseMigrationClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, databasemigrationsdk.NewDatabaseMigrationClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -13,6 +13,7 @@ import (
 
 	databasetoolssdk "github.com/oracle/oci-go-sdk/v65/databasetools"
 	databasetoolsv1beta1 "github.com/oracle/oci-service-operator/api/databasetools/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultDatabaseToolsConnectionServiceClient struct {
 var _ DatabaseToolsConnectionServiceClient = defaultDatabaseToolsConnectionServiceClient{}
 
 var newDatabaseToolsConnectionServiceClient = func(manager *DatabaseToolsConnectionServiceManager) DatabaseToolsConnectionServiceClient {
-	sdkClient, err := databasetoolssdk.NewDatabaseToolsClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, databasetoolssdk.NewDatabaseToolsClientWithConfigurationProvider)
 	hooks := newDatabaseToolsConnectionRuntimeHooks(manager, sdkClient)
 	config := buildDatabaseToolsConnectionGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
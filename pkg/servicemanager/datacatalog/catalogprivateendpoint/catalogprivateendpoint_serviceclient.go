@@ -13,6 +13,7 @@ import (
 
 	datacatalogsdk "github.com/oracle/oci-go-sdk/v65/datacatalog"
 	datacatalogv1beta1 "github.com/oracle/oci-service-operator/api/datacatalog/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultCatalogPrivateEndpointServiceClient struct {
 var _ CatalogPrivateEndpointServiceClient = defaultCatalogPrivateEndpointServiceClient{}
 
 var newCatalogPrivateEndpointServiceClient = func(manager *CatalogPrivateEndpointServiceManager) CatalogPrivateEndpointServiceClient {
-	sdkClient, err := datacatalogsdk.NewDataCatalogClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, datacatalogsdk.NewDataCatalogClientWithConfigurationProvider)
 	hooks := newCatalogPrivateEndpointRuntimeHooks(manager, sdkClient)
 	config := buildCatalogPrivateEndpointGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -18,6 +18,7 @@ import (
 	dataflowv1beta1 "github.com/oracle/oci-service-operator/api/dataflow/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -55,7 +56,7 @@ func newApplicationSDKClient(manager *ApplicationServiceManager) (applicationOCI
 	if manager == nil {
 		return nil, fmt.Errorf("Application service manager is nil")
 	}
-	client, err := dataflowsdk.NewDataFlowClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, dataflowsdk.NewDataFlowClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -13,6 +13,7 @@ import (
 
 	dataintegrationsdk "github.com/oracle/oci-go-sdk/v65/dataintegration"
 	dataintegrationv1beta1 "github.com/oracle/oci-service-operator/api/dataintegration/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultCopyObjectRequestServiceClient struct {
 var _ CopyObjectRequestServiceClient = defaultCopyObjectRequestServiceClient{}
 
 var newCopyObjectRequestServiceClient = func(manager *CopyObjectRequestServiceManager) CopyObjectRequestServiceClient {
-	sdkClient, err := dataintegrationsdk.NewDataIntegrationClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, dataintegrationsdk.NewDataIntegrationClientWithConfigurationProvider)
 	hooks := newCopyObjectRequestRuntimeHooks(manager, sdkClient)
 	config := buildCopyObjectRequestGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	datalabelingservicesdk "github.com/oracle/oci-go-sdk/v65/datalabelingservice"
 	datalabelingservicev1beta1 "github.com/oracle/oci-service-operator/api/datalabelingservice/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultDatasetServiceClient struct {
 var _ DatasetServiceClient = defaultDatasetServiceClient{}
 
 var newDatasetServiceClient = func(manager *DatasetServiceManager) DatasetServiceClient {
-	sdkClient, err := datalabelingservicesdk.NewDataLabelingManagementClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, datalabelingservicesdk.NewDataLabelingManagementClientWithConfigurationProvider)
 	hooks := newDatasetRuntimeHooks(manager, sdkClient)
 	config := buildDatasetGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	datalabelingservicedataplanesdk "github.com/oracle/oci-go-sdk/v65/datalabelingservicedataplane"
 	datalabelingservicedataplanev1beta1 "github.com/oracle/oci-service-operator/api/datalabelingservicedataplane/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultAnnotationServiceClient struct {
 var _ AnnotationServiceClient = defaultAnnotationServiceClient{}
 
 var newAnnotationServiceClient = func(manager *AnnotationServiceManager) AnnotationServiceClient {
-	sdkClient, err := datalabelingservicedataplanesdk.NewDataLabelingClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, datalabelingservicedataplanesdk.NewDataLabelingClientWithConfigurationProvider)
 	hooks := newAnnotationRuntimeHooks(manager, sdkClient)
 	config := buildAnnotationGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -20,6 +20,7 @@ import (
 	datasafev1beta1 "github.com/oracle/oci-service-operator/api/datasafe/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
 	"github.com/oracle/oci-service-operator/pkg/util"
@@ -91,7 +92,7 @@ func newAlertPolicyRuleOCIClient(manager *AlertPolicyRuleServiceManager) (alertP
 	if manager == nil {
 		return nil, fmt.Errorf("initialize AlertPolicyRule OCI client: service manager is nil")
 	}
-	client, err := datasafesdk.NewDataSafeClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, datasafesdk.NewDataSafeClientWithConfigurationProvider)
 	if err != nil {
 		return nil, fmt.Errorf("initialize AlertPolicyRule OCI client: %w", err)
 	}
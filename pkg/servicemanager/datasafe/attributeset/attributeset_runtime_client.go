@@ -17,6 +17,7 @@ import (
 	datasafev1beta1 "github.com/oracle/oci-service-operator/api/datasafe/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	"github.com/oracle/oci-service-operator/pkg/shared"
@@ -68,7 +69,7 @@ func newAttributeSetOCIClient(manager *AttributeSetServiceManager) (attributeSet
 	if manager == nil {
 		return nil, fmt.Errorf("AttributeSet service manager is nil")
 	}
-	client, err := datasafesdk.NewDataSafeClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, datasafesdk.NewDataSafeClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
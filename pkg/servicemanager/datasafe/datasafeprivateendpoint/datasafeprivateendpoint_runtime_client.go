@@ -20,6 +20,7 @@ import (
 	datasafev1beta1 "github.com/oracle/oci-service-operator/api/datasafe/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -100,7 +101,7 @@ func newDataSafePrivateEndpointOCIClient(manager *DataSafePrivateEndpointService
 	if manager == nil {
 		return nil, fmt.Errorf("%s service manager is nil", dataSafePrivateEndpointKind)
 	}
-	client, err := datasafesdk.NewDataSafeClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, datasafesdk.NewDataSafeClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -18,6 +18,7 @@ import (
 	datasafev1beta1 "github.com/oracle/oci-service-operator/api/datasafe/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -90,7 +91,7 @@ func newMaskingColumnWorkRequestClient(manager *MaskingColumnServiceManager) (ma
 	if manager == nil {
 		return nil, fmt.Errorf("MaskingColumn service manager is nil")
 	}
-	client, err := datasafesdk.NewDataSafeClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, datasafesdk.NewDataSafeClientWithConfigurationProvider)
 	if err != nil {
 		return nil, fmt.Errorf("initialize MaskingColumn work request OCI client: %w", err)
 	}
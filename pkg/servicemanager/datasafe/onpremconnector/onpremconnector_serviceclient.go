@@ -13,6 +13,7 @@ import (
 
 	datasafesdk "github.com/oracle/oci-go-sdk/v65/datasafe"
 	datasafev1beta1 "github.com/oracle/oci-service-operator/api/datasafe/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultOnPremConnectorServiceClient struct {
 var _ OnPremConnectorServiceClient = defaultOnPremConnectorServiceClient{}
 
 var newOnPremConnectorServiceClient = func(manager *OnPremConnectorServiceManager) OnPremConnectorServiceClient {
-	sdkClient, err := datasafesdk.NewDataSafeClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, datasafesdk.NewDataSafeClientWithConfigurationProvider)
 	hooks := newOnPremConnectorRuntimeHooks(manager, sdkClient)
 	config := buildOnPremConnectorGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
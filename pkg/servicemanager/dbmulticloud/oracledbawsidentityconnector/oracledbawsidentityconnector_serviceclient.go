@@ -13,6 +13,7 @@ import (
 
 	dbmulticloudsdk "github.com/oracle/oci-go-sdk/v65/dbmulticloud"
 	dbmulticloudv1beta1 "github.com/oracle/oci-service-operator/api/dbmulticloud/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultOracleDbAwsIdentityConnectorServiceClient struct {
 var _ OracleDbAwsIdentityConnectorServiceClient = defaultOracleDbAwsIdentityConnectorServiceClient{}
 
 var newOracleDbAwsIdentityConnectorServiceClient = func(manager *OracleDbAwsIdentityConnectorServiceManager) OracleDbAwsIdentityConnectorServiceClient {
-	sdkClient, err := dbmulticloudsdk.NewDbMulticloudAwsProviderClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, dbmulticloudsdk.NewDbMulticloudAwsProviderClientWithConfigurationProvider)
 	hooks := newOracleDbAwsIdentityConnectorRuntimeHooks(manager, sdkClient)
 	config := buildOracleDbAwsIdentityConnectorGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	dbmulticloudsdk "github.com/oracle/oci-go-sdk/v65/dbmulticloud"
 	dbmulticloudv1beta1 "github.com/oracle/oci-service-operator/api/dbmulticloud/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultOracleDbAzureBlobContainerServiceClient struct {
 var _ OracleDbAzureBlobContainerServiceClient = defaultOracleDbAzureBlobContainerServiceClient{}
 
 var newOracleDbAzureBlobContainerServiceClient = func(manager *OracleDbAzureBlobContainerServiceManager) OracleDbAzureBlobContainerServiceClient {
-	sdkClient, err := dbmulticloudsdk.NewOracleDBAzureBlobContainerClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, dbmulticloudsdk.NewOracleDBAzureBlobContainerClientWithConfigurationProvider)
 	hooks := newOracleDbAzureBlobContainerRuntimeHooks(manager, sdkClient)
 	config := buildOracleDbAzureBlobContainerGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	dbmulticloudsdk "github.com/oracle/oci-go-sdk/v65/dbmulticloud"
 	dbmulticloudv1beta1 "github.com/oracle/oci-service-operator/api/dbmulticloud/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultOracleDbAzureConnectorServiceClient struct {
 var _ OracleDbAzureConnectorServiceClient = defaultOracleDbAzureConnectorServiceClient{}
 
 var newOracleDbAzureConnectorServiceClient = func(manager *OracleDbAzureConnectorServiceManager) OracleDbAzureConnectorServiceClient {
-	sdkClient, err := dbmulticloudsdk.NewOracleDBAzureConnectorClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, dbmulticloudsdk.NewOracleDBAzureConnectorClientWithConfigurationProvider)
 	hooks := newOracleDbAzureConnectorRuntimeHooks(manager, sdkClient)
 	config := buildOracleDbAzureConnectorGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
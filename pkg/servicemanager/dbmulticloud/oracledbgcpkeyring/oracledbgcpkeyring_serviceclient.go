@@ -13,6 +13,7 @@ import (
 
 	dbmulticloudsdk "github.com/oracle/oci-go-sdk/v65/dbmulticloud"
 	dbmulticloudv1beta1 "github.com/oracle/oci-service-operator/api/dbmulticloud/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultOracleDbGcpKeyRingServiceClient struct {
 var _ OracleDbGcpKeyRingServiceClient = defaultOracleDbGcpKeyRingServiceClient{}
 
 var newOracleDbGcpKeyRingServiceClient = func(manager *OracleDbGcpKeyRingServiceManager) OracleDbGcpKeyRingServiceClient {
-	sdkClient, err := dbmulticloudsdk.NewDbMulticloudGCPProviderClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, dbmulticloudsdk.NewDbMulticloudGCPProviderClientWithConfigurationProvider)
 	hooks := newOracleDbGcpKeyRingRuntimeHooks(manager, sdkClient)
 	config := buildOracleDbGcpKeyRingGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -18,6 +18,7 @@ import (
 	delegateaccesscontrolsdk "github.com/oracle/oci-go-sdk/v65/delegateaccesscontrol"
 	delegateaccesscontrolv1beta1 "github.com/oracle/oci-service-operator/api/delegateaccesscontrol/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -91,7 +92,7 @@ func newDelegationControlSDKClient(manager *DelegationControlServiceManager) (de
 		return nil, fmt.Errorf("DelegationControl service manager is nil")
 	}
 
-	client, err := delegateaccesscontrolsdk.NewDelegateAccessControlClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, delegateaccesscontrolsdk.NewDelegateAccessControlClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -105,7 +106,7 @@ func newDelegationControlWorkRequestClient(
 		return nil, fmt.Errorf("DelegationControl service manager is nil")
 	}
 
-	client, err := delegateaccesscontrolsdk.NewWorkRequestClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, delegateaccesscontrolsdk.NewWorkRequestClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -13,6 +13,7 @@ import (
 
 	delegateaccesscontrolsdk "github.com/oracle/oci-go-sdk/v65/delegateaccesscontrol"
 	delegateaccesscontrolv1beta1 "github.com/oracle/oci-service-operator/api/delegateaccesscontrol/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultDelegationControlServiceClient struct {
 var _ DelegationControlServiceClient = defaultDelegationControlServiceClient{}
 
 var newDelegationControlServiceClient = func(manager *DelegationControlServiceManager) DelegationControlServiceClient {
-	sdkClient, err := delegateaccesscontrolsdk.NewDelegateAccessControlClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, delegateaccesscontrolsdk.NewDelegateAccessControlClientWithConfigurationProvider)
 	hooks := newDelegationControlRuntimeHooks(manager, sdkClient)
 	config := buildDelegationControlGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	demandsignalsdk "github.com/oracle/oci-go-sdk/v65/demandsignal"
 	demandsignalv1beta1 "github.com/oracle/oci-service-operator/api/demandsignal/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultOccDemandSignalServiceClient struct {
 var _ OccDemandSignalServiceClient = defaultOccDemandSignalServiceClient{}
 
 var newOccDemandSignalServiceClient = func(manager *OccDemandSignalServiceManager) OccDemandSignalServiceClient {
-	sdkClient, err := demandsignalsdk.NewOccDemandSignalClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, demandsignalsdk.NewOccDemandSignalClientWithConfigurationProvider)
 	hooks := newOccDemandSignalRuntimeHooks(manager, sdkClient)
 	config := buildOccDemandSignalGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	desktopssdk "github.com/oracle/oci-go-sdk/v65/desktops"
 	desktopsv1beta1 "github.com/oracle/oci-service-operator/api/desktops/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultDesktopPoolServiceClient struct {
 var _ DesktopPoolServiceClient = defaultDesktopPoolServiceClient{}
 
 var newDesktopPoolServiceClient = func(manager *DesktopPoolServiceManager) DesktopPoolServiceClient {
-	sdkClient, err := desktopssdk.NewDesktopServiceClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, desktopssdk.NewDesktopServiceClientWithConfigurationProvider)
 	hooks := newDesktopPoolRuntimeHooks(manager, sdkClient)
 	config := buildDesktopPoolGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -21,6 +21,7 @@ import (
 	devopsv1beta1 "github.com/oracle/oci-service-operator/api/devops/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -92,7 +93,7 @@ func newBuildPipelineSDKClient(manager *BuildPipelineServiceManager) (buildPipel
 	if manager == nil {
 		return nil, fmt.Errorf("BuildPipeline service manager is nil")
 	}
-	client, err := devopssdk.NewDevopsClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, devopssdk.NewDevopsClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -13,6 +13,7 @@ import (
 
 	devopssdk "github.com/oracle/oci-go-sdk/v65/devops"
 	devopsv1beta1 "github.com/oracle/oci-service-operator/api/devops/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultBuildPipelineServiceClient struct {
 var _ BuildPipelineServiceClient = defaultBuildPipelineServiceClient{}
 
 var newBuildPipelineServiceClient = func(manager *BuildPipelineServiceManager) BuildPipelineServiceClient {
-	sdkClient, err := devopssdk.NewDevopsClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, devopssdk.NewDevopsClientWithConfigurationProvider)
 	hooks := newBuildPipelineRuntimeHooks(manager, sdkClient)
 	config := buildBuildPipelineGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -17,6 +17,7 @@ import (
 	devopsv1beta1 "github.com/oracle/oci-service-operator/api/devops/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -83,7 +84,7 @@ func newDeployArtifactSDKClient(manager *DeployArtifactServiceManager) (deployAr
 	if manager == nil {
 		return nil, fmt.Errorf("%s service manager is nil", deployArtifactKind)
 	}
-	client, err := devopssdk.NewDevopsClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, devopssdk.NewDevopsClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -13,6 +13,7 @@ import (
 
 	devopssdk "github.com/oracle/oci-go-sdk/v65/devops"
 	devopsv1beta1 "github.com/oracle/oci-service-operator/api/devops/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultDeployArtifactServiceClient struct {
 var _ DeployArtifactServiceClient = defaultDeployArtifactServiceClient{}
 
 var newDeployArtifactServiceClient = func(manager *DeployArtifactServiceManager) DeployArtifactServiceClient {
-	sdkClient, err := devopssdk.NewDevopsClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, devopssdk.NewDevopsClientWithConfigurationProvider)
 	hooks := newDeployArtifactRuntimeHooks(manager, sdkClient)
 	config := buildDeployArtifactGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -17,6 +17,7 @@ import (
 	devopsv1beta1 "github.com/oracle/oci-service-operator/api/devops/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -76,7 +77,7 @@ func newProjectSDKClient(manager *ProjectServiceManager) (projectOCIClient, erro
 	if manager == nil {
 		return nil, fmt.Errorf("Project service manager is nil")
 	}
-	client, err := devopssdk.NewDevopsClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, devopssdk.NewDevopsClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
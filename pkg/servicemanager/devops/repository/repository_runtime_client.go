@@ -16,6 +16,7 @@ import (
 	devopsv1beta1 "github.com/oracle/oci-service-operator/api/devops/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -64,7 +65,7 @@ func newRepositoryWorkRequestClient(manager *RepositoryServiceManager) (reposito
 	if manager == nil {
 		return nil, fmt.Errorf("repository service manager is nil")
 	}
-	client, err := devopssdk.NewDevopsClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, devopssdk.NewDevopsClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -13,6 +13,7 @@ import (
 
 	difsdk "github.com/oracle/oci-go-sdk/v65/dif"
 	difv1beta1 "github.com/oracle/oci-service-operator/api/dif/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultStackServiceClient struct {
 var _ StackServiceClient = defaultStackServiceClient{}
 
 var newStackServiceClient = func(manager *StackServiceManager) StackServiceClient {
-	sdkClient, err := difsdk.NewStackClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, difsdk.NewStackClientWithConfigurationProvider)
 	hooks := newStackRuntimeHooks(manager, sdkClient)
 	config := buildStackGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	dnssdk "github.com/oracle/oci-go-sdk/v65/dns"
 	dnsv1beta1 "github.com/oracle/oci-service-operator/api/dns/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultSteeringPolicyServiceClient struct {
 var _ SteeringPolicyServiceClient = defaultSteeringPolicyServiceClient{}
 
 var newSteeringPolicyServiceClient = func(manager *SteeringPolicyServiceManager) SteeringPolicyServiceClient {
-	sdkClient, err := dnssdk.NewDnsClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, dnssdk.NewDnsClientWithConfigurationProvider)
 	hooks := newSteeringPolicyRuntimeHooks(manager, sdkClient)
 	config := buildSteeringPolicyGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -15,6 +15,7 @@ import (
 	dnssdk "github.com/oracle/oci-go-sdk/v65/dns"
 	dnsv1beta1 "github.com/oracle/oci-service-operator/api/dns/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 )
@@ -52,7 +53,7 @@ func newSteeringPolicyAttachmentSDKClient(manager *SteeringPolicyAttachmentServi
 	if manager == nil {
 		return nil, fmt.Errorf("SteeringPolicyAttachment service manager is nil")
 	}
-	client, err := dnssdk.NewDnsClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, dnssdk.NewDnsClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
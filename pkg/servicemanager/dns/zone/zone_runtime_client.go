@@ -20,6 +20,7 @@ import (
 	dnsv1beta1 "github.com/oracle/oci-service-operator/api/dns/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -64,7 +65,7 @@ func newZoneSDKClient(manager *ZoneServiceManager) (zoneOCIClient, error) {
 	if manager == nil {
 		return nil, fmt.Errorf("zone service manager is nil")
 	}
-	client, err := dnssdk.NewDnsClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, dnssdk.NewDnsClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -13,6 +13,7 @@ import (
 
 	emailsdk "github.com/oracle/oci-go-sdk/v65/email"
 	emailv1beta1 "github.com/oracle/oci-service-operator/api/email/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultDkimServiceClient struct {
 var _ DkimServiceClient = defaultDkimServiceClient{}
 
 var newDkimServiceClient = func(manager *DkimServiceManager) DkimServiceClient {
-	sdkClient, err := emailsdk.NewEmailClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, emailsdk.NewEmailClientWithConfigurationProvider)
 	hooks := newDkimRuntimeHooks(manager, sdkClient)
 	config := buildDkimGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
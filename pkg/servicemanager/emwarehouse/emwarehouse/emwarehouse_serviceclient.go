@@ -13,6 +13,7 @@ import (
 
 	emwarehousesdk "github.com/oracle/oci-go-sdk/v65/emwarehouse"
 	emwarehousev1beta1 "github.com/oracle/oci-service-operator/api/emwarehouse/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultEmWarehouseServiceClient struct {
 var _ EmWarehouseServiceClient = defaultEmWarehouseServiceClient{}
 
 var newEmWarehouseServiceClient = func(manager *EmWarehouseServiceManager) EmWarehouseServiceClient {
-	sdkClient, err := emwarehousesdk.NewEmWarehouseClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, emwarehousesdk.NewEmWarehouseClientWithConfigurationProvider)
 	hooks := newEmWarehouseRuntimeHooks(manager, sdkClient)
 	config := buildEmWarehouseGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
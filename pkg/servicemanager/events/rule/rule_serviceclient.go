@@ -13,6 +13,7 @@ import (
 
 	eventssdk "github.com/oracle/oci-go-sdk/v65/events"
 	eventsv1beta1 "github.com/oracle/oci-service-operator/api/events/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultRuleServiceClient struct {
 var _ RuleServiceClient = defaultRuleServiceClient{}
 
 var newRuleServiceClient = func(manager *RuleServiceManager) RuleServiceClient {
-	sdkClient, err := eventssdk.NewEventsClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, eventssdk.NewEventsClientWithConfigurationProvider)
 	hooks := newRuleRuntimeHooks(manager, sdkClient)
 	config := buildRuleGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
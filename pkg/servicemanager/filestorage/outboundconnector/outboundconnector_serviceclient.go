@@ -13,6 +13,7 @@ import (
 
 	filestoragesdk "github.com/oracle/oci-go-sdk/v65/filestorage"
 	filestoragev1beta1 "github.com/oracle/oci-service-operator/api/filestorage/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultOutboundConnectorServiceClient struct {
 var _ OutboundConnectorServiceClient = defaultOutboundConnectorServiceClient{}
 
 var newOutboundConnectorServiceClient = func(manager *OutboundConnectorServiceManager) OutboundConnectorServiceClient {
-	sdkClient, err := filestoragesdk.NewFileStorageClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, filestoragesdk.NewFileStorageClientWithConfigurationProvider)
 	hooks := newOutboundConnectorRuntimeHooks(manager, sdkClient)
 	config := buildOutboundConnectorGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
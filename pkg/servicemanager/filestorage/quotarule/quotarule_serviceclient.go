@@ -13,6 +13,7 @@ import (
 
 	filestoragesdk "github.com/oracle/oci-go-sdk/v65/filestorage"
 	filestoragev1beta1 "github.com/oracle/oci-service-operator/api/filestorage/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultQuotaRuleServiceClient struct {
 var _ QuotaRuleServiceClient = defaultQuotaRuleServiceClient{}
 
 var newQuotaRuleServiceClient = func(manager *QuotaRuleServiceManager) QuotaRuleServiceClient {
-	sdkClient, err := filestoragesdk.NewFileStorageClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, filestoragesdk.NewFileStorageClientWithConfigurationProvider)
 	hooks := newQuotaRuleRuntimeHooks(manager, sdkClient)
 	config := buildQuotaRuleGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
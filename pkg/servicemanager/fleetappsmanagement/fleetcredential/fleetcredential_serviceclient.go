@@ -13,6 +13,7 @@ import (
 
 	fleetappsmanagementsdk "github.com/oracle/oci-go-sdk/v65/fleetappsmanagement"
 	fleetappsmanagementv1beta1 "github.com/oracle/oci-service-operator/api/fleetappsmanagement/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultFleetCredentialServiceClient struct {
 var _ FleetCredentialServiceClient = defaultFleetCredentialServiceClient{}
 
 var newFleetCredentialServiceClient = func(manager *FleetCredentialServiceManager) FleetCredentialServiceClient {
-	sdkClient, err := fleetappsmanagementsdk.NewFleetAppsManagementClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, fleetappsmanagementsdk.NewFleetAppsManagementClientWithConfigurationProvider)
 	hooks := newFleetCredentialRuntimeHooks(manager, sdkClient)
 	config := buildFleetCredentialGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	fleetappsmanagementsdk "github.com/oracle/oci-go-sdk/v65/fleetappsmanagement"
 	fleetappsmanagementv1beta1 "github.com/oracle/oci-service-operator/api/fleetappsmanagement/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultPlatformConfigurationServiceClient struct {
 var _ PlatformConfigurationServiceClient = defaultPlatformConfigurationServiceClient{}
 
 var newPlatformConfigurationServiceClient = func(manager *PlatformConfigurationServiceManager) PlatformConfigurationServiceClient {
-	sdkClient, err := fleetappsmanagementsdk.NewFleetAppsManagementAdminClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, fleetappsmanagementsdk.NewFleetAppsManagementAdminClientWithConfigurationProvider)
 	hooks := newPlatformConfigurationRuntimeHooks(manager, sdkClient)
 	config := buildPlatformConfigurationGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	fleetsoftwareupdatesdk "github.com/oracle/oci-go-sdk/v65/fleetsoftwareupdate"
 	fleetsoftwareupdatev1beta1 "github.com/oracle/oci-service-operator/api/fleetsoftwareupdate/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultFsuCollectionServiceClient struct {
 var _ FsuCollectionServiceClient = defaultFsuCollectionServiceClient{}
 
 var newFsuCollectionServiceClient = func(manager *FsuCollectionServiceManager) FsuCollectionServiceClient {
-	sdkClient, err := fleetsoftwareupdatesdk.NewFleetSoftwareUpdateClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, fleetsoftwareupdatesdk.NewFleetSoftwareUpdateClientWithConfigurationProvider)
 	hooks := newFsuCollectionRuntimeHooks(manager, sdkClient)
 	config := buildFsuCollectionGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -14,6 +14,8 @@ import (
 	ocifunctions "github.com/oracle/oci-go-sdk/v65/functions"
 	functionsv1beta1 "github.com/oracle/oci-service-operator/api/functions/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/credhelper"
+	"github.com/oracle/oci-service-operator/pkg/metrics"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	"github.com/oracle/oci-service-operator/pkg/shared"
 )
@@ -33,7 +35,7 @@ type FunctionsManagementClientInterface interface {
 }
 
 func getFunctionsManagementClient(provider common.ConfigurationProvider) (ocifunctions.FunctionsManagementClient, error) {
-	return ocifunctions.NewFunctionsManagementClientWithConfigurationProvider(provider)
+	return ociclient.New(provider, ocifunctions.NewFunctionsManagementClientWithConfigurationProvider)
 }
 
 func (m *FunctionsApplicationServiceManager) getOCIClient() (FunctionsManagementClientInterface, error) {
@@ -57,9 +59,9 @@ func (m *FunctionsApplicationServiceManager) CreateApplication(
 		return ocifunctions.CreateApplicationResponse{}, fmt.Errorf("build Functions Application create details: %w", err)
 	}
 
-	response, err := client.CreateApplication(ctx, ocifunctions.CreateApplicationRequest{
+	response, err := metrics.CallOCI(ctx, ocifunctions.CreateApplicationRequest{
 		CreateApplicationDetails: details,
-	})
+	}, client.CreateApplication)
 	if err != nil {
 		servicemanager.RecordErrorOpcRequestID(&resource.Status.OsokStatus, err)
 		return ocifunctions.CreateApplicationResponse{}, err
@@ -89,7 +91,7 @@ func (m *FunctionsApplicationServiceManager) GetApplication(
 		request.RequestMetadata.RetryPolicy = retryPolicy
 	}
 
-	response, err := client.GetApplication(ctx, request)
+	response, err := metrics.CallOCI(ctx, request, client.GetApplication)
 	if err != nil {
 		return nil, err
 	}
@@ -105,11 +107,11 @@ func (m *FunctionsApplicationServiceManager) FindApplication(
 		return nil, err
 	}
 
-	response, err := client.ListApplications(ctx, ocifunctions.ListApplicationsRequest{
+	response, err := metrics.CallOCI(ctx, ocifunctions.ListApplicationsRequest{
 		CompartmentId: common.String(resource.Spec.CompartmentId),
 		DisplayName:   common.String(resource.Spec.DisplayName),
 		Limit:         common.Int(1),
-	})
+	}, client.ListApplications)
 	if err != nil {
 		return nil, err
 	}
@@ -173,10 +175,10 @@ func (m *FunctionsApplicationServiceManager) UpdateApplication(
 		return nil, false, fmt.Errorf("application update requires a tracked application id")
 	}
 
-	response, err := client.UpdateApplication(ctx, ocifunctions.UpdateApplicationRequest{
+	response, err := metrics.CallOCI(ctx, ocifunctions.UpdateApplicationRequest{
 		ApplicationId:            common.String(string(applicationID)),
 		UpdateApplicationDetails: details,
-	})
+	}, client.UpdateApplication)
 	if err != nil {
 		servicemanager.RecordErrorOpcRequestID(&resource.Status.OsokStatus, err)
 		return nil, false, err
@@ -195,9 +197,9 @@ func (m *FunctionsApplicationServiceManager) DeleteApplication(ctx context.Conte
 		return err
 	}
 
-	response, err := client.DeleteApplication(ctx, ocifunctions.DeleteApplicationRequest{
+	response, err := metrics.CallOCI(ctx, ocifunctions.DeleteApplicationRequest{
 		ApplicationId: common.String(string(applicationID)),
-	})
+	}, client.DeleteApplication)
 	if resource != nil {
 		if err != nil {
 			servicemanager.RecordErrorOpcRequestID(&resource.Status.OsokStatus, err)
@@ -14,6 +14,7 @@ import (
 	ocifunctions "github.com/oracle/oci-go-sdk/v65/functions"
 	functionsv1beta1 "github.com/oracle/oci-service-operator/api/functions/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/credhelper"
+	"github.com/oracle/oci-service-operator/pkg/metrics"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	"github.com/oracle/oci-service-operator/pkg/shared"
 )
@@ -39,9 +40,9 @@ func (m *FunctionsFunctionServiceManager) CreateFunction(
 		return ocifunctions.CreateFunctionResponse{}, fmt.Errorf("build Functions Function create details: %w", err)
 	}
 
-	response, err := client.CreateFunction(ctx, ocifunctions.CreateFunctionRequest{
+	response, err := metrics.CallOCI(ctx, ocifunctions.CreateFunctionRequest{
 		CreateFunctionDetails: details,
-	})
+	}, client.CreateFunction)
 	if err != nil {
 		servicemanager.RecordErrorOpcRequestID(&resource.Status.OsokStatus, err)
 		return ocifunctions.CreateFunctionResponse{}, err
@@ -71,7 +72,7 @@ func (m *FunctionsFunctionServiceManager) GetFunction(
 		request.RequestMetadata.RetryPolicy = retryPolicy
 	}
 
-	response, err := client.GetFunction(ctx, request)
+	response, err := metrics.CallOCI(ctx, request, client.GetFunction)
 	if err != nil {
 		return nil, err
 	}
@@ -94,7 +95,7 @@ func (m *FunctionsFunctionServiceManager) FindFunction(
 	}
 
 	for {
-		response, err := client.ListFunctions(ctx, request)
+		response, err := metrics.CallOCI(ctx, request, client.ListFunctions)
 		if err != nil {
 			return nil, err
 		}
@@ -224,10 +225,10 @@ func (m *FunctionsFunctionServiceManager) UpdateFunction(
 		return nil, false, fmt.Errorf("function update requires a tracked function id")
 	}
 
-	response, err := client.UpdateFunction(ctx, ocifunctions.UpdateFunctionRequest{
+	response, err := metrics.CallOCI(ctx, ocifunctions.UpdateFunctionRequest{
 		FunctionId:            common.String(string(functionID)),
 		UpdateFunctionDetails: details,
-	})
+	}, client.UpdateFunction)
 	if err != nil {
 		servicemanager.RecordErrorOpcRequestID(&resource.Status.OsokStatus, err)
 		return nil, false, err
@@ -246,9 +247,9 @@ func (m *FunctionsFunctionServiceManager) DeleteFunction(ctx context.Context, re
 		return err
 	}
 
-	response, err := client.DeleteFunction(ctx, ocifunctions.DeleteFunctionRequest{
+	response, err := metrics.CallOCI(ctx, ocifunctions.DeleteFunctionRequest{
 		FunctionId: common.String(string(functionID)),
-	})
+	}, client.DeleteFunction)
 	if resource != nil {
 		if err != nil {
 			servicemanager.RecordErrorOpcRequestID(&resource.Status.OsokStatus, err)
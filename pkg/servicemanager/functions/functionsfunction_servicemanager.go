@@ -29,6 +29,8 @@ const functionsFunctionSecretOwnerUIDLabel = "functions.oracle.com/function-uid"
 // Compile-time check that FunctionsFunctionServiceManager implements OSOKServiceManager.
 var _ servicemanager.OSOKServiceManager = (*FunctionsFunctionServiceManager)(nil)
 
+var _ servicemanager.OSOKOrphanCleaner = (*FunctionsFunctionServiceManager)(nil)
+
 // FunctionsFunctionServiceManager implements OSOKServiceManager for OCI Functions Functions.
 type FunctionsFunctionServiceManager struct {
 	Provider         common.ConfigurationProvider
@@ -265,6 +267,16 @@ func (m *FunctionsFunctionServiceManager) Delete(ctx context.Context, obj runtim
 	return false, nil
 }
 
+// CleanupOrphaned removes the invoke endpoint secret when a deletion policy
+// leaves the OCI Function in place.
+func (m *FunctionsFunctionServiceManager) CleanupOrphaned(ctx context.Context, obj runtime.Object) error {
+	resource, err := m.convert(obj)
+	if err != nil {
+		return err
+	}
+	return m.deleteFunctionSecret(ctx, resource)
+}
+
 func (m *FunctionsFunctionServiceManager) markFunctionDeleted(
 	ctx context.Context,
 	resource *functionsv1beta1.Function,
@@ -312,12 +324,16 @@ func (m *FunctionsFunctionServiceManager) syncFunctionSecret(
 	if err != nil {
 		return err
 	}
+	desiredData, err = servicemanager.RenderConnectionSecretData(resource.Spec.WriteConnectionSecretToRef, desiredData)
+	if err != nil {
+		return err
+	}
 
 	recordReader, ok := m.CredentialClient.(credhelper.SecretRecordReader)
 	if !ok {
-		_, err := m.CredentialClient.CreateSecret(ctx, resource.Name, resource.Namespace, ownerLabels, desiredData)
+		_, err := m.CredentialClient.CreateSecret(ctx, functionSecretName(resource), resource.Namespace, ownerLabels, desiredData)
 		if apierrors.IsAlreadyExists(err) {
-			_, err = m.CredentialClient.UpdateSecret(ctx, resource.Name, resource.Namespace, ownerLabels, desiredData)
+			_, err = m.CredentialClient.UpdateSecret(ctx, functionSecretName(resource), resource.Namespace, ownerLabels, desiredData)
 		}
 		return err
 	}
@@ -327,7 +343,7 @@ func (m *FunctionsFunctionServiceManager) syncFunctionSecret(
 		return fmt.Errorf("function endpoint secret ownership checks require guarded secret mutations")
 	}
 
-	currentRecord, err := recordReader.GetSecretRecord(ctx, resource.Name, resource.Namespace)
+	currentRecord, err := recordReader.GetSecretRecord(ctx, functionSecretName(resource), resource.Namespace)
 	if err == nil {
 		return m.syncExistingFunctionSecret(ctx, resource, currentRecord, ownerLabels, desiredData, guardedMutator)
 	}
@@ -335,12 +351,12 @@ func (m *FunctionsFunctionServiceManager) syncFunctionSecret(
 		return err
 	}
 
-	_, err = m.CredentialClient.CreateSecret(ctx, resource.Name, resource.Namespace, ownerLabels, desiredData)
+	_, err = m.CredentialClient.CreateSecret(ctx, functionSecretName(resource), resource.Namespace, ownerLabels, desiredData)
 	switch {
 	case err == nil:
 		return nil
 	case apierrors.IsAlreadyExists(err):
-		currentRecord, rereadErr := recordReader.GetSecretRecord(ctx, resource.Name, resource.Namespace)
+		currentRecord, rereadErr := recordReader.GetSecretRecord(ctx, functionSecretName(resource), resource.Namespace)
 		if rereadErr != nil {
 			return rereadErr
 		}
@@ -366,15 +382,16 @@ func (m *FunctionsFunctionServiceManager) syncExistingFunctionSecret(
 		return fmt.Errorf(
 			"function endpoint secret %s/%s is not owned by Function UID %q",
 			resource.Namespace,
-			resource.Name,
+			functionSecretName(resource),
 			resource.UID,
 		)
 	}
-	if reflect.DeepEqual(currentRecord.Data, desiredData) {
+	if reflect.DeepEqual(currentRecord.Data, desiredData) &&
+		servicemanager.ConnectionSecretLabelsCurrent(currentRecord.Labels, ownerLabels) {
 		return nil
 	}
 
-	_, err = guardedMutator.UpdateSecretIfCurrent(ctx, resource.Name, resource.Namespace, currentRecord, ownerLabels, desiredData)
+	_, err = guardedMutator.UpdateSecretIfCurrent(ctx, functionSecretName(resource), resource.Namespace, currentRecord, ownerLabels, desiredData)
 	return err
 }
 
@@ -388,14 +405,14 @@ func (m *FunctionsFunctionServiceManager) deleteFunctionSecret(
 
 	recordReader, ok := m.CredentialClient.(credhelper.SecretRecordReader)
 	if !ok {
-		_, err := m.CredentialClient.DeleteSecret(ctx, resource.Name, resource.Namespace)
+		_, err := m.CredentialClient.DeleteSecret(ctx, functionSecretName(resource), resource.Namespace)
 		if apierrors.IsNotFound(err) {
 			return nil
 		}
 		return err
 	}
 
-	currentRecord, err := recordReader.GetSecretRecord(ctx, resource.Name, resource.Namespace)
+	currentRecord, err := recordReader.GetSecretRecord(ctx, functionSecretName(resource), resource.Namespace)
 	switch {
 	case apierrors.IsNotFound(err):
 		return nil
@@ -413,14 +430,14 @@ func (m *FunctionsFunctionServiceManager) deleteFunctionSecret(
 
 	guardedMutator, ok := m.CredentialClient.(credhelper.GuardedSecretMutator)
 	if ok {
-		_, err = guardedMutator.DeleteSecretIfCurrent(ctx, resource.Name, resource.Namespace, currentRecord)
+		_, err = guardedMutator.DeleteSecretIfCurrent(ctx, functionSecretName(resource), resource.Namespace, currentRecord)
 		if apierrors.IsNotFound(err) {
 			return nil
 		}
 		return err
 	}
 
-	_, err = m.CredentialClient.DeleteSecret(ctx, resource.Name, resource.Namespace)
+	_, err = m.CredentialClient.DeleteSecret(ctx, functionSecretName(resource), resource.Namespace)
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
@@ -434,9 +451,15 @@ func functionSecretLabels(resource *functionsv1beta1.Function) (map[string]strin
 	if strings.TrimSpace(string(resource.UID)) == "" {
 		return nil, fmt.Errorf("function endpoint secret requires a Function UID")
 	}
-	return map[string]string{
+	return servicemanager.ConnectionSecretLabels(resource.Spec.WriteConnectionSecretToRef, map[string]string{
 		functionsFunctionSecretOwnerUIDLabel: string(resource.UID),
-	}, nil
+	}), nil
+}
+
+// functionSecretName returns the name of the invoke endpoint Secret,
+// spec.writeConnectionSecretToRef.name when set.
+func functionSecretName(resource *functionsv1beta1.Function) string {
+	return servicemanager.ConnectionSecretName(resource.Spec.WriteConnectionSecretToRef, resource.Name)
 }
 
 func functionOwnsSecret(resource *functionsv1beta1.Function, labels map[string]string) (bool, error) {
@@ -13,6 +13,7 @@ import (
 
 	fusionappssdk "github.com/oracle/oci-go-sdk/v65/fusionapps"
 	fusionappsv1beta1 "github.com/oracle/oci-service-operator/api/fusionapps/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultFusionEnvironmentServiceClient struct {
 var _ FusionEnvironmentServiceClient = defaultFusionEnvironmentServiceClient{}
 
 var newFusionEnvironmentServiceClient = func(manager *FusionEnvironmentServiceManager) FusionEnvironmentServiceClient {
-	sdkClient, err := fusionappssdk.NewFusionApplicationsClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, fusionappssdk.NewFusionApplicationsClientWithConfigurationProvider)
 	hooks := newFusionEnvironmentRuntimeHooks(manager, sdkClient)
 	config := buildFusionEnvironmentGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -0,0 +1,96 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package generatedruntime
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	shared "github.com/oracle/oci-service-operator/pkg/shared"
+)
+
+// AdoptOCIDAnnotation binds a custom resource to an existing OCI resource
+// instead of creating a new one. The OCID is only read while the resource has
+// no tracked identity; once adopted, the resource reconciles like any other.
+const AdoptOCIDAnnotation = "oci.oracle.com/adopt-ocid"
+
+// pendingAdoptionID returns the OCID requested through AdoptOCIDAnnotation when
+// the resource does not track an OCI identity yet. Rebinding a resource that
+// already tracks a different OCID is rejected rather than silently ignored.
+func (c ServiceClient[T]) pendingAdoptionID(resource T) (string, error) {
+	adoptID := strings.TrimSpace(resourceAnnotation(resource, AdoptOCIDAnnotation))
+	if adoptID == "" {
+		return "", nil
+	}
+
+	switch currentID := c.currentID(resource); currentID {
+	case "":
+		return adoptID, nil
+	case adoptID:
+		return "", nil
+	default:
+		return "", fmt.Errorf("%s already tracks OCI resource %s; refusing to adopt %s from %s",
+			c.config.Kind, currentID, adoptID, AdoptOCIDAnnotation)
+	}
+}
+
+// adoptExistingResource reads the adoption target and records its OCID once the
+// live resource is confirmed to exist and to agree with the create-only fields
+// in spec. It never falls back to create; mutable drift is left to the regular
+// update path that runs afterwards.
+func (c ServiceClient[T]) adoptExistingResource(ctx context.Context, resource T, adoptID string) error {
+	getOp := c.getReadOperation()
+	if getOp == nil {
+		return fmt.Errorf("%s does not support %s because it has no OCI get operation", c.config.Kind, AdoptOCIDAnnotation)
+	}
+
+	response, err := c.invoke(ctx, getOp, resource, adoptID, requestBuildOptions{})
+	if err != nil {
+		if isReadNotFound(err) {
+			return fmt.Errorf("%s adoption target %s was not found: %w", c.config.Kind, adoptID, err)
+		}
+		return err
+	}
+	if liveID := responseID(response); liveID != "" && liveID != adoptID {
+		return fmt.Errorf("%s adoption target %s resolved to OCI resource %s", c.config.Kind, adoptID, liveID)
+	}
+	if body, ok := responseBody(response); ok {
+		switch c.listItemLifecycleCategory(body) {
+		case lifecycleCategoryDeleting, lifecycleCategoryDeleted:
+			return fmt.Errorf("%s adoption target %s is in lifecycle state %s", c.config.Kind, adoptID, responseLifecycleState(response))
+		}
+	}
+
+	c.normalizeDesiredState(resource, response)
+	if err := c.validateMutationPolicy(resource, true, response); err != nil {
+		return fmt.Errorf("%s adoption target %s does not match spec: %w", c.config.Kind, adoptID, err)
+	}
+
+	status, err := osokStatus(resource)
+	if err != nil {
+		return err
+	}
+	status.Ocid = shared.OCID(adoptID)
+	return nil
+}
+
+func resourceAnnotation(resource any, key string) string {
+	resourceValue, err := resourceStruct(resource)
+	if err != nil {
+		return ""
+	}
+	annotations, ok := fieldValue(resourceValue, "Annotations")
+	if !ok || annotations.Kind() != reflect.Map || annotations.Type().Key().Kind() != reflect.String {
+		return ""
+	}
+	value := annotations.MapIndex(reflect.ValueOf(key))
+	if !value.IsValid() || value.Kind() != reflect.String {
+		return ""
+	}
+	return value.String()
+}
@@ -0,0 +1,159 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package generatedruntime
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/oracle/oci-service-operator/pkg/errorutil/errortest"
+	shared "github.com/oracle/oci-service-operator/pkg/shared"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const adoptedThingID = "ocid1.thing.oc1..adopted"
+
+func TestServiceClientCreateOrUpdateAdoptsExistingResourceWithoutCreate(t *testing.T) {
+	t.Parallel()
+	var getRequests []string
+	client := newAdoptionTestClient(t, func(_ context.Context, request any) (any, error) {
+		getRequests = append(getRequests, *request.(*fakeGetThingRequest).ThingId)
+		return fakeGetThingResponse{Thing: fakeThing{Id: adoptedThingID, CompartmentId: "ocid1.compartment.oc1..live", DisplayName: "terraform-name", LifecycleState: "ACTIVE"}}, nil
+	}, func(_ context.Context, _ any) (any, error) {
+		t.Fatal("Update() should not be called when the adopted resource already matches spec")
+		return nil, nil
+	})
+	resource := newAdoptionTestResource(adoptedThingID)
+	resource.Spec.DisplayName = "terraform-name"
+
+	response, err := client.CreateOrUpdate(context.Background(), resource, ctrl.Request{})
+	requireCreateOrUpdateSuccess(t, response, err)
+	requireStatusOCID(t, resource, adoptedThingID)
+	requireStringEqual(t, "status.displayName", resource.Status.DisplayName, "terraform-name")
+	requireTrailingCondition(t, resource, shared.Active)
+	if len(getRequests) == 0 || getRequests[0] != adoptedThingID {
+		t.Fatalf("get requests = %v, want the adoption OCID first", getRequests)
+	}
+}
+
+func TestServiceClientCreateOrUpdateUpdatesMutableDriftAfterAdoption(t *testing.T) {
+	t.Parallel()
+	var updateRequest fakeUpdateThingRequest
+	client := newAdoptionTestClient(t, func(_ context.Context, _ any) (any, error) {
+		return fakeGetThingResponse{Thing: fakeThing{Id: adoptedThingID, CompartmentId: "ocid1.compartment.oc1..live", DisplayName: "terraform-name", LifecycleState: "ACTIVE"}}, nil
+	}, func(_ context.Context, request any) (any, error) {
+		updateRequest = *request.(*fakeUpdateThingRequest)
+		return fakeUpdateThingResponse{Thing: fakeThing{Id: adoptedThingID, CompartmentId: "ocid1.compartment.oc1..live", DisplayName: "osok-name", LifecycleState: "ACTIVE"}}, nil
+	})
+	resource := newAdoptionTestResource(adoptedThingID)
+	resource.Spec.DisplayName = "osok-name"
+
+	response, err := client.CreateOrUpdate(context.Background(), resource, ctrl.Request{})
+	requireCreateOrUpdateSuccess(t, response, err)
+	requireThingIDRequest(t, "update", updateRequest.ThingId, adoptedThingID)
+	requireStringEqual(t, "update displayName", updateRequest.DisplayName, "osok-name")
+	requireStatusOCID(t, resource, adoptedThingID)
+}
+
+func TestServiceClientCreateOrUpdateAdoptionNotFoundDoesNotCreate(t *testing.T) {
+	t.Parallel()
+	client := newAdoptionTestClient(t, func(_ context.Context, _ any) (any, error) {
+		return nil, errortest.NewServiceError(404, "NotFound", "thing not found")
+	}, nil)
+	resource := newAdoptionTestResource(adoptedThingID)
+
+	response, err := client.CreateOrUpdate(context.Background(), resource, ctrl.Request{})
+	if err == nil || !strings.Contains(err.Error(), "adoption target "+adoptedThingID+" was not found") {
+		t.Fatalf("CreateOrUpdate() error = %v, want adoption not-found failure", err)
+	}
+	requireTrue(t, !response.IsSuccessful, "CreateOrUpdate() should fail when the adoption target is missing")
+	requireStatusOCID(t, resource, "")
+	requireTrailingCondition(t, resource, shared.Failed)
+}
+
+func TestServiceClientCreateOrUpdateAdoptionRejectsCreateOnlyMismatch(t *testing.T) {
+	t.Parallel()
+	client := newAdoptionTestClient(t, func(_ context.Context, _ any) (any, error) {
+		return fakeGetThingResponse{Thing: fakeThing{Id: adoptedThingID, CompartmentId: "ocid1.compartment.oc1..other", DisplayName: "terraform-name", LifecycleState: "ACTIVE"}}, nil
+	}, func(_ context.Context, _ any) (any, error) {
+		t.Fatal("Update() should not be called when adoption validation fails")
+		return nil, nil
+	})
+	resource := newAdoptionTestResource(adoptedThingID)
+
+	if _, err := client.CreateOrUpdate(context.Background(), resource, ctrl.Request{}); err == nil || !strings.Contains(err.Error(), "does not match spec") {
+		t.Fatalf("CreateOrUpdate() error = %v, want create-only mismatch failure", err)
+	}
+	requireStatusOCID(t, resource, "")
+}
+
+func TestServiceClientCreateOrUpdateAdoptionRejectsDeletingTarget(t *testing.T) {
+	t.Parallel()
+	client := newAdoptionTestClient(t, func(_ context.Context, _ any) (any, error) {
+		return fakeGetThingResponse{Thing: fakeThing{Id: adoptedThingID, CompartmentId: "ocid1.compartment.oc1..live", LifecycleState: "DELETING"}}, nil
+	}, nil)
+	resource := newAdoptionTestResource(adoptedThingID)
+
+	if _, err := client.CreateOrUpdate(context.Background(), resource, ctrl.Request{}); err == nil || !strings.Contains(err.Error(), "lifecycle state DELETING") {
+		t.Fatalf("CreateOrUpdate() error = %v, want deleting-target failure", err)
+	}
+	requireStatusOCID(t, resource, "")
+}
+
+func TestServiceClientCreateOrUpdateAdoptionRejectsRebindingTrackedResource(t *testing.T) {
+	t.Parallel()
+	client := newAdoptionTestClient(t, func(_ context.Context, _ any) (any, error) {
+		t.Fatal("Get() should not be called when the adoption OCID conflicts with the tracked OCID")
+		return nil, nil
+	}, nil)
+	resource := newAdoptionTestResource(adoptedThingID)
+	resource.Status.OsokStatus.Ocid = "ocid1.thing.oc1..tracked"
+
+	if _, err := client.CreateOrUpdate(context.Background(), resource, ctrl.Request{}); err == nil || !strings.Contains(err.Error(), "refusing to adopt") {
+		t.Fatalf("CreateOrUpdate() error = %v, want rebinding failure", err)
+	}
+	requireStatusOCID(t, resource, "ocid1.thing.oc1..tracked")
+}
+
+func newAdoptionTestClient(t *testing.T, get func(context.Context, any) (any, error), update func(context.Context, any) (any, error)) ServiceClient[*fakeResource] {
+	t.Helper()
+	if update == nil {
+		update = func(_ context.Context, _ any) (any, error) {
+			t.Fatal("Update() should not be called")
+			return nil, nil
+		}
+	}
+	return NewServiceClient[*fakeResource](Config[*fakeResource]{
+		Kind:    "Thing",
+		SDKName: "Thing",
+		Semantics: &Semantics{
+			Lifecycle: LifecycleSemantics{ActiveStates: []string{"ACTIVE"}},
+			Delete:    DeleteSemantics{PendingStates: []string{"DELETING"}, TerminalStates: []string{"DELETED"}},
+			Mutation:  MutationSemantics{Mutable: []string{"displayName"}, ForceNew: []string{"compartmentId"}},
+		},
+		Create: &Operation{NewRequest: func() any {
+			return &fakeCreateThingRequest{}
+		}, Call: func(_ context.Context, _ any) (any, error) {
+			t.Fatal("Create() should never be called for an adopted resource")
+			return nil, nil
+		}},
+		Get: &Operation{NewRequest: func() any {
+			return &fakeGetThingRequest{}
+		}, Call: get, Fields: []RequestField{{FieldName: "ThingId", RequestName: "thingId", Contribution: "path", PreferResourceID: true}}},
+		Update: &Operation{NewRequest: func() any {
+			return &fakeUpdateThingRequest{}
+		}, Call: update},
+	})
+}
+
+func newAdoptionTestResource(adoptID string) *fakeResource {
+	return &fakeResource{
+		Name:        "adopted-thing",
+		Annotations: map[string]string{AdoptOCIDAnnotation: adoptID},
+		Spec:        fakeSpec{CompartmentId: "ocid1.compartment.oc1..live"},
+	}
+}
@@ -0,0 +1,464 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+// Package conformance runs one generated kind through the real
+// BaseReconciler and its generated service manager, against an envtest API
+// server and a fakeoci backend. It checks that the finalizer is added, the
+// OCI resource is created and projected into status, mutable drift is
+// updated back to spec, a create-only spec change is rejected, and delete
+// removes both the OCI resource and the finalizer.
+//
+// The generator emits a <kind>_conformance_test.go that calls Run for every
+// controller-backed kind. Those tests build only with the conformance tag
+// and need envtest assets, so run them through make:
+//
+//	make conformance-test
+package conformance
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime/debug"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/funcr"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	"sigs.k8s.io/yaml"
+
+	"github.com/oracle/oci-service-operator/pkg/core"
+	"github.com/oracle/oci-service-operator/pkg/credhelper/kubesecret"
+	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/metrics"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime/fakeoci"
+	shared "github.com/oracle/oci-service-operator/pkg/shared"
+)
+
+// maxReconciles bounds how many reconciles a step may take to settle.
+const maxReconciles = 20
+
+// Kind describes one generated kind under test.
+type Kind[T client.Object] struct {
+	// CRD is the CustomResourceDefinition file under config/crd/bases.
+	CRD string
+	// Sample is the manifest under config/samples the resource is created
+	// from.
+	Sample      string
+	AddToScheme func(*runtime.Scheme) error
+	// Semantics is the generated runtime semantics of the kind. Its mutable
+	// and create-only fields pick the fields the drift checks change.
+	Semantics *generatedruntime.Semantics
+	// CreateOperation and UpdateOperation name the OCI operations, such as
+	// "CreateQueue", whose calls the checks count.
+	CreateOperation string
+	UpdateOperation string
+	// Setup returns the service manager with its generated client pointed
+	// at service.
+	Setup func(service *fakeoci.Service, deps servicemanager.RuntimeDeps) (servicemanager.OSOKServiceManager, error)
+}
+
+type harness[T client.Object] struct {
+	kind       Kind[T]
+	client     client.Client
+	manager    servicemanager.OSOKServiceManager
+	reconciler *core.BaseReconciler
+	service    *fakeoci.Service
+	sample     T
+	key        types.NamespacedName
+	ocid       string
+}
+
+// Run checks kind end to end. Each step is a subtest; the drift steps are
+// skipped when the sample sets no field they can change.
+func Run[T client.Object](t *testing.T, kind Kind[T]) {
+	t.Helper()
+	h := start(t, kind)
+
+	if t.Run("Create", h.create) {
+		t.Run("MutableDrift", h.mutableDrift)
+		t.Run("CreateOnlyDrift", h.createOnlyDrift)
+	}
+	t.Run("Delete", h.delete)
+}
+
+func start[T client.Object](t *testing.T, kind Kind[T]) *harness[T] {
+	t.Helper()
+	root, err := repoRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	environment := &envtest.Environment{
+		CRDInstallOptions: envtest.CRDInstallOptions{
+			Paths:              []string{filepath.Join(root, "config", "crd", "bases", kind.CRD)},
+			ErrorIfPathMissing: true,
+		},
+	}
+	restConfig, err := environment.Start()
+	if err != nil {
+		t.Fatalf("start envtest (set KUBEBUILDER_ASSETS or run make conformance-test): %v", err)
+	}
+	t.Cleanup(func() {
+		if err := environment.Stop(); err != nil {
+			t.Errorf("stop envtest: %v", err)
+		}
+	})
+
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme() error = %v", err)
+	}
+	if err := kind.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme() error = %v", err)
+	}
+	kubeClient, err := client.New(restConfig, client.Options{Scheme: scheme})
+	if err != nil {
+		t.Fatalf("client.New() error = %v", err)
+	}
+
+	log := loggerutil.OSOKLogger{Logger: funcr.New(func(prefix, args string) { t.Log(prefix, args) }, funcr.Options{})}
+	serviceMetrics := &metrics.Metrics{Name: "oci", ServiceName: "conformance", Logger: log}
+	service := fakeoci.New(fakeoci.Options{ReadsPerTransition: 1, ForgetDeleted: true})
+	manager, err := kind.Setup(service, servicemanager.RuntimeDeps{
+		Provider:         provider(t),
+		CredentialClient: kubesecret.New(kubeClient, log, serviceMetrics),
+		Scheme:           scheme,
+		Log:              log,
+		Metrics:          serviceMetrics,
+	})
+	if err != nil {
+		t.Fatalf("Setup() error = %v", err)
+	}
+
+	sample := newObject[T]()
+	if err := readSample(filepath.Join(root, "config", "samples", kind.Sample), sample); err != nil {
+		t.Fatal(err)
+	}
+	if sample.GetNamespace() == "" {
+		sample.SetNamespace("default")
+	}
+
+	return &harness[T]{
+		kind:    kind,
+		client:  kubeClient,
+		manager: manager,
+		reconciler: &core.BaseReconciler{
+			Client:             kubeClient,
+			OSOKServiceManager: manager,
+			Finalizer:          core.NewBaseFinalizer(kubeClient, log.Logger),
+			Log:                log,
+			Metrics:            serviceMetrics,
+			Recorder:           &record.FakeRecorder{},
+			Scheme:             scheme,
+			// A resync at an unchanged generation checks for drift and
+			// updates the OCI resource back to spec.
+			Drift: core.DriftConfig{Default: core.DriftPolicy{ResyncInterval: time.Minute, AutoCorrect: true}},
+		},
+		service: service,
+		sample:  sample,
+		key:     types.NamespacedName{Namespace: sample.GetNamespace(), Name: sample.GetName()},
+	}
+}
+
+func (h *harness[T]) create(t *testing.T) {
+	if err := h.client.Create(context.Background(), h.sample.DeepCopyObject().(T)); err != nil {
+		t.Fatalf("create the sample: %v", err)
+	}
+
+	obj := h.settle(t)
+	if !core.HasFinalizer(obj, core.OSOKFinalizerName) {
+		t.Fatalf("finalizers = %v, want %s", obj.GetFinalizers(), core.OSOKFinalizerName)
+	}
+	if got := h.service.Calls(h.kind.CreateOperation); got != 1 {
+		t.Fatalf("Calls(%s) = %d, want 1", h.kind.CreateOperation, got)
+	}
+
+	status := h.status(t, obj)
+	h.ocid = string(status.Ocid)
+	live, ok := h.service.Resource(h.ocid)
+	if !ok {
+		t.Fatalf("status.ocid = %q, want the OCID of the created OCI resource", h.ocid)
+	}
+	projected, err := statusValues(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state, ok := projected["lifecycleState"]; ok && state != live["lifecycleState"] {
+		t.Fatalf("status.lifecycleState = %v, want %v", state, live["lifecycleState"])
+	}
+}
+
+func (h *harness[T]) mutableDrift(t *testing.T) {
+	if h.kind.Semantics == nil {
+		t.Skip("the kind has no generated runtime semantics")
+	}
+	live, ok := h.service.Resource(h.ocid)
+	if !ok {
+		t.Fatalf("OCI resource %s is missing", h.ocid)
+	}
+	field, want, drifted, ok := driftableField(h.kind.Semantics.Mutation.Mutable, live)
+	if !ok {
+		t.Skip("the sample sets no top-level scalar mutable field")
+	}
+
+	updates := h.service.Calls(h.kind.UpdateOperation)
+	if err := h.service.Mutate(h.ocid, func(values map[string]any) { values[field] = drifted }); err != nil {
+		t.Fatal(err)
+	}
+	h.settle(t)
+
+	if got := h.service.Calls(h.kind.UpdateOperation); got <= updates {
+		t.Fatalf("Calls(%s) = %d after %s drifted, want an update", h.kind.UpdateOperation, got, field)
+	}
+	live, _ = h.service.Resource(h.ocid)
+	if !reflect.DeepEqual(live[field], want) {
+		t.Fatalf("OCI %s = %v after the drift update, want the spec value %v", field, live[field], want)
+	}
+}
+
+func (h *harness[T]) createOnlyDrift(t *testing.T) {
+	if h.kind.Semantics == nil {
+		t.Skip("the kind has no generated runtime semantics")
+	}
+	obj := h.get(t)
+	spec, err := specValues(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	field, _, changed, ok := driftableField(h.kind.Semantics.Mutation.ForceNew, spec)
+	if !ok {
+		t.Skip("the sample sets no top-level scalar create-only field")
+	}
+
+	patch, err := json.Marshal(map[string]any{"spec": map[string]any{field: changed}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := h.client.Patch(context.Background(), obj, client.RawPatch(types.MergePatchType, patch)); err != nil {
+		t.Skipf("the API server rejected a change to spec.%s: %v", field, err)
+	}
+
+	creates := h.service.Calls(h.kind.CreateOperation)
+	updates := h.service.Calls(h.kind.UpdateOperation)
+	_, reconcileErr := h.reconcile(t)
+	if got := h.service.Calls(h.kind.CreateOperation); got != creates {
+		t.Fatalf("Calls(%s) = %d after spec.%s changed, want no replacement", h.kind.CreateOperation, got, field)
+	}
+	if got := h.service.Calls(h.kind.UpdateOperation); got != updates {
+		t.Fatalf("Calls(%s) = %d after spec.%s changed, want no update", h.kind.UpdateOperation, got, field)
+	}
+	if condition := currentCondition(h.status(t, h.get(t))); condition != shared.Failed && reconcileErr == nil {
+		t.Fatalf("condition = %q after spec.%s changed, want %q", condition, field, shared.Failed)
+	}
+}
+
+func (h *harness[T]) delete(t *testing.T) {
+	obj := newObject[T]()
+	if err := h.client.Get(context.Background(), h.key, obj); err != nil {
+		t.Fatalf("get %s: %v", h.key, err)
+	}
+	if err := h.client.Delete(context.Background(), obj); err != nil {
+		t.Fatalf("delete %s: %v", h.key, err)
+	}
+
+	for attempt := 0; attempt < maxReconciles; attempt++ {
+		if _, err := h.reconcile(t); err != nil {
+			t.Logf("reconcile %d: %v", attempt, err)
+		}
+		err := h.client.Get(context.Background(), h.key, newObject[T]())
+		if apierrors.IsNotFound(err) {
+			if live, ok := h.service.Resource(h.ocid); ok && h.ocid != "" && !h.deleting(live) {
+				t.Fatalf("OCI resource %s is %v after the finalizer was removed, want it deleted", h.ocid, live["lifecycleState"])
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("get %s: %v", h.key, err)
+		}
+	}
+	t.Fatalf("%s still exists after %d reconciles; finalizers = %v", h.key, maxReconciles, h.get(t).GetFinalizers())
+}
+
+// deleting reports whether live is in one of the kind's delete states. A
+// best-effort delete releases the finalizer once OCI accepts the request, so
+// the resource may still be on its way out.
+func (h *harness[T]) deleting(live map[string]any) bool {
+	if h.kind.Semantics == nil {
+		return false
+	}
+	state, _ := live["lifecycleState"].(string)
+	for _, states := range [][]string{h.kind.Semantics.Delete.PendingStates, h.kind.Semantics.Delete.TerminalStates} {
+		for _, candidate := range states {
+			if strings.EqualFold(state, candidate) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// settle reconciles until the resource is Active with no async operation
+// pending, and returns it.
+func (h *harness[T]) settle(t *testing.T) T {
+	t.Helper()
+	var lastErr error
+	for attempt := 0; attempt < maxReconciles; attempt++ {
+		_, lastErr = h.reconcile(t)
+		obj := h.get(t)
+		status := h.status(t, obj)
+		switch currentCondition(status) {
+		case shared.Active:
+			if status.Async.Current == nil {
+				return obj
+			}
+		case shared.Failed:
+			t.Fatalf("reconcile failed: %s (error: %v)", status.Message, lastErr)
+		}
+	}
+	t.Fatalf("the resource is not Active after %d reconciles (last error: %v)", maxReconciles, lastErr)
+	return newObject[T]()
+}
+
+func (h *harness[T]) reconcile(t *testing.T) (result ctrl.Result, err error) {
+	t.Helper()
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			t.Fatalf("Reconcile() panicked: %v\n%s", recovered, debug.Stack())
+		}
+	}()
+	return h.reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: h.key}, newObject[T]())
+}
+
+func (h *harness[T]) get(t *testing.T) T {
+	t.Helper()
+	obj := newObject[T]()
+	if err := h.client.Get(context.Background(), h.key, obj); err != nil {
+		t.Fatalf("get %s: %v", h.key, err)
+	}
+	return obj
+}
+
+func (h *harness[T]) status(t *testing.T, obj T) *shared.OSOKStatus {
+	t.Helper()
+	status, err := h.manager.GetCrdStatus(obj)
+	if err != nil {
+		t.Fatalf("GetCrdStatus() error = %v", err)
+	}
+	return status
+}
+
+// currentCondition returns the condition the last reconcile projected.
+// Conditions are appended only when they change, so a return to an earlier
+// condition does not show at the end of the list; status.reason does.
+func currentCondition(status *shared.OSOKStatus) shared.OSOKConditionType {
+	return shared.OSOKConditionType(status.Reason)
+}
+
+// driftableField returns the first of fields that values sets to a
+// top-level scalar, its value, and a different value of the same type.
+func driftableField(fields []string, values map[string]any) (string, any, any, bool) {
+	for _, field := range fields {
+		if strings.Contains(field, ".") {
+			continue
+		}
+		switch value := values[field].(type) {
+		case string:
+			if value != "" {
+				return field, value, value + "-drift", true
+			}
+		case float64:
+			return field, value, value + 1, true
+		case int64:
+			return field, value, value + 1, true
+		case bool:
+			return field, value, !value, true
+		}
+	}
+	return "", nil, nil, false
+}
+
+func specValues(obj client.Object) (map[string]any, error) {
+	return objectSection(obj, "spec")
+}
+
+func statusValues(obj client.Object) (map[string]any, error) {
+	return objectSection(obj, "status")
+}
+
+func objectSection(obj client.Object, section string) (map[string]any, error) {
+	values, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, fmt.Errorf("convert %s to unstructured: %w", obj.GetName(), err)
+	}
+	nested, _ := values[section].(map[string]any)
+	return nested, nil
+}
+
+// provider returns credentials for the SDK client the service manager builds
+// by default. The fake serves every call, so they are never sent.
+func provider(t *testing.T) common.ConfigurationProvider {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	return common.NewRawConfigurationProvider("ocid1.tenancy.oc1..conformance", "ocid1.user.oc1..conformance",
+		"us-ashburn-1", "aa:bb:cc", string(privateKey), nil)
+}
+
+func readSample(path string, obj client.Object) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read sample: %w", err)
+	}
+	if err := yaml.Unmarshal(content, obj); err != nil {
+		return fmt.Errorf("decode sample %s: %w", filepath.Base(path), err)
+	}
+	return nil
+}
+
+func newObject[T client.Object]() T {
+	var zero T
+	return reflect.New(reflect.TypeOf(zero).Elem()).Interface().(T)
+}
+
+// repoRoot walks up from the working directory, the package under test, to
+// the directory holding go.mod.
+func repoRoot() (string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return dir, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("go.mod not found above the package under test")
+		}
+		dir = parent
+	}
+}
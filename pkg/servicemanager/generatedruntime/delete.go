@@ -22,6 +22,14 @@ func (c ServiceClient[T]) Delete(ctx context.Context, resource T) (bool, error)
 }
 
 func (c ServiceClient[T]) DeleteWithResult(ctx context.Context, resource T) (servicemanager.OSOKDeleteResult, error) {
+	result, err := c.deleteWithResult(ctx, resource)
+	if errors.Is(err, errPlannedMutation) {
+		return servicemanager.OSOKDeleteResult{}, nil
+	}
+	return result, err
+}
+
+func (c ServiceClient[T]) deleteWithResult(ctx context.Context, resource T) (servicemanager.OSOKDeleteResult, error) {
 	if err := c.validateDeleteRequest(resource); err != nil {
 		return servicemanager.OSOKDeleteResult{}, err
 	}
@@ -48,6 +56,18 @@ func (c ServiceClient[T]) DeleteWithResult(ctx context.Context, resource T) (ser
 		}
 		return servicemanager.OSOKDeleteResult{Deleted: deleted}, nil
 	}
+	if servicemanager.IsObserveOnly(ctx) {
+		if restoreSyntheticTrackedID != nil {
+			restoreSyntheticTrackedID()
+		}
+		return servicemanager.OSOKDeleteResult{}, nil
+	}
+	if err := c.abandonReplacement(ctx, resource); err != nil {
+		if restoreSyntheticTrackedID != nil {
+			restoreSyntheticTrackedID()
+		}
+		return servicemanager.OSOKDeleteResult{}, err
+	}
 
 	var result servicemanager.OSOKDeleteResult
 	if c.config.Semantics != nil {
@@ -0,0 +1,70 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package generatedruntime
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	shared "github.com/oracle/oci-service-operator/pkg/shared"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const driftedThingID = "ocid1.thing.oc1..drifted"
+
+func TestServiceClientDriftCheckReportsDriftWithoutUpdate(t *testing.T) {
+	t.Parallel()
+	client := newAdoptionTestClient(t, func(_ context.Context, _ any) (any, error) {
+		return fakeGetThingResponse{Thing: fakeThing{Id: driftedThingID, CompartmentId: "ocid1.compartment.oc1..live", DisplayName: "console-edit", LifecycleState: "ACTIVE"}}, nil
+	}, nil)
+	resource := newDriftTestResource("osok-name")
+
+	response, err := client.CreateOrUpdate(servicemanager.WithDriftCheck(context.Background(), false), resource, ctrl.Request{})
+	requireCreateOrUpdateSuccess(t, response, err)
+	requireTrue(t, response.DriftDetected, "CreateOrUpdate() should report drift when the live displayName differs from spec")
+	requireStringEqual(t, "status.displayName", resource.Status.DisplayName, "console-edit")
+	requireTrailingCondition(t, resource, shared.Active)
+}
+
+func TestServiceClientDriftCheckAutoCorrectUpdatesDrift(t *testing.T) {
+	t.Parallel()
+	var updateRequest fakeUpdateThingRequest
+	client := newAdoptionTestClient(t, func(_ context.Context, _ any) (any, error) {
+		return fakeGetThingResponse{Thing: fakeThing{Id: driftedThingID, CompartmentId: "ocid1.compartment.oc1..live", DisplayName: "console-edit", LifecycleState: "ACTIVE"}}, nil
+	}, func(_ context.Context, request any) (any, error) {
+		updateRequest = *request.(*fakeUpdateThingRequest)
+		return fakeUpdateThingResponse{Thing: fakeThing{Id: driftedThingID, CompartmentId: "ocid1.compartment.oc1..live", DisplayName: "osok-name", LifecycleState: "ACTIVE"}}, nil
+	})
+	resource := newDriftTestResource("osok-name")
+
+	response, err := client.CreateOrUpdate(servicemanager.WithDriftCheck(context.Background(), true), resource, ctrl.Request{})
+	requireCreateOrUpdateSuccess(t, response, err)
+	requireTrue(t, response.DriftDetected, "CreateOrUpdate() should report the drift it corrected")
+	requireThingIDRequest(t, "update", updateRequest.ThingId, driftedThingID)
+	requireStringEqual(t, "update displayName", updateRequest.DisplayName, "osok-name")
+}
+
+func TestServiceClientDriftCheckWithoutDriftIsQuiet(t *testing.T) {
+	t.Parallel()
+	client := newAdoptionTestClient(t, func(_ context.Context, _ any) (any, error) {
+		return fakeGetThingResponse{Thing: fakeThing{Id: driftedThingID, CompartmentId: "ocid1.compartment.oc1..live", DisplayName: "osok-name", LifecycleState: "ACTIVE"}}, nil
+	}, nil)
+	resource := newDriftTestResource("osok-name")
+
+	response, err := client.CreateOrUpdate(servicemanager.WithDriftCheck(context.Background(), true), resource, ctrl.Request{})
+	requireCreateOrUpdateSuccess(t, response, err)
+	requireTrue(t, !response.DriftDetected, "CreateOrUpdate() should not report drift when live state matches spec")
+}
+
+func newDriftTestResource(displayName string) *fakeResource {
+	resource := &fakeResource{
+		Name: "drifted-thing",
+		Spec: fakeSpec{CompartmentId: "ocid1.compartment.oc1..live", DisplayName: displayName},
+	}
+	resource.Status.OsokStatus.Ocid = driftedThingID
+	return resource
+}
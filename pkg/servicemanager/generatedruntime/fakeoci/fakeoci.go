@@ -0,0 +1,783 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+// Package fakeoci is an in-memory OCI service for generatedruntime tests.
+// It keeps resources keyed by OCID and serves Create, Get, List, Update,
+// Delete, and GetWorkRequest calls for any OCI SDK request type, so a test
+// can drive a generated service client through its whole lifecycle without
+// hand-rolled Operation.Call closures:
+//
+//	service := fakeoci.New(fakeoci.Options{ReadsPerTransition: 1})
+//	config := buildQueueGeneratedRuntimeConfig(manager, hooks)
+//	if err := fakeoci.Install(service, &config, queuesdk.QueueAdminClient{}); err != nil {
+//		t.Fatal(err)
+//	}
+//	client := generatedruntime.NewServiceClient(config)
+//
+// Operations are recognised by the SDK request type name: CreateQueueRequest
+// is the CreateQueue operation on a Queue, ListQueuesRequest lists them, and
+// so on. Request bodies, path and query parameters, and response bodies and
+// headers are found through the SDK's contributesTo and presentIn tags.
+package fakeoci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+)
+
+// Lifecycle names the lifecycleState values the fake moves resources
+// through: Provisioning to Active on create, Updating to Active on update,
+// and Deleting to Deleted on delete.
+type Lifecycle struct {
+	Provisioning string
+	Updating     string
+	Active       string
+	Deleting     string
+	Deleted      string
+}
+
+// DefaultLifecycle is the lifecycle most OCI resources use.
+var DefaultLifecycle = Lifecycle{
+	Provisioning: "PROVISIONING",
+	Updating:     "UPDATING",
+	Active:       "ACTIVE",
+	Deleting:     "DELETING",
+	Deleted:      "DELETED",
+}
+
+// Options tune how closely the fake follows a real OCI service.
+type Options struct {
+	// Lifecycle overrides the state names. Empty names fall back to the
+	// generated semantics passed to Install, then to DefaultLifecycle.
+	Lifecycle Lifecycle
+	// ReadsPerTransition is the number of Get calls that still see a
+	// resource in a transitional state before it settles. Zero settles
+	// resources immediately, so Create returns them Active.
+	ReadsPerTransition int
+	// WorkRequests makes Create, Update, and Delete return a work request.
+	// The resource stays transitional until the work request succeeds.
+	WorkRequests bool
+	// WorkRequestStep is the percentComplete a work request gains on each
+	// GetWorkRequest call. Zero means 50.
+	WorkRequestStep float32
+	// ListLag is the number of List calls that miss a newly created
+	// resource, like an eventually consistent OCI list.
+	ListLag int
+	// ForgetDeleted makes deleted resources read as 404 instead of staying
+	// visible in the Deleted state.
+	ForgetDeleted bool
+}
+
+// Service is one fake OCI service. It is safe for concurrent use.
+type Service struct {
+	mu           sync.Mutex
+	options      Options
+	lifecycle    Lifecycle
+	sequence     int
+	resources    map[string]*resource
+	workRequests map[string]*workRequest
+	faults       map[string][]fault
+	calls        map[string]int
+}
+
+type resource struct {
+	values      map[string]any
+	kind        string
+	reads       int
+	listSkips   int
+	workRequest string
+	version     int
+}
+
+type workRequest struct {
+	id            string
+	operationType string
+	entityType    string
+	actionType    string
+	resourceID    string
+	compartmentID any
+	percent       float32
+	settled       bool
+	accepted      time.Time
+}
+
+type fault struct {
+	err        error
+	retryAfter time.Duration
+}
+
+// New returns an empty fake service.
+func New(options Options) *Service {
+	return &Service{
+		options:      options,
+		lifecycle:    mergeLifecycle(options.Lifecycle, DefaultLifecycle),
+		resources:    map[string]*resource{},
+		workRequests: map[string]*workRequest{},
+		faults:       map[string][]fault{},
+		calls:        map[string]int{},
+	}
+}
+
+// ServiceError is the error the fake returns for OCI failures.
+type ServiceError struct {
+	StatusCode   int
+	Code         string
+	Message      string
+	OpcRequestID string
+}
+
+func (e ServiceError) Error() string {
+	return fmt.Sprintf("Error returned by fake OCI service. Http Status Code: %d. Error Code: %s. Message: %s", e.StatusCode, e.Code, e.Message)
+}
+
+func (e ServiceError) GetHTTPStatusCode() int  { return e.StatusCode }
+func (e ServiceError) GetMessage() string      { return e.Message }
+func (e ServiceError) GetCode() string         { return e.Code }
+func (e ServiceError) GetOpcRequestID() string { return e.OpcRequestID }
+
+var _ common.ServiceError = ServiceError{}
+
+// Fail queues errs for the next calls to operation, such as "CreateQueue"
+// or "GetWorkRequest", one error per call.
+func (s *Service) Fail(operation string, errs ...error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, err := range errs {
+		s.faults[operation] = append(s.faults[operation], fault{err: err})
+	}
+}
+
+// FailWith queues one OCI error with statusCode and code, such as 409
+// "IncorrectState" or 500 "InternalServerError", for the next call to
+// operation.
+func (s *Service) FailWith(operation string, statusCode int, code string) {
+	s.Fail(operation, ServiceError{StatusCode: statusCode, Code: code, Message: fmt.Sprintf("injected %d %s", statusCode, code)})
+}
+
+// Throttle queues a 429 for the next call to operation. The response
+// carries retryAfter in its Retry-After header when it is positive.
+func (s *Service) Throttle(operation string, retryAfter time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults[operation] = append(s.faults[operation], fault{
+		err:        ServiceError{StatusCode: http.StatusTooManyRequests, Code: "TooManyRequests", Message: "injected 429 TooManyRequests"},
+		retryAfter: retryAfter,
+	})
+}
+
+// Calls returns how many times operation was called, including calls that
+// failed.
+func (s *Service) Calls(operation string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[operation]
+}
+
+// Seed stores an Active resource of kind, like one created outside the
+// operator, and returns its OCID. values uses the SDK model's JSON names.
+func (s *Service) Seed(kind string, values map[string]any) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored := cloneValues(values)
+	id, _ := stored["id"].(string)
+	if id == "" {
+		id = s.newID(kind)
+	}
+	stored["id"] = id
+	stored["lifecycleState"] = s.lifecycle.Active
+	if _, ok := stored["timeCreated"]; !ok {
+		stored["timeCreated"] = now()
+	}
+	s.resources[id] = &resource{values: stored, kind: kind}
+	return id
+}
+
+// Resource returns a copy of the stored resource, as the SDK model's JSON.
+func (s *Service) Resource(id string) (map[string]any, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	current, ok := s.resources[id]
+	if !ok {
+		return nil, false
+	}
+	return cloneValues(current.values), true
+}
+
+// Mutate changes a stored resource out of band, the way a console edit
+// would, so that tests can check drift handling.
+func (s *Service) Mutate(id string, mutate func(values map[string]any)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	current, ok := s.resources[id]
+	if !ok {
+		return fmt.Errorf("fake OCI resource %s does not exist", id)
+	}
+	mutate(current.values)
+	current.version++
+	return nil
+}
+
+// Handler returns an SDK-shaped call for the operation Req names, for the
+// typed runtimeOperationHooks of a generated package:
+//
+//	hooks.Create.Call = fakeoci.Handler[queuesdk.CreateQueueRequest, queuesdk.CreateQueueResponse](service)
+func Handler[Req any, Resp any](s *Service) func(context.Context, Req) (Resp, error) {
+	responseType := reflect.TypeOf((*Resp)(nil)).Elem()
+	return func(_ context.Context, request Req) (Resp, error) {
+		response, err := s.serve(request, responseType)
+		typed, _ := response.(Resp)
+		return typed, err
+	}
+}
+
+func (s *Service) serve(request any, responseType reflect.Type) (any, error) {
+	requestValue := reflect.ValueOf(request)
+	for requestValue.Kind() == reflect.Pointer {
+		if requestValue.IsNil() {
+			return nil, fmt.Errorf("fake OCI request is nil")
+		}
+		requestValue = requestValue.Elem()
+	}
+	operation := strings.TrimSuffix(requestValue.Type().Name(), "Request")
+	response := reflect.New(responseType).Elem()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls[operation]++
+	s.sequence++
+	requestID := fmt.Sprintf("fake-opc-request-%d", s.sequence)
+	setHeader(response, "opc-request-id", requestID)
+
+	if queued := s.faults[operation]; len(queued) > 0 {
+		s.faults[operation] = queued[1:]
+		return s.failure(response, queued[0], requestID)
+	}
+
+	var err error
+	switch {
+	case operation == "GetWorkRequest":
+		err = s.getWorkRequest(requestValue, response)
+	case strings.HasPrefix(operation, "Create"):
+		err = s.create(strings.TrimPrefix(operation, "Create"), requestValue, response)
+	case strings.HasPrefix(operation, "Get"):
+		err = s.get(requestValue, response)
+	case strings.HasPrefix(operation, "List"):
+		err = s.list(requestValue, response)
+	case strings.HasPrefix(operation, "Update"):
+		err = s.update(requestValue, response)
+	case strings.HasPrefix(operation, "Delete"):
+		err = s.delete(requestValue, response)
+	default:
+		return response.Interface(), fmt.Errorf("fake OCI service does not model %s", operation)
+	}
+	if serviceErr, ok := err.(ServiceError); ok {
+		return s.failure(response, fault{err: serviceErr}, requestID)
+	}
+	if err != nil {
+		return response.Interface(), err
+	}
+	setRawResponse(response, http.StatusOK, 0)
+	return response.Interface(), nil
+}
+
+func (s *Service) failure(response reflect.Value, injected fault, requestID string) (any, error) {
+	err := injected.err
+	statusCode := http.StatusInternalServerError
+	if serviceErr, ok := err.(ServiceError); ok {
+		if serviceErr.OpcRequestID == "" {
+			serviceErr.OpcRequestID = requestID
+		}
+		statusCode = serviceErr.StatusCode
+		err = serviceErr
+	}
+	setRawResponse(response, statusCode, injected.retryAfter)
+	return response.Interface(), err
+}
+
+func (s *Service) create(kind string, request reflect.Value, response reflect.Value) error {
+	values, err := requestBody(request)
+	if err != nil {
+		return err
+	}
+	id := s.newID(kind)
+	values["id"] = id
+	values["timeCreated"] = now()
+	values["lifecycleState"] = s.lifecycle.Active
+	stored := &resource{values: values, kind: kind, listSkips: s.options.ListLag}
+	s.resources[id] = stored
+	s.begin(stored, s.lifecycle.Provisioning, "CREATE", "CREATED")
+	return s.respond(stored, response)
+}
+
+func (s *Service) get(request reflect.Value, response reflect.Value) error {
+	stored, err := s.lookup(request)
+	if err != nil {
+		return err
+	}
+	if stored.workRequest == "" && s.transitional(stored) {
+		stored.reads++
+		if stored.reads > s.options.ReadsPerTransition {
+			s.settle(stored)
+		}
+	}
+	if s.forgotten(stored) {
+		delete(s.resources, stored.values["id"].(string))
+		return notFound()
+	}
+	return s.respond(stored, response)
+}
+
+func (s *Service) update(request reflect.Value, response reflect.Value) error {
+	stored, err := s.lookup(request)
+	if err != nil {
+		return err
+	}
+	if state(stored) != s.lifecycle.Active {
+		return incorrectState(stored)
+	}
+	values, err := requestBody(request)
+	if err != nil {
+		return err
+	}
+	for key, value := range values {
+		stored.values[key] = value
+	}
+	stored.version++
+	s.begin(stored, s.lifecycle.Updating, "UPDATE", "UPDATED")
+	return s.respond(stored, response)
+}
+
+func (s *Service) delete(request reflect.Value, response reflect.Value) error {
+	stored, err := s.lookup(request)
+	if err != nil {
+		return err
+	}
+	switch state(stored) {
+	case s.lifecycle.Deleted:
+		return notFound()
+	case s.lifecycle.Provisioning, s.lifecycle.Updating:
+		return incorrectState(stored)
+	}
+	s.begin(stored, s.lifecycle.Deleting, "DELETE", "DELETED")
+	if stored.workRequest != "" {
+		setHeader(response, "opc-work-request-id", stored.workRequest)
+	}
+	if s.forgotten(stored) {
+		delete(s.resources, stored.values["id"].(string))
+	}
+	return nil
+}
+
+func (s *Service) list(request reflect.Value, response reflect.Value) error {
+	filters := queryFilters(request)
+	ids := make([]string, 0, len(s.resources))
+	for id := range s.resources {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	items := make([]map[string]any, 0, len(ids))
+	for _, id := range ids {
+		stored := s.resources[id]
+		if s.forgotten(stored) || !matches(stored.values, filters) {
+			continue
+		}
+		if stored.listSkips > 0 {
+			stored.listSkips--
+			continue
+		}
+		items = append(items, stored.values)
+	}
+
+	body, ok := bodyField(response)
+	if !ok {
+		return nil
+	}
+	var payload any = items
+	if itemsField, found := sliceField(body.Type()); found {
+		payload = map[string]any{jsonName(itemsField): items}
+	}
+	return decodeInto(payload, body)
+}
+
+func (s *Service) getWorkRequest(request reflect.Value, response reflect.Value) error {
+	id := ""
+	for _, value := range pathValues(request) {
+		id = value
+	}
+	model, err := s.advanceWorkRequest(id)
+	if err != nil {
+		return err
+	}
+	body, ok := bodyField(response)
+	if !ok {
+		return nil
+	}
+	return decodeInto(model, body)
+}
+
+// GetWorkRequest decodes the work request with id into workRequest, a
+// pointer to the SDK's WorkRequest model, and advances it like a
+// GetWorkRequest call. It suits an AsyncHooks.GetWorkRequest that is wired
+// by hand.
+func (s *Service) GetWorkRequest(id string, workRequest any) error {
+	target := reflect.ValueOf(workRequest)
+	if target.Kind() != reflect.Pointer || target.IsNil() {
+		return fmt.Errorf("fake OCI work request target must be a non-nil pointer")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls["GetWorkRequest"]++
+	model, err := s.advanceWorkRequest(id)
+	if err != nil {
+		return err
+	}
+	return decodeInto(model, target.Elem())
+}
+
+// advanceWorkRequest moves the work request on by one poll and settles its
+// resource once it succeeds.
+func (s *Service) advanceWorkRequest(id string) (map[string]any, error) {
+	current, ok := s.workRequests[id]
+	if !ok {
+		return nil, notFound()
+	}
+	step := s.options.WorkRequestStep
+	if step <= 0 {
+		step = 50
+	}
+	current.percent = min(current.percent+step, 100)
+	if current.percent < 100 {
+		return current.model("IN_PROGRESS"), nil
+	}
+	if !current.settled {
+		current.settled = true
+		if stored, found := s.resources[current.resourceID]; found && stored.workRequest == current.id {
+			stored.workRequest = ""
+			s.settle(stored)
+			if s.forgotten(stored) {
+				delete(s.resources, current.resourceID)
+			}
+		}
+	}
+	return current.model("SUCCEEDED"), nil
+}
+
+func (w *workRequest) model(status string) map[string]any {
+	model := map[string]any{
+		"id":              w.id,
+		"operationType":   w.operationType,
+		"status":          status,
+		"percentComplete": w.percent,
+		"timeAccepted":    w.accepted.Format(time.RFC3339Nano),
+		"resources": []map[string]any{{
+			"entityType": w.entityType,
+			"actionType": w.actionType,
+			"identifier": w.resourceID,
+			"entityUri":  "/" + w.entityType + "s/" + w.resourceID,
+		}},
+	}
+	if w.compartmentID != nil {
+		model["compartmentId"] = w.compartmentID
+	}
+	if status == "SUCCEEDED" {
+		model["timeFinished"] = now()
+	}
+	return model
+}
+
+// begin moves stored into transitional, or straight to its settled state
+// when the fake is configured to settle immediately.
+func (s *Service) begin(stored *resource, transitional string, operation string, action string) {
+	stored.values["lifecycleState"] = transitional
+	stored.reads = 0
+	if s.options.WorkRequests {
+		s.sequence++
+		id := fmt.Sprintf("ocid1.workrequest.oc1..fake%06d", s.sequence)
+		entityType := strings.ToLower(stored.kind)
+		s.workRequests[id] = &workRequest{
+			id:            id,
+			operationType: operation + "_" + upperSnake(stored.kind),
+			entityType:    entityType,
+			actionType:    action,
+			resourceID:    stored.values["id"].(string),
+			compartmentID: stored.values["compartmentId"],
+			accepted:      time.Now(),
+		}
+		stored.workRequest = id
+		return
+	}
+	if s.options.ReadsPerTransition <= 0 {
+		s.settle(stored)
+	}
+}
+
+func (s *Service) settle(stored *resource) {
+	switch state(stored) {
+	case s.lifecycle.Provisioning, s.lifecycle.Updating:
+		stored.values["lifecycleState"] = s.lifecycle.Active
+	case s.lifecycle.Deleting:
+		stored.values["lifecycleState"] = s.lifecycle.Deleted
+	}
+	stored.reads = 0
+}
+
+func (s *Service) transitional(stored *resource) bool {
+	switch state(stored) {
+	case s.lifecycle.Provisioning, s.lifecycle.Updating, s.lifecycle.Deleting:
+		return true
+	}
+	return false
+}
+
+func (s *Service) forgotten(stored *resource) bool {
+	return s.options.ForgetDeleted && state(stored) == s.lifecycle.Deleted
+}
+
+// respond fills the response body with stored and the work request and
+// etag headers.
+func (s *Service) respond(stored *resource, response reflect.Value) error {
+	if stored.workRequest != "" {
+		setHeader(response, "opc-work-request-id", stored.workRequest)
+	}
+	setHeader(response, "etag", "fake-etag-"+strconv.Itoa(stored.version))
+	body, ok := bodyField(response)
+	if !ok {
+		return nil
+	}
+	return decodeInto(stored.values, body)
+}
+
+// lookup finds the resource a Get, Update, or Delete request names in one
+// of its path parameters.
+func (s *Service) lookup(request reflect.Value) (*resource, error) {
+	for _, value := range pathValues(request) {
+		if stored, ok := s.resources[value]; ok {
+			return stored, nil
+		}
+	}
+	return nil, notFound()
+}
+
+func (s *Service) newID(kind string) string {
+	s.sequence++
+	return fmt.Sprintf("ocid1.%s.oc1..fake%06d", strings.ToLower(kind), s.sequence)
+}
+
+func state(stored *resource) string {
+	value, _ := stored.values["lifecycleState"].(string)
+	return value
+}
+
+func notFound() error {
+	return ServiceError{
+		StatusCode: http.StatusNotFound,
+		Code:       "NotAuthorizedOrNotFound",
+		Message:    "Authorization failed or requested resource not found.",
+	}
+}
+
+func incorrectState(stored *resource) error {
+	return ServiceError{
+		StatusCode: http.StatusConflict,
+		Code:       "IncorrectState",
+		Message:    fmt.Sprintf("resource %v is %s", stored.values["id"], state(stored)),
+	}
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339Nano)
+}
+
+func mergeLifecycle(lifecycle Lifecycle, fallback Lifecycle) Lifecycle {
+	pick := func(value string, fallback string) string {
+		if value != "" {
+			return value
+		}
+		return fallback
+	}
+	return Lifecycle{
+		Provisioning: pick(lifecycle.Provisioning, fallback.Provisioning),
+		Updating:     pick(lifecycle.Updating, fallback.Updating),
+		Active:       pick(lifecycle.Active, fallback.Active),
+		Deleting:     pick(lifecycle.Deleting, fallback.Deleting),
+		Deleted:      pick(lifecycle.Deleted, fallback.Deleted),
+	}
+}
+
+func upperSnake(name string) string {
+	var builder strings.Builder
+	for i, r := range name {
+		if i > 0 && unicode.IsUpper(r) {
+			builder.WriteByte('_')
+		}
+		builder.WriteRune(unicode.ToUpper(r))
+	}
+	return builder.String()
+}
+
+func cloneValues(values map[string]any) map[string]any {
+	cloned := make(map[string]any, len(values)+3)
+	for key, value := range values {
+		cloned[key] = value
+	}
+	return cloned
+}
+
+// requestBody returns the request's body field as JSON values.
+func requestBody(request reflect.Value) (map[string]any, error) {
+	values := map[string]any{}
+	for i := 0; i < request.NumField(); i++ {
+		if request.Type().Field(i).Tag.Get("contributesTo") != "body" {
+			continue
+		}
+		payload, err := json.Marshal(request.Field(i).Interface())
+		if err != nil {
+			return nil, fmt.Errorf("encode fake OCI request body: %w", err)
+		}
+		if err := json.Unmarshal(payload, &values); err != nil {
+			return nil, fmt.Errorf("decode fake OCI request body: %w", err)
+		}
+	}
+	for key, value := range values {
+		if value == nil {
+			delete(values, key)
+		}
+	}
+	return values, nil
+}
+
+func pathValues(request reflect.Value) []string {
+	var values []string
+	for i := 0; i < request.NumField(); i++ {
+		if request.Type().Field(i).Tag.Get("contributesTo") != "path" {
+			continue
+		}
+		if value, ok := stringValue(request.Field(i)); ok {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// ignoredQueryParameters page and order a list rather than filter it.
+var ignoredQueryParameters = map[string]bool{"limit": true, "page": true, "sortBy": true, "sortOrder": true}
+
+func queryFilters(request reflect.Value) map[string]string {
+	filters := map[string]string{}
+	for i := 0; i < request.NumField(); i++ {
+		field := request.Type().Field(i)
+		name := field.Tag.Get("name")
+		if field.Tag.Get("contributesTo") != "query" || ignoredQueryParameters[name] {
+			continue
+		}
+		if value, ok := stringValue(request.Field(i)); ok && value != "" {
+			filters[name] = value
+		}
+	}
+	return filters
+}
+
+func matches(values map[string]any, filters map[string]string) bool {
+	for name, want := range filters {
+		if got, ok := values[name]; !ok || fmt.Sprint(got) != want {
+			return false
+		}
+	}
+	return true
+}
+
+func stringValue(value reflect.Value) (string, bool) {
+	if value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return "", false
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.String {
+		return "", false
+	}
+	return value.String(), true
+}
+
+func bodyField(response reflect.Value) (reflect.Value, bool) {
+	for i := 0; i < response.NumField(); i++ {
+		if response.Type().Field(i).Tag.Get("presentIn") == "body" {
+			return response.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+func sliceField(structType reflect.Type) (reflect.StructField, bool) {
+	if structType.Kind() != reflect.Struct {
+		return reflect.StructField{}, false
+	}
+	for i := 0; i < structType.NumField(); i++ {
+		if structType.Field(i).Type.Kind() == reflect.Slice {
+			return structType.Field(i), true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+func jsonName(field reflect.StructField) string {
+	if name, _, _ := strings.Cut(field.Tag.Get("json"), ","); name != "" {
+		return name
+	}
+	return field.Name
+}
+
+func decodeInto(payload any, target reflect.Value) error {
+	if target.Kind() == reflect.Interface {
+		return fmt.Errorf("fake OCI service cannot decode into polymorphic %s", target.Type())
+	}
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("encode fake OCI response: %w", err)
+	}
+	if err := json.Unmarshal(encoded, target.Addr().Interface()); err != nil {
+		return fmt.Errorf("decode fake OCI response into %s: %w", target.Type(), err)
+	}
+	return nil
+}
+
+func setHeader(response reflect.Value, name string, value string) {
+	for i := 0; i < response.NumField(); i++ {
+		field := response.Type().Field(i)
+		if field.Tag.Get("presentIn") != "header" || field.Tag.Get("name") != name {
+			continue
+		}
+		switch {
+		case field.Type.Kind() == reflect.Pointer && field.Type.Elem().Kind() == reflect.String:
+			response.Field(i).Set(reflect.ValueOf(&value).Convert(field.Type))
+		case field.Type.Kind() == reflect.String:
+			response.Field(i).SetString(value)
+		}
+	}
+}
+
+func setRawResponse(response reflect.Value, statusCode int, retryAfter time.Duration) {
+	field := response.FieldByName("RawResponse")
+	if !field.IsValid() || field.Type() != reflect.TypeOf((*http.Response)(nil)) {
+		return
+	}
+	header := http.Header{}
+	if retryAfter > 0 {
+		header.Set("Retry-After", strconv.Itoa(int(retryAfter.Round(time.Second)/time.Second)))
+	}
+	field.Set(reflect.ValueOf(&http.Response{StatusCode: statusCode, Header: header}))
+}
@@ -0,0 +1,360 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package fakeoci
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	queuesdk "github.com/oracle/oci-go-sdk/v65/queue"
+	streamingsdk "github.com/oracle/oci-go-sdk/v65/streaming"
+	streamingv1beta1 "github.com/oracle/oci-service-operator/api/streaming/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const testCompartmentID = "ocid1.compartment.oc1..fake"
+
+type streamHandlers struct {
+	create func(context.Context, streamingsdk.CreateStreamRequest) (streamingsdk.CreateStreamResponse, error)
+	get    func(context.Context, streamingsdk.GetStreamRequest) (streamingsdk.GetStreamResponse, error)
+	list   func(context.Context, streamingsdk.ListStreamsRequest) (streamingsdk.ListStreamsResponse, error)
+	update func(context.Context, streamingsdk.UpdateStreamRequest) (streamingsdk.UpdateStreamResponse, error)
+	delete func(context.Context, streamingsdk.DeleteStreamRequest) (streamingsdk.DeleteStreamResponse, error)
+}
+
+func newStreamHandlers(service *Service) streamHandlers {
+	return streamHandlers{
+		create: Handler[streamingsdk.CreateStreamRequest, streamingsdk.CreateStreamResponse](service),
+		get:    Handler[streamingsdk.GetStreamRequest, streamingsdk.GetStreamResponse](service),
+		list:   Handler[streamingsdk.ListStreamsRequest, streamingsdk.ListStreamsResponse](service),
+		update: Handler[streamingsdk.UpdateStreamRequest, streamingsdk.UpdateStreamResponse](service),
+		delete: Handler[streamingsdk.DeleteStreamRequest, streamingsdk.DeleteStreamResponse](service),
+	}
+}
+
+func createTestStream(t *testing.T, handlers streamHandlers, name string) streamingsdk.Stream {
+	t.Helper()
+	response, err := handlers.create(context.Background(), streamingsdk.CreateStreamRequest{
+		CreateStreamDetails: streamingsdk.CreateStreamDetails{
+			CompartmentId: common.String(testCompartmentID),
+			Name:          common.String(name),
+			Partitions:    common.Int(1),
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateStream() error = %v", err)
+	}
+	return response.Stream
+}
+
+func getTestStreamState(t *testing.T, handlers streamHandlers, id string) streamingsdk.StreamLifecycleStateEnum {
+	t.Helper()
+	response, err := handlers.get(context.Background(), streamingsdk.GetStreamRequest{StreamId: common.String(id)})
+	if err != nil {
+		t.Fatalf("GetStream() error = %v", err)
+	}
+	return response.LifecycleState
+}
+
+func TestServiceMovesResourcesThroughTheirLifecycle(t *testing.T) {
+	service := New(Options{ReadsPerTransition: 1, ForgetDeleted: true})
+	handlers := newStreamHandlers(service)
+
+	created := createTestStream(t, handlers, "orders")
+	if created.Id == nil || *created.Name != "orders" || created.LifecycleState != "PROVISIONING" {
+		t.Fatalf("CreateStream() = %+v, want a PROVISIONING stream named orders", created)
+	}
+	id := *created.Id
+	if got := getTestStreamState(t, handlers, id); got != "PROVISIONING" {
+		t.Fatalf("first GetStream() state = %s, want PROVISIONING", got)
+	}
+	if got := getTestStreamState(t, handlers, id); got != "ACTIVE" {
+		t.Fatalf("second GetStream() state = %s, want ACTIVE", got)
+	}
+
+	updated, err := handlers.update(context.Background(), streamingsdk.UpdateStreamRequest{
+		StreamId:            common.String(id),
+		UpdateStreamDetails: streamingsdk.UpdateStreamDetails{FreeformTags: map[string]string{"team": "payments"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateStream() error = %v", err)
+	}
+	if updated.LifecycleState != "UPDATING" || updated.FreeformTags["team"] != "payments" || *updated.Name != "orders" {
+		t.Fatalf("UpdateStream() = %+v, want an UPDATING stream with the new tags", updated.Stream)
+	}
+	getTestStreamState(t, handlers, id)
+	if got := getTestStreamState(t, handlers, id); got != "ACTIVE" {
+		t.Fatalf("GetStream() state = %s, want ACTIVE after the update settles", got)
+	}
+
+	if _, err := handlers.delete(context.Background(), streamingsdk.DeleteStreamRequest{StreamId: common.String(id)}); err != nil {
+		t.Fatalf("DeleteStream() error = %v", err)
+	}
+	if got := getTestStreamState(t, handlers, id); got != "DELETING" {
+		t.Fatalf("GetStream() state = %s, want DELETING", got)
+	}
+	_, err = handlers.get(context.Background(), streamingsdk.GetStreamRequest{StreamId: common.String(id)})
+	requireServiceError(t, err, http.StatusNotFound, "NotAuthorizedOrNotFound")
+	if got := service.Calls("GetStream"); got != 6 {
+		t.Fatalf("Calls(GetStream) = %d, want 6", got)
+	}
+}
+
+func TestServiceTracksWorkRequestProgress(t *testing.T) {
+	service := New(Options{WorkRequests: true, WorkRequestStep: 40})
+	getWorkRequest := Handler[queuesdk.GetWorkRequestRequest, queuesdk.GetWorkRequestResponse](service)
+	created, err := Handler[queuesdk.CreateQueueRequest, queuesdk.CreateQueueResponse](service)(context.Background(), queuesdk.CreateQueueRequest{
+		CreateQueueDetails: queuesdk.CreateQueueDetails{
+			CompartmentId: common.String(testCompartmentID),
+			DisplayName:   common.String("orders"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateQueue() error = %v", err)
+	}
+	if created.OpcWorkRequestId == nil {
+		t.Fatal("CreateQueue() opc-work-request-id = nil, want a work request")
+	}
+	request := queuesdk.GetWorkRequestRequest{WorkRequestId: created.OpcWorkRequestId}
+
+	var percents []float32
+	for {
+		response, err := getWorkRequest(context.Background(), request)
+		if err != nil {
+			t.Fatalf("GetWorkRequest() error = %v", err)
+		}
+		workRequest := response.WorkRequest
+		percents = append(percents, *workRequest.PercentComplete)
+		if workRequest.OperationType != queuesdk.OperationTypeCreateQueue ||
+			len(workRequest.Resources) != 1 ||
+			*workRequest.Resources[0].EntityType != "queue" ||
+			workRequest.Resources[0].ActionType != queuesdk.ActionTypeCreated {
+			t.Fatalf("GetWorkRequest() = %+v, want a create queue work request", workRequest)
+		}
+		if workRequest.Status == queuesdk.OperationStatusSucceeded {
+			break
+		}
+		if workRequest.Status != queuesdk.OperationStatusInProgress {
+			t.Fatalf("GetWorkRequest() status = %s, want IN_PROGRESS", workRequest.Status)
+		}
+		if got, _ := service.Resource(*workRequest.Resources[0].Identifier); got["lifecycleState"] != "PROVISIONING" {
+			t.Fatalf("queue state = %v, want PROVISIONING while the work request runs", got["lifecycleState"])
+		}
+	}
+	if len(percents) != 3 || percents[0] != 40 || percents[2] != 100 {
+		t.Fatalf("percentComplete = %v, want 40, 80, 100", percents)
+	}
+	queues, err := Handler[queuesdk.ListQueuesRequest, queuesdk.ListQueuesResponse](service)(context.Background(), queuesdk.ListQueuesRequest{
+		CompartmentId: common.String(testCompartmentID),
+	})
+	if err != nil {
+		t.Fatalf("ListQueues() error = %v", err)
+	}
+	if len(queues.Items) != 1 || queues.Items[0].LifecycleState != "ACTIVE" {
+		t.Fatalf("ListQueues() items = %+v, want one ACTIVE queue once the work request succeeded", queues.Items)
+	}
+}
+
+func TestServiceListIsEventuallyConsistent(t *testing.T) {
+	service := New(Options{ListLag: 1})
+	handlers := newStreamHandlers(service)
+	createTestStream(t, handlers, "orders")
+	createTestStream(t, handlers, "payments")
+
+	request := streamingsdk.ListStreamsRequest{
+		CompartmentId: common.String(testCompartmentID),
+		Name:          common.String("orders"),
+		Limit:         common.Int(10),
+	}
+	first, err := handlers.list(context.Background(), request)
+	if err != nil {
+		t.Fatalf("ListStreams() error = %v", err)
+	}
+	if len(first.Items) != 0 {
+		t.Fatalf("first ListStreams() items = %d, want the new stream to be missing", len(first.Items))
+	}
+	second, err := handlers.list(context.Background(), request)
+	if err != nil {
+		t.Fatalf("ListStreams() error = %v", err)
+	}
+	if len(second.Items) != 1 || *second.Items[0].Name != "orders" {
+		t.Fatalf("second ListStreams() items = %+v, want only orders", second.Items)
+	}
+}
+
+func TestServiceInjectsErrors(t *testing.T) {
+	service := New(Options{ReadsPerTransition: 1})
+	handlers := newStreamHandlers(service)
+	created := createTestStream(t, handlers, "orders")
+
+	_, err := handlers.update(context.Background(), streamingsdk.UpdateStreamRequest{StreamId: created.Id})
+	requireServiceError(t, err, http.StatusConflict, "IncorrectState")
+
+	service.Throttle("GetStream", 3*time.Second)
+	service.FailWith("GetStream", http.StatusInternalServerError, "InternalServerError")
+	throttled, err := handlers.get(context.Background(), streamingsdk.GetStreamRequest{StreamId: created.Id})
+	requireServiceError(t, err, http.StatusTooManyRequests, "TooManyRequests")
+	if throttled.RawResponse == nil || throttled.RawResponse.Header.Get("Retry-After") != "3" {
+		t.Fatalf("GetStream() raw response = %+v, want Retry-After 3", throttled.RawResponse)
+	}
+	_, err = handlers.get(context.Background(), streamingsdk.GetStreamRequest{StreamId: created.Id})
+	requireServiceError(t, err, http.StatusInternalServerError, "InternalServerError")
+	if got := getTestStreamState(t, handlers, *created.Id); got != "PROVISIONING" {
+		t.Fatalf("GetStream() state = %s, want failed reads not to advance the lifecycle", got)
+	}
+}
+
+func requireServiceError(t *testing.T, err error, statusCode int, code string) {
+	t.Helper()
+	var serviceErr common.ServiceError
+	if !errors.As(err, &serviceErr) || serviceErr.GetHTTPStatusCode() != statusCode || serviceErr.GetCode() != code {
+		t.Fatalf("error = %v, want %d %s", err, statusCode, code)
+	}
+	if serviceErr.GetOpcRequestID() == "" {
+		t.Fatalf("error = %v, want an opc-request-id", err)
+	}
+}
+
+func newTestStreamConfig() generatedruntime.Config[*streamingv1beta1.Stream] {
+	streamID := []generatedruntime.RequestField{{FieldName: "StreamId", RequestName: "streamId", Contribution: "path", PreferResourceID: true}}
+	return generatedruntime.Config[*streamingv1beta1.Stream]{
+		Kind:    "Stream",
+		SDKName: "Stream",
+		Semantics: &generatedruntime.Semantics{
+			FormalService:     "streaming",
+			FormalSlug:        "stream",
+			StatusProjection:  "required",
+			SecretSideEffects: "none",
+			FinalizerPolicy:   "retain-until-confirmed-delete",
+			Lifecycle: generatedruntime.LifecycleSemantics{
+				ProvisioningStates: []string{"CREATING"},
+				UpdatingStates:     []string{"UPDATING"},
+				ActiveStates:       []string{"ACTIVE"},
+			},
+			Delete: generatedruntime.DeleteSemantics{
+				Policy:         "required",
+				PendingStates:  []string{"DELETING"},
+				TerminalStates: []string{"DELETED"},
+			},
+			List: &generatedruntime.ListSemantics{
+				ResponseItemsField: "Items",
+				MatchFields:        []string{"compartmentId", "name", "id", "lifecycleState"},
+			},
+			Mutation: generatedruntime.MutationSemantics{
+				Mutable:  []string{"freeformTags"},
+				ForceNew: []string{"compartmentId", "name", "partitions", "retentionInHours"},
+			},
+			CreateFollowUp: generatedruntime.FollowUpSemantics{Strategy: "read-after-write"},
+			UpdateFollowUp: generatedruntime.FollowUpSemantics{Strategy: "read-after-write"},
+			DeleteFollowUp: generatedruntime.FollowUpSemantics{Strategy: "confirm-delete"},
+		},
+		Create: &generatedruntime.Operation{
+			NewRequest: func() any { return &streamingsdk.CreateStreamRequest{} },
+			Fields:     []generatedruntime.RequestField{{FieldName: "CreateStreamDetails", RequestName: "CreateStreamDetails", Contribution: "body"}},
+		},
+		Get: &generatedruntime.Operation{
+			NewRequest: func() any { return &streamingsdk.GetStreamRequest{} },
+			Fields:     streamID,
+		},
+		List: &generatedruntime.Operation{
+			NewRequest: func() any { return &streamingsdk.ListStreamsRequest{} },
+			Fields: []generatedruntime.RequestField{
+				{FieldName: "CompartmentId", RequestName: "compartmentId", Contribution: "query"},
+				{FieldName: "Name", RequestName: "name", Contribution: "query"},
+			},
+		},
+		Update: &generatedruntime.Operation{
+			NewRequest: func() any { return &streamingsdk.UpdateStreamRequest{} },
+			Fields: append(streamID, generatedruntime.RequestField{
+				FieldName: "UpdateStreamDetails", RequestName: "UpdateStreamDetails", Contribution: "body",
+			}),
+		},
+		Delete: &generatedruntime.Operation{
+			NewRequest: func() any { return &streamingsdk.DeleteStreamRequest{} },
+			Fields:     streamID,
+		},
+	}
+}
+
+// reconcileUntilSettled calls CreateOrUpdate until it stops asking for a
+// requeue, the way the controller would.
+func reconcileUntilSettled(t *testing.T, client generatedruntime.ServiceClient[*streamingv1beta1.Stream], resource *streamingv1beta1.Stream) {
+	t.Helper()
+	for attempt := 0; attempt < 10; attempt++ {
+		response, err := client.CreateOrUpdate(context.Background(), resource, ctrl.Request{})
+		if err != nil {
+			t.Fatalf("CreateOrUpdate() error = %v", err)
+		}
+		if !response.IsSuccessful {
+			t.Fatalf("CreateOrUpdate() response = %+v, want success", response)
+		}
+		if !response.ShouldRequeue {
+			return
+		}
+	}
+	t.Fatal("CreateOrUpdate() still requeues after 10 reconciles")
+}
+
+func TestInstallDrivesAGeneratedServiceClient(t *testing.T) {
+	service := New(Options{ReadsPerTransition: 1, ForgetDeleted: true})
+	config := newTestStreamConfig()
+	if err := Install(service, &config, streamingsdk.StreamAdminClient{}); err != nil {
+		t.Fatalf("Install() error = %v", err)
+	}
+	client := generatedruntime.NewServiceClient(config)
+
+	resource := &streamingv1beta1.Stream{Spec: streamingv1beta1.StreamSpec{
+		CompartmentId: testCompartmentID,
+		Name:          "orders",
+		Partitions:    1,
+		FreeformTags:  map[string]string{"team": "orders"},
+	}}
+	resource.Name = "orders"
+	resource.Namespace = "default"
+	reconcileUntilSettled(t, client, resource)
+	id := string(resource.Status.OsokStatus.Ocid)
+	live, ok := service.Resource(id)
+	if !ok || live["lifecycleState"] != "ACTIVE" || live["name"] != "orders" {
+		t.Fatalf("fake stream %q = %v, want an ACTIVE stream named orders", id, live)
+	}
+	if got := service.Calls("CreateStream"); got != 1 {
+		t.Fatalf("Calls(CreateStream) = %d, want 1", got)
+	}
+
+	if err := service.Mutate(id, func(values map[string]any) {
+		values["freeformTags"] = map[string]any{"team": "someone-else"}
+	}); err != nil {
+		t.Fatalf("Mutate() error = %v", err)
+	}
+	reconcileUntilSettled(t, client, resource)
+	if got := service.Calls("UpdateStream"); got != 1 {
+		t.Fatalf("Calls(UpdateStream) = %d, want drift to be corrected with one update", got)
+	}
+	if live, _ := service.Resource(id); live["freeformTags"].(map[string]any)["team"] != "orders" {
+		t.Fatalf("fake stream freeformTags = %v, want the spec tags back", live["freeformTags"])
+	}
+
+	for attempt := 0; ; attempt++ {
+		deleted, err := client.Delete(context.Background(), resource)
+		if err != nil {
+			t.Fatalf("Delete() error = %v", err)
+		}
+		if deleted {
+			break
+		}
+		if attempt == 10 {
+			t.Fatal("Delete() is still pending after 10 attempts")
+		}
+	}
+	if _, ok := service.Resource(id); ok {
+		t.Fatalf("fake stream %s still exists after delete", id)
+	}
+}
@@ -0,0 +1,124 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package fakeoci
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
+)
+
+var contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+
+// Install points every operation of cfg, and its work request polling, at
+// s. clients are zero values of the SDK client types the kind calls, such
+// as queuesdk.QueueAdminClient{}; their method sets map each request type
+// to the response type the fake has to return. The fake also takes its
+// lifecycle state names from cfg.Semantics unless Options.Lifecycle set
+// them, and returns work requests when the semantics poll them.
+func Install[T any](s *Service, cfg *generatedruntime.Config[T], clients ...any) error {
+	responses := responseTypes(clients)
+	s.useSemantics(cfg.Semantics)
+
+	for _, operation := range []**generatedruntime.Operation{
+		&cfg.Create, &cfg.Get, &cfg.List, &cfg.Update, &cfg.Delete, &cfg.Read.Get, &cfg.Read.List,
+	} {
+		if *operation == nil || (*operation).NewRequest == nil {
+			continue
+		}
+		requestType := indirect(reflect.TypeOf((*operation).NewRequest()))
+		responseType, ok := responses[requestType]
+		if !ok {
+			return fmt.Errorf("fake OCI: no client method takes %s", requestType)
+		}
+		replaced := **operation
+		replaced.Call = func(_ context.Context, request any) (any, error) {
+			return s.serve(request, responseType)
+		}
+		*operation = &replaced
+	}
+
+	if !usesWorkRequests(cfg.Semantics) {
+		return nil
+	}
+	for requestType, responseType := range responses {
+		if requestType.Name() != "GetWorkRequestRequest" {
+			continue
+		}
+		cfg.Async.GetWorkRequest = func(_ context.Context, workRequestID string) (any, error) {
+			request := reflect.New(requestType).Elem()
+			if field := request.FieldByName("WorkRequestId"); field.IsValid() {
+				field.Set(reflect.ValueOf(common.String(workRequestID)))
+			}
+			response, err := s.serve(request.Interface(), responseType)
+			if err != nil {
+				return nil, err
+			}
+			body, _ := bodyField(reflect.ValueOf(response))
+			return body.Interface(), nil
+		}
+		return nil
+	}
+	return fmt.Errorf("fake OCI: %s uses work requests but no client method takes a GetWorkRequestRequest", cfg.Kind)
+}
+
+// responseTypes maps each request type a client method takes to the
+// response type it returns.
+func responseTypes(clients []any) map[reflect.Type]reflect.Type {
+	responses := map[reflect.Type]reflect.Type{}
+	for _, client := range clients {
+		clientType := reflect.TypeOf(client)
+		if clientType.Kind() != reflect.Pointer {
+			clientType = reflect.PointerTo(clientType)
+		}
+		for i := 0; i < clientType.NumMethod(); i++ {
+			method := clientType.Method(i).Type
+			// The receiver is the first input.
+			if method.NumIn() != 3 || method.In(1) != contextType || method.NumOut() != 2 {
+				continue
+			}
+			responses[method.In(2)] = method.Out(0)
+		}
+	}
+	return responses
+}
+
+func (s *Service) useSemantics(semantics *generatedruntime.Semantics) {
+	if semantics == nil {
+		return
+	}
+	first := func(values []string) string {
+		if len(values) == 0 {
+			return ""
+		}
+		return values[0]
+	}
+	fromSemantics := Lifecycle{
+		Provisioning: first(semantics.Lifecycle.ProvisioningStates),
+		Updating:     first(semantics.Lifecycle.UpdatingStates),
+		Active:       first(semantics.Lifecycle.ActiveStates),
+		Deleting:     first(semantics.Delete.PendingStates),
+		Deleted:      first(semantics.Delete.TerminalStates),
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.options.WorkRequests = s.options.WorkRequests || usesWorkRequests(semantics)
+	s.lifecycle = mergeLifecycle(s.options.Lifecycle, mergeLifecycle(fromSemantics, DefaultLifecycle))
+}
+
+func usesWorkRequests(semantics *generatedruntime.Semantics) bool {
+	return semantics != nil && semantics.Async != nil && semantics.Async.WorkRequest != nil
+}
+
+func indirect(value reflect.Type) reflect.Type {
+	for value.Kind() == reflect.Pointer {
+		value = value.Elem()
+	}
+	return value
+}
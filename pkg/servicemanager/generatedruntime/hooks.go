@@ -165,6 +165,9 @@ func (c ServiceClient[T]) handleParityHooks(
 		if c.config.ParityHooks.ApplyParityUpdate == nil {
 			return servicemanager.OSOKResponse{}, fmt.Errorf("%s parity hooks require ApplyParityUpdate when RequiresParityHandling returns true", c.config.Kind), true
 		}
+		if plan := servicemanager.PlanFrom(ctx); plan != nil {
+			return servicemanager.OSOKResponse{}, c.planOperation(plan, shared.OSOKAsyncPhaseUpdate, resource, state.currentID, nil, state.liveResponse), true
+		}
 		response, err := c.config.ParityHooks.ApplyParityUpdate(ctx, resource, state.liveResponse)
 		return response, err, true
 	}
@@ -30,6 +30,20 @@ func (c ServiceClient[T]) shouldInvokeUpdate(ctx context.Context, resource T, na
 	return c.hasMutableDrift(resource, currentResponse)
 }
 
+// detectDrift reports whether the live resource differs from spec in a field
+// the update operation can change. Unlike shouldInvokeUpdate it never assumes
+// drift when the kind has no mutation semantics to compare against.
+func (c ServiceClient[T]) detectDrift(ctx context.Context, resource T, namespace string, currentResponse any) (bool, error) {
+	if c.config.Update == nil || c.shouldObserveCurrentLifecycle(currentResponse) {
+		return false, nil
+	}
+	if c.config.BuildUpdateBody != nil {
+		_, updateNeeded, err := c.config.BuildUpdateBody(ctx, resource, namespace, currentResponse)
+		return updateNeeded, err
+	}
+	return c.hasMutableDrift(resource, currentResponse)
+}
+
 func (c ServiceClient[T]) shouldObserveCurrentLifecycle(currentResponse any) bool {
 	if c.config.Semantics == nil || currentResponse == nil {
 		return false
@@ -83,7 +97,10 @@ func (c ServiceClient[T]) validateCreateOnlyDrift(resource T, currentResponse an
 	if currentResponse == nil || c.config.ParityHooks.ValidateCreateOnlyDrift == nil {
 		return nil
 	}
-	return c.config.ParityHooks.ValidateCreateOnlyDrift(resource, currentResponse)
+	if err := c.config.ParityHooks.ValidateCreateOnlyDrift(resource, currentResponse); err != nil {
+		return replacementRequiredError{err: err}
+	}
+	return nil
 }
 
 func mutationValues(resource any, currentResponse any) (map[string]any, map[string]any, error) {
@@ -135,7 +152,7 @@ func (c ServiceClient[T]) validateForceNewFields(resource T, specValues map[stri
 			continue
 		}
 		if !forceNewValuesEqual(wantedValue, statusValue) {
-			return fmt.Errorf("%s formal semantics require replacement when %s changes", c.config.Kind, field)
+			return replacementRequiredError{err: fmt.Errorf("%s formal semantics require replacement when %s changes", c.config.Kind, field)}
 		}
 	}
 	return nil
@@ -0,0 +1,90 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package generatedruntime
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	shared "github.com/oracle/oci-service-operator/pkg/shared"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func TestServiceClientObserveOnlyResumesCreateWorkRequestWithoutMutation(t *testing.T) {
+	t.Parallel()
+
+	config := newObserveOnlyTestConfig(t, map[string]fakeWorkRequest{
+		"wr-create-paused": {Id: "wr-create-paused", Status: "SUCCEEDED", Action: "CREATED", ResourceID: "ocid1.thing.oc1..created"},
+	})
+	config.Get = &Operation{
+		NewRequest: func() any { return &fakeGetThingRequest{} },
+		Call: func(_ context.Context, _ any) (any, error) {
+			return fakeGetThingResponse{Thing: fakeThing{Id: "ocid1.thing.oc1..created", LifecycleState: "ACTIVE"}}, nil
+		},
+		Fields: []RequestField{{FieldName: "ThingId", RequestName: "thingId", Contribution: "path", PreferResourceID: true}},
+	}
+	resource := &fakeResource{Status: fakeStatus{CreateWorkRequestId: "wr-create-paused"}}
+
+	response, err := NewServiceClient[*fakeResource](config).CreateOrUpdate(servicemanager.WithObserveOnly(context.Background()), resource, ctrl.Request{})
+	requireCreateOrUpdateSuccess(t, response, err)
+	requireStatusOCID(t, resource, "ocid1.thing.oc1..created")
+	requireTrailingCondition(t, resource, shared.Active)
+}
+
+func TestServiceClientObserveOnlyCreateOrUpdateWithoutWorkRequestIsNoop(t *testing.T) {
+	t.Parallel()
+
+	config := newObserveOnlyTestConfig(t, nil)
+	resource := &fakeResource{Spec: fakeSpec{DisplayName: "paused"}}
+
+	response, err := NewServiceClient[*fakeResource](config).CreateOrUpdate(servicemanager.WithObserveOnly(context.Background()), resource, ctrl.Request{})
+	requireCreateOrUpdateSuccess(t, response, err)
+	requireStatusOCID(t, resource, "")
+	if len(resource.Status.OsokStatus.Conditions) != 0 {
+		t.Fatalf("status.conditions = %#v, want untouched status", resource.Status.OsokStatus.Conditions)
+	}
+}
+
+func TestServiceClientObserveOnlyDeleteWithoutWorkRequestIsNoop(t *testing.T) {
+	t.Parallel()
+
+	config := newObserveOnlyTestConfig(t, nil)
+	resource := &fakeResource{Status: fakeStatus{OsokStatus: shared.OSOKStatus{Ocid: "ocid1.thing.oc1..paused"}}}
+
+	deleted, err := NewServiceClient[*fakeResource](config).Delete(servicemanager.WithObserveOnly(context.Background()), resource)
+	if err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	requireTrue(t, !deleted, "Delete() should not report deletion while observing read-only")
+	requireStatusOCID(t, resource, "ocid1.thing.oc1..paused")
+}
+
+func TestServiceClientInvokeRefusesMutationWhileObservingReadOnly(t *testing.T) {
+	t.Parallel()
+
+	config := newObserveOnlyTestConfig(t, nil)
+	client := NewServiceClient[*fakeResource](config)
+
+	_, err := client.invoke(servicemanager.WithObserveOnly(context.Background()), client.config.Update, &fakeResource{}, "ocid1.thing.oc1..paused", requestBuildOptions{})
+	if err == nil || !strings.Contains(err.Error(), "refused an OCI mutation") {
+		t.Fatalf("invoke() error = %v, want observe-only refusal", err)
+	}
+}
+
+func newObserveOnlyTestConfig(t *testing.T, workRequests map[string]fakeWorkRequest) Config[*fakeResource] {
+	t.Helper()
+	config := newFakeWorkRequestConfig(workRequests)
+	mutation := func(_ context.Context, _ any) (any, error) {
+		t.Fatal("OCI mutation should not run while observing read-only")
+		return nil, nil
+	}
+	config.Create = &Operation{NewRequest: func() any { return &fakeCreateThingRequest{} }, Call: mutation}
+	config.Update = &Operation{NewRequest: func() any { return &fakeUpdateThingRequest{} }, Call: mutation}
+	config.Delete = &Operation{NewRequest: func() any { return &fakeDeleteThingRequest{} }, Call: mutation}
+	return config
+}
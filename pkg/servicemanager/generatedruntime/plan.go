@@ -0,0 +1,136 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package generatedruntime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	shared "github.com/oracle/oci-service-operator/pkg/shared"
+)
+
+// errPlannedMutation stops a reconcile in plan mode at the first OCI
+// mutation, after it was recorded in the plan. CreateOrUpdate and Delete
+// return it as an unsuccessful response without an error and leave status as
+// it was.
+var errPlannedMutation = errors.New("OCI mutation recorded in plan mode")
+
+func (c ServiceClient[T]) operationPhase(op *Operation) shared.OSOKAsyncPhase {
+	switch op {
+	case c.config.Create:
+		return shared.OSOKAsyncPhaseCreate
+	case c.config.Update:
+		return shared.OSOKAsyncPhaseUpdate
+	default:
+		return shared.OSOKAsyncPhaseDelete
+	}
+}
+
+// planOperation records the operation the reconcile would send. request is
+// the fully built OCI request, or nil when a hook owns the call.
+func (c ServiceClient[T]) planOperation(
+	plan *servicemanager.Plan,
+	phase shared.OSOKAsyncPhase,
+	resource T,
+	ocid string,
+	request any,
+	currentResponse any,
+) error {
+	operation := servicemanager.PlannedOperation{Operation: phase, OCID: ocid}
+	if body, ok := requestBody(request); ok {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("render planned %s %s request: %w", c.config.Kind, phase, err)
+		}
+		operation.Request = payload
+	}
+	if phase == shared.OSOKAsyncPhaseUpdate {
+		changes, err := c.plannedChanges(resource, currentResponse)
+		if err != nil {
+			return err
+		}
+		operation.Changes = changes
+	}
+	plan.Record(operation)
+	return errPlannedMutation
+}
+
+// plannedChanges lists the spec fields that differ from the live resource,
+// limited to the mutable fields when the kind has mutation semantics.
+func (c ServiceClient[T]) plannedChanges(resource T, currentResponse any) ([]servicemanager.PlannedFieldChange, error) {
+	specValues, currentValues, err := mutationValues(resource, currentResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	var mutable []string
+	if c.config.Semantics != nil {
+		mutable = c.config.Semantics.Mutation.Mutable
+	}
+	var changes []servicemanager.PlannedFieldChange
+	for _, path := range comparableDiffPaths(specValues, currentValues, "") {
+		if len(mutable) > 0 && !pathCoveredByAny(path, mutable) {
+			continue
+		}
+		desired, _ := lookupValueByPath(specValues, path)
+		current, _ := lookupValueByPath(currentValues, path)
+		changes = append(changes, servicemanager.PlannedFieldChange{Path: path, Current: current, Desired: desired})
+	}
+	return changes, nil
+}
+
+// requestBody returns the body field of an OCI SDK request.
+func requestBody(request any) (any, bool) {
+	value := reflect.ValueOf(request)
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return nil, false
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil, false
+	}
+	for i := 0; i < value.NumField(); i++ {
+		if value.Type().Field(i).Tag.Get("contributesTo") == "body" {
+			return value.Field(i).Interface(), true
+		}
+	}
+	return nil, false
+}
+
+// planReplacement records both calls of a replacement in the order the
+// strategy runs them, without touching status.
+func (c ServiceClient[T]) planReplacement(
+	ctx context.Context,
+	resource T,
+	namespace string,
+	previousID string,
+	strategy shared.OSOKReplaceStrategy,
+) (servicemanager.OSOKResponse, error) {
+	create := func() error {
+		_, err := c.invoke(ctx, c.config.Create, resource, "", c.requestBuildOptions(ctx, namespace))
+		return err
+	}
+	remove := func() error {
+		_, err := c.invoke(ctx, c.config.Delete, resource, previousID, requestBuildOptions{})
+		return err
+	}
+	steps := []func() error{create, remove}
+	if strategy == shared.ReplaceDeleteBeforeCreate {
+		steps = []func() error{remove, create}
+	}
+	for _, step := range steps {
+		if err := step(); err != nil && !errors.Is(err, errPlannedMutation) {
+			return c.failCreateOrUpdate(resource, err)
+		}
+	}
+	return servicemanager.OSOKResponse{}, errPlannedMutation
+}
@@ -0,0 +1,182 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package generatedruntime
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/oracle/oci-service-operator/pkg/errorutil/errortest"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	shared "github.com/oracle/oci-service-operator/pkg/shared"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const planExistingID = "ocid1.thing.oc1..existing"
+
+func newPlanClient(t *testing.T, things map[string]fakeThing) ServiceClient[*fakeResource] {
+	t.Helper()
+	return NewServiceClient[*fakeResource](Config[*fakeResource]{
+		Kind:    "Thing",
+		SDKName: "Thing",
+		Semantics: &Semantics{
+			Lifecycle: LifecycleSemantics{ProvisioningStates: []string{"CREATING"}, ActiveStates: []string{"ACTIVE"}},
+			Delete:    DeleteSemantics{Policy: "required", PendingStates: []string{"DELETING"}, TerminalStates: []string{"DELETED"}},
+			Mutation:  MutationSemantics{Mutable: []string{"displayName"}, ForceNew: []string{"compartmentId"}},
+		},
+		Create: &Operation{NewRequest: func() any { return &fakeCreateThingRequest{} }, Call: func(_ context.Context, _ any) (any, error) {
+			t.Fatal("Create() should not be called in plan mode")
+			return nil, nil
+		}},
+		Get: &Operation{NewRequest: func() any { return &fakeGetThingRequest{} }, Call: func(_ context.Context, request any) (any, error) {
+			thing, ok := things[*request.(*fakeGetThingRequest).ThingId]
+			if !ok {
+				return nil, errortest.NewServiceError(404, "NotAuthorizedOrNotFound", "thing not found")
+			}
+			return fakeGetThingResponse{Thing: thing}, nil
+		}},
+		Update: &Operation{NewRequest: func() any { return &fakeUpdateThingRequest{} }, Call: func(_ context.Context, _ any) (any, error) {
+			t.Fatal("Update() should not be called in plan mode")
+			return nil, nil
+		}},
+		Delete: &Operation{NewRequest: func() any { return &fakeDeleteThingRequest{} }, Call: func(_ context.Context, _ any) (any, error) {
+			t.Fatal("Delete() should not be called in plan mode")
+			return nil, nil
+		}},
+	})
+}
+
+func newPlanExistingResource() *fakeResource {
+	return &fakeResource{
+		Name:      "thing",
+		Namespace: "default",
+		UID:       "uid-1",
+		Spec:      fakeSpec{CompartmentId: "ocid1.compartment.oc1..example", DisplayName: "desired"},
+		Status: fakeStatus{
+			OsokStatus:    shared.OSOKStatus{Ocid: planExistingID},
+			Id:            planExistingID,
+			CompartmentId: "ocid1.compartment.oc1..example",
+			DisplayName:   "live",
+		},
+	}
+}
+
+func requireSinglePlannedOperation(t *testing.T, plan *servicemanager.Plan, phase shared.OSOKAsyncPhase) servicemanager.PlannedOperation {
+	t.Helper()
+	operations := plan.Operations()
+	if len(operations) != 1 {
+		t.Fatalf("planned operations = %#v, want exactly one %s", operations, phase)
+	}
+	if operations[0].Operation != phase {
+		t.Fatalf("planned operation = %q, want %q", operations[0].Operation, phase)
+	}
+	return operations[0]
+}
+
+func requirePlannedCreateOrUpdate(t *testing.T, client ServiceClient[*fakeResource], resource *fakeResource, plan *servicemanager.Plan) {
+	t.Helper()
+	response, err := client.CreateOrUpdate(servicemanager.WithPlan(context.Background(), plan), resource, ctrl.Request{})
+	if err != nil {
+		t.Fatalf("CreateOrUpdate() error = %v", err)
+	}
+	if response.IsSuccessful {
+		t.Fatal("CreateOrUpdate() successful = true, want false for a planned mutation")
+	}
+}
+
+func TestServiceClientPlansCreateWithoutCallingOCI(t *testing.T) {
+	t.Parallel()
+
+	client := newPlanClient(t, map[string]fakeThing{})
+	resource := &fakeResource{
+		Name:      "thing",
+		Namespace: "default",
+		UID:       "uid-1",
+		Spec:      fakeSpec{CompartmentId: "ocid1.compartment.oc1..example", DisplayName: "thing"},
+	}
+	plan := &servicemanager.Plan{}
+
+	requirePlannedCreateOrUpdate(t, client, resource, plan)
+
+	operation := requireSinglePlannedOperation(t, plan, shared.OSOKAsyncPhaseCreate)
+	var body map[string]any
+	if err := json.Unmarshal(operation.Request, &body); err != nil {
+		t.Fatalf("planned request %s is not JSON: %v", operation.Request, err)
+	}
+	if body["compartmentId"] != "ocid1.compartment.oc1..example" || body["displayName"] != "thing" {
+		t.Fatalf("planned request body = %v, want the spec compartmentId and displayName", body)
+	}
+	if resource.Status.OsokStatus.Ocid != "" || resource.Status.OsokStatus.Async.Current != nil {
+		t.Fatalf("status = %#v, want no OCID or async operation after a planned create", resource.Status.OsokStatus)
+	}
+}
+
+func TestServiceClientPlansUpdateWithFieldChanges(t *testing.T) {
+	t.Parallel()
+
+	client := newPlanClient(t, map[string]fakeThing{
+		planExistingID: {Id: planExistingID, CompartmentId: "ocid1.compartment.oc1..example", DisplayName: "live", LifecycleState: "ACTIVE"},
+	})
+	resource := newPlanExistingResource()
+	plan := &servicemanager.Plan{}
+
+	requirePlannedCreateOrUpdate(t, client, resource, plan)
+
+	operation := requireSinglePlannedOperation(t, plan, shared.OSOKAsyncPhaseUpdate)
+	if operation.OCID != planExistingID {
+		t.Fatalf("planned update OCID = %q, want %q", operation.OCID, planExistingID)
+	}
+	want := servicemanager.PlannedFieldChange{Path: "displayName", Current: "live", Desired: "desired"}
+	if len(operation.Changes) != 1 || operation.Changes[0] != want {
+		t.Fatalf("planned changes = %#v, want [%#v]", operation.Changes, want)
+	}
+	if got := plan.Summary(); got != "Plan mode: would update "+planExistingID+" (displayName)" {
+		t.Fatalf("plan summary = %q", got)
+	}
+}
+
+func TestServiceClientPlansNothingWhenInSync(t *testing.T) {
+	t.Parallel()
+
+	client := newPlanClient(t, map[string]fakeThing{
+		planExistingID: {Id: planExistingID, CompartmentId: "ocid1.compartment.oc1..example", DisplayName: "desired", LifecycleState: "ACTIVE"},
+	})
+	resource := newPlanExistingResource()
+	plan := &servicemanager.Plan{}
+
+	response, err := client.CreateOrUpdate(servicemanager.WithPlan(context.Background(), plan), resource, ctrl.Request{})
+	requireCreateOrUpdateSuccess(t, response, err)
+	if operations := plan.Operations(); len(operations) != 0 {
+		t.Fatalf("planned operations = %#v, want none for an in-sync resource", operations)
+	}
+}
+
+func TestServiceClientPlansDeleteWithoutCallingOCI(t *testing.T) {
+	t.Parallel()
+
+	client := newPlanClient(t, map[string]fakeThing{
+		planExistingID: {Id: planExistingID, CompartmentId: "ocid1.compartment.oc1..example", LifecycleState: "ACTIVE"},
+	})
+	resource := newPlanExistingResource()
+	plan := &servicemanager.Plan{}
+
+	result, err := client.DeleteWithResult(servicemanager.WithPlan(context.Background(), plan), resource)
+	if err != nil {
+		t.Fatalf("DeleteWithResult() error = %v", err)
+	}
+	if result.Deleted {
+		t.Fatal("DeleteWithResult() deleted = true, want false in plan mode")
+	}
+
+	operation := requireSinglePlannedOperation(t, plan, shared.OSOKAsyncPhaseDelete)
+	if operation.OCID != planExistingID {
+		t.Fatalf("planned delete OCID = %q, want %q", operation.OCID, planExistingID)
+	}
+	if resource.Status.OsokStatus.Async.Current != nil {
+		t.Fatalf("status.async.current = %#v, want nil after a planned delete", resource.Status.OsokStatus.Async.Current)
+	}
+}
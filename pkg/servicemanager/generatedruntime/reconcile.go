@@ -7,6 +7,7 @@ package generatedruntime
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
@@ -15,6 +16,15 @@ import (
 )
 
 func (c ServiceClient[T]) CreateOrUpdate(ctx context.Context, resource T, req ctrl.Request) (servicemanager.OSOKResponse, error) {
+	response, err := c.createOrUpdate(ctx, resource, req)
+	if errors.Is(err, errPlannedMutation) {
+		// Not successful, so wrapping clients skip their follow-up work.
+		return servicemanager.OSOKResponse{}, nil
+	}
+	return response, err
+}
+
+func (c ServiceClient[T]) createOrUpdate(ctx context.Context, resource T, req ctrl.Request) (servicemanager.OSOKResponse, error) {
 	if response, err, handled := c.validateCreateOrUpdateRequest(resource); handled {
 		return response, err
 	}
@@ -23,6 +33,12 @@ func (c ServiceClient[T]) CreateOrUpdate(ctx context.Context, resource T, req ct
 	if err != nil {
 		return c.failCreateOrUpdate(resource, err)
 	}
+	if replacement := c.currentReplacement(resource); replacement != nil {
+		if servicemanager.IsObserveOnly(ctx) {
+			return servicemanager.OSOKResponse{IsSuccessful: true}, nil
+		}
+		return c.resumeReplacement(ctx, resource, resourceNamespace(resource, req.Namespace), identity, *replacement)
+	}
 	if workRequestID, phase := c.currentGeneratedWorkRequest(resource); workRequestID != "" {
 		switch phase {
 		case shared.OSOKAsyncPhaseCreate, shared.OSOKAsyncPhaseUpdate:
@@ -31,6 +47,19 @@ func (c ServiceClient[T]) CreateOrUpdate(ctx context.Context, resource T, req ct
 			return c.failCreateOrUpdate(resource, fmt.Errorf("%s delete work request %s is still active during CreateOrUpdate", c.config.Kind, workRequestID))
 		}
 	}
+	if servicemanager.IsObserveOnly(ctx) {
+		return servicemanager.OSOKResponse{IsSuccessful: true}, nil
+	}
+
+	adoptID, err := c.pendingAdoptionID(resource)
+	if err != nil {
+		return c.failCreateOrUpdate(resource, err)
+	}
+	if adoptID != "" {
+		if err := c.adoptExistingResource(ctx, resource, adoptID); err != nil {
+			return c.failCreateOrUpdate(resource, err)
+		}
+	}
 
 	namespace := resourceNamespace(resource, req.Namespace)
 	state, err := c.prepareCreateOrUpdateState(ctx, resource, identity)
@@ -41,6 +70,9 @@ func (c ServiceClient[T]) CreateOrUpdate(ctx context.Context, resource T, req ct
 		if state.restoreSyntheticTrackedID != nil {
 			state.restoreSyntheticTrackedID()
 		}
+		if strategy, ok := c.replaceStrategy(ctx, err); ok && state.currentID != "" {
+			return c.startReplacement(ctx, resource, namespace, state.identity, state.currentID, strategy, err)
+		}
 		return c.failCreateOrUpdate(resource, err)
 	}
 
@@ -91,6 +123,9 @@ func (c ServiceClient[T]) validateCreateOrUpdateRequest(resource T) (servicemana
 }
 
 func (c ServiceClient[T]) reconcileExistingResource(ctx context.Context, resource T, state createOrUpdateState, namespace string) (servicemanager.OSOKResponse, error) {
+	if resync, autoCorrect := servicemanager.DriftCheck(ctx); resync {
+		return c.resyncExistingResource(ctx, resource, state, namespace, autoCorrect)
+	}
 	shouldUpdate, err := c.shouldInvokeUpdate(ctx, resource, namespace, state.liveResponse)
 	if err != nil {
 		return c.failCreateOrUpdate(resource, err)
@@ -101,6 +136,25 @@ func (c ServiceClient[T]) reconcileExistingResource(ctx context.Context, resourc
 	return c.observeExistingResource(ctx, resource, state.currentID, state.liveResponse, state.identity)
 }
 
+// resyncExistingResource compares the live resource against spec during a
+// drift resync. Drift is always reported; the update only runs when
+// autoCorrect is set.
+func (c ServiceClient[T]) resyncExistingResource(ctx context.Context, resource T, state createOrUpdateState, namespace string, autoCorrect bool) (servicemanager.OSOKResponse, error) {
+	drifted, err := c.detectDrift(ctx, resource, namespace, state.liveResponse)
+	if err != nil {
+		return c.failCreateOrUpdate(resource, err)
+	}
+
+	var response servicemanager.OSOKResponse
+	if drifted && autoCorrect {
+		response, err = c.updateExistingResource(ctx, resource, state.currentID, namespace, state.liveResponse, state.identity)
+	} else {
+		response, err = c.observeExistingResource(ctx, resource, state.currentID, state.liveResponse, state.identity)
+	}
+	response.DriftDetected = drifted
+	return response, err
+}
+
 func (c ServiceClient[T]) updateExistingResource(ctx context.Context, resource T, currentID string, namespace string, currentResponse any, identity any) (servicemanager.OSOKResponse, error) {
 	options := c.requestBuildOptions(ctx, namespace)
 	options.CurrentResponse = currentResponse
@@ -0,0 +1,250 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package generatedruntime
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	shared "github.com/oracle/oci-service-operator/pkg/shared"
+	"github.com/oracle/oci-service-operator/pkg/util"
+)
+
+const dependencyRequeueDuration = 15 * time.Second
+
+// ReferenceField pairs a raw OCID spec field with the sibling *Ref field that
+// names the OSOK resource it can be resolved from. FieldName is a string or
+// []string field (VcnId, SecurityListIds); RefFieldName is the matching
+// *shared.ResourceRef or []shared.ResourceRef field (VcnRef, SecurityListRefs).
+type ReferenceField struct {
+	FieldName    string
+	RefFieldName string
+	APIVersion   string
+	Kind         string
+}
+
+// ReferenceClient is the CreateOrUpdate/Delete surface shared by every
+// generated service client interface.
+type ReferenceClient[T any] interface {
+	CreateOrUpdate(context.Context, T, ctrl.Request) (servicemanager.OSOKResponse, error)
+	Delete(context.Context, T) (bool, error)
+}
+
+type referenceResolvingClient[T any] struct {
+	kind       string
+	log        loggerutil.OSOKLogger
+	references []ReferenceField
+	delegate   ReferenceClient[T]
+}
+
+// WithReferenceResolution wraps delegate so that spec *Ref fields are resolved
+// into their OCID fields before the delegate builds any OCI request. The
+// resolved OCIDs only live on the in-memory object; the stored spec keeps the
+// reference. While a referenced resource is missing or not Active the wrapper
+// records DependencyNotReady and requeues without calling the delegate.
+func WithReferenceResolution[T any](kind string, log loggerutil.OSOKLogger, references []ReferenceField, delegate ReferenceClient[T]) ReferenceClient[T] {
+	if len(references) == 0 {
+		return delegate
+	}
+	return referenceResolvingClient[T]{
+		kind:       kind,
+		log:        log,
+		references: append([]ReferenceField(nil), references...),
+		delegate:   delegate,
+	}
+}
+
+func (c referenceResolvingClient[T]) CreateOrUpdate(ctx context.Context, resource T, req ctrl.Request) (servicemanager.OSOKResponse, error) {
+	if servicemanager.IsObserveOnly(ctx) {
+		return c.delegate.CreateOrUpdate(ctx, resource, req)
+	}
+
+	waiting, err := resolveReferences(ctx, resource, c.references)
+	if err != nil {
+		err = fmt.Errorf("%s reference resolution failed: %w", c.kind, err)
+		c.markReferenceFailure(resource, err)
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+	if waiting != "" {
+		c.markDependencyNotReady(resource, waiting)
+		return servicemanager.OSOKResponse{
+			IsSuccessful:       false,
+			ShouldRequeue:      true,
+			RequeueDuration:    dependencyRequeueDuration,
+			DependencyNotReady: true,
+		}, nil
+	}
+	return c.delegate.CreateOrUpdate(ctx, resource, req)
+}
+
+func (c referenceResolvingClient[T]) Delete(ctx context.Context, resource T) (bool, error) {
+	return c.delegate.Delete(ctx, resource)
+}
+
+func (c referenceResolvingClient[T]) markDependencyNotReady(resource T, message string) {
+	status, err := osokStatus(resource)
+	if err != nil {
+		return
+	}
+	now := metav1.Now()
+	status.Message = message
+	status.Reason = string(shared.DependencyNotReady)
+	status.UpdatedAt = &now
+	*status = util.UpdateOSOKStatusCondition(*status, shared.DependencyNotReady, v1.ConditionTrue, "", message, c.log)
+}
+
+func (c referenceResolvingClient[T]) markReferenceFailure(resource T, err error) {
+	status, statusErr := osokStatus(resource)
+	if statusErr != nil {
+		return
+	}
+	now := metav1.Now()
+	status.Message = err.Error()
+	status.Reason = string(shared.Failed)
+	status.UpdatedAt = &now
+	*status = util.UpdateOSOKStatusCondition(*status, shared.Failed, v1.ConditionFalse, "", err.Error(), c.log)
+}
+
+// resolveReferences fills every referenced OCID field on resource. It returns
+// a non-empty message naming the first dependency that is not ready yet.
+func resolveReferences(ctx context.Context, resource any, references []ReferenceField) (string, error) {
+	resourceValue, err := resourceStruct(resource)
+	if err != nil {
+		return "", err
+	}
+	spec, ok := fieldValue(resourceValue, "Spec")
+	if !ok || spec.Kind() != reflect.Struct {
+		return "", fmt.Errorf("resource %T does not expose Spec", resource)
+	}
+	namespace := lookupMetadataString(resourceValue, "Namespace")
+
+	for _, reference := range references {
+		refs, err := referenceTargets(spec, reference)
+		if err != nil {
+			return "", err
+		}
+		if len(refs) == 0 {
+			continue
+		}
+
+		target, _ := fieldValue(spec, reference.FieldName)
+		if !target.IsZero() {
+			return "", fmt.Errorf("set either spec.%s or spec.%s, not both",
+				specJSONName(spec, reference.FieldName), specJSONName(spec, reference.RefFieldName))
+		}
+
+		ocids := make([]string, 0, len(refs))
+		for _, ref := range refs {
+			ocid, waiting, err := referencedOCID(ctx, reference, ref, namespace)
+			if err != nil || waiting != "" {
+				return waiting, err
+			}
+			ocids = append(ocids, ocid)
+		}
+		if target.Kind() == reflect.String {
+			target.SetString(ocids[0])
+		} else {
+			target.Set(reflect.ValueOf(ocids))
+		}
+	}
+	return "", nil
+}
+
+func referenceTargets(spec reflect.Value, reference ReferenceField) ([]shared.ResourceRef, error) {
+	refField, ok := fieldValue(spec, reference.RefFieldName)
+	if !ok {
+		return nil, fmt.Errorf("spec does not expose reference field %s", reference.RefFieldName)
+	}
+	target, ok := fieldValue(spec, reference.FieldName)
+	if !ok {
+		return nil, fmt.Errorf("spec does not expose referenced field %s", reference.FieldName)
+	}
+
+	switch refs := refField.Interface().(type) {
+	case *shared.ResourceRef:
+		if target.Kind() != reflect.String {
+			return nil, fmt.Errorf("spec field %s must be a string to take a single reference", reference.FieldName)
+		}
+		if refs == nil {
+			return nil, nil
+		}
+		return []shared.ResourceRef{*refs}, nil
+	case []shared.ResourceRef:
+		if _, ok := target.Interface().([]string); !ok {
+			return nil, fmt.Errorf("spec field %s must be a []string to take a reference list", reference.FieldName)
+		}
+		return refs, nil
+	default:
+		return nil, fmt.Errorf("spec field %s has unsupported reference type %T", reference.RefFieldName, refs)
+	}
+}
+
+// referencedOCID reads the OCID of the OSOK resource ref names. The resource
+// is ready once it tracks an OCID and its current condition is Active.
+func referencedOCID(ctx context.Context, reference ReferenceField, ref shared.ResourceRef, namespace string) (string, string, error) {
+	if strings.TrimSpace(ref.Name) == "" {
+		return "", "", fmt.Errorf("%s reference for %s has an empty name", reference.Kind, reference.FieldName)
+	}
+	if ref.Namespace != "" {
+		namespace = ref.Namespace
+	}
+	reader := servicemanager.ReferenceReader(ctx)
+	if reader == nil {
+		return "", "", fmt.Errorf("no Kubernetes reader is available to resolve %s %s/%s", reference.Kind, namespace, ref.Name)
+	}
+
+	referenced := &unstructured.Unstructured{}
+	referenced.SetAPIVersion(reference.APIVersion)
+	referenced.SetKind(reference.Kind)
+	if err := reader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, referenced); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", fmt.Sprintf("Waiting for %s %s/%s to be created", reference.Kind, namespace, ref.Name), nil
+		}
+		return "", "", fmt.Errorf("get %s %s/%s: %w", reference.Kind, namespace, ref.Name, err)
+	}
+
+	ocid, _, _ := unstructured.NestedString(referenced.Object, "status", "status", "ocid")
+	if ocid == "" || !referencedResourceActive(referenced) {
+		return "", fmt.Sprintf("Waiting for %s %s/%s to become Active", reference.Kind, namespace, ref.Name), nil
+	}
+	return ocid, "", nil
+}
+
+func referencedResourceActive(object *unstructured.Unstructured) bool {
+	if reason, _, _ := unstructured.NestedString(object.Object, "status", "status", "reason"); reason != "" {
+		return reason == string(shared.Active)
+	}
+	conditions, _, _ := unstructured.NestedSlice(object.Object, "status", "status", "conditions")
+	if len(conditions) == 0 {
+		return false
+	}
+	trailing, ok := conditions[len(conditions)-1].(map[string]any)
+	return ok && trailing["type"] == string(shared.Active)
+}
+
+func specJSONName(spec reflect.Value, fieldName string) string {
+	field, ok := spec.Type().FieldByName(fieldName)
+	if !ok {
+		return fieldName
+	}
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" {
+		return fieldName
+	}
+	return name
+}
@@ -0,0 +1,182 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package generatedruntime
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	shared "github.com/oracle/oci-service-operator/pkg/shared"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type referenceTestResource struct {
+	Namespace string
+	Spec      referenceTestSpec
+	Status    referenceTestStatus
+}
+
+type referenceTestSpec struct {
+	VcnId            string               `json:"vcnId,omitempty"`
+	VcnRef           *shared.ResourceRef  `json:"vcnRef,omitempty"`
+	SecurityListIds  []string             `json:"securityListIds,omitempty"`
+	SecurityListRefs []shared.ResourceRef `json:"securityListRefs,omitempty"`
+}
+
+type referenceTestStatus struct {
+	OsokStatus shared.OSOKStatus `json:"status"`
+}
+
+var referenceTestFields = []ReferenceField{
+	{FieldName: "VcnId", RefFieldName: "VcnRef", APIVersion: "core.oracle.com/v1beta1", Kind: "Vcn"},
+	{FieldName: "SecurityListIds", RefFieldName: "SecurityListRefs", APIVersion: "core.oracle.com/v1beta1", Kind: "SecurityList"},
+}
+
+func TestReferenceResolutionFillsOCIDFieldsFromActiveResources(t *testing.T) {
+	t.Parallel()
+
+	reader := referenceTestReader{
+		"Vcn/apps/vcn":         referencedTestObject("Vcn", "ocid1.vcn.oc1..resolved", shared.Active),
+		"SecurityList/net/web": referencedTestObject("SecurityList", "ocid1.securitylist.oc1..web", shared.Active),
+	}
+	delegate := &referenceTestDelegate{}
+	resource := &referenceTestResource{
+		Namespace: "apps",
+		Spec: referenceTestSpec{
+			VcnRef:           &shared.ResourceRef{Name: "vcn"},
+			SecurityListRefs: []shared.ResourceRef{{Name: "web", Namespace: "net"}},
+		},
+	}
+
+	client := WithReferenceResolution[*referenceTestResource]("Subnet", loggerutil.OSOKLogger{}, referenceTestFields, delegate)
+	response, err := client.CreateOrUpdate(servicemanager.WithReferenceReader(context.Background(), reader), resource, ctrl.Request{})
+	requireCreateOrUpdateSuccess(t, response, err)
+	if delegate.calls != 1 {
+		t.Fatalf("delegate CreateOrUpdate calls = %d, want 1", delegate.calls)
+	}
+	if resource.Spec.VcnId != "ocid1.vcn.oc1..resolved" {
+		t.Fatalf("spec.vcnId = %q, want the referenced Vcn OCID", resource.Spec.VcnId)
+	}
+	if want := []string{"ocid1.securitylist.oc1..web"}; !reflect.DeepEqual(resource.Spec.SecurityListIds, want) {
+		t.Fatalf("spec.securityListIds = %v, want %v", resource.Spec.SecurityListIds, want)
+	}
+}
+
+func TestReferenceResolutionWaitsForDependency(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name   string
+		reader referenceTestReader
+		want   string
+	}{
+		{name: "missing", reader: referenceTestReader{}, want: "Waiting for Vcn apps/vcn to be created"},
+		{
+			name:   "provisioning",
+			reader: referenceTestReader{"Vcn/apps/vcn": referencedTestObject("Vcn", "ocid1.vcn.oc1..pending", shared.Provisioning)},
+			want:   "Waiting for Vcn apps/vcn to become Active",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			delegate := &referenceTestDelegate{}
+			resource := &referenceTestResource{Namespace: "apps", Spec: referenceTestSpec{VcnRef: &shared.ResourceRef{Name: "vcn"}}}
+
+			client := WithReferenceResolution[*referenceTestResource]("Subnet", loggerutil.OSOKLogger{}, referenceTestFields, delegate)
+			response, err := client.CreateOrUpdate(servicemanager.WithReferenceReader(context.Background(), tt.reader), resource, ctrl.Request{})
+			if err != nil {
+				t.Fatalf("CreateOrUpdate() error = %v", err)
+			}
+			if response.IsSuccessful || !response.ShouldRequeue || !response.DependencyNotReady {
+				t.Fatalf("CreateOrUpdate() response = %#v, want an unsuccessful requeue flagged DependencyNotReady", response)
+			}
+			if delegate.calls != 0 {
+				t.Fatalf("delegate CreateOrUpdate calls = %d, want 0 while the dependency is not ready", delegate.calls)
+			}
+			status := resource.Status.OsokStatus
+			if got := status.Conditions[len(status.Conditions)-1].Type; got != shared.DependencyNotReady {
+				t.Fatalf("trailing condition = %s, want %s", got, shared.DependencyNotReady)
+			}
+			if status.Message != tt.want {
+				t.Fatalf("status.message = %q, want %q", status.Message, tt.want)
+			}
+		})
+	}
+}
+
+func TestReferenceResolutionRejectsFieldAndReferenceTogether(t *testing.T) {
+	t.Parallel()
+
+	delegate := &referenceTestDelegate{}
+	resource := &referenceTestResource{
+		Namespace: "apps",
+		Spec:      referenceTestSpec{VcnId: "ocid1.vcn.oc1..raw", VcnRef: &shared.ResourceRef{Name: "vcn"}},
+	}
+
+	client := WithReferenceResolution[*referenceTestResource]("Subnet", loggerutil.OSOKLogger{}, referenceTestFields, delegate)
+	_, err := client.CreateOrUpdate(servicemanager.WithReferenceReader(context.Background(), referenceTestReader{}), resource, ctrl.Request{})
+	if err == nil || !strings.Contains(err.Error(), "set either spec.vcnId or spec.vcnRef") {
+		t.Fatalf("CreateOrUpdate() error = %v, want a vcnId/vcnRef conflict", err)
+	}
+	if delegate.calls != 0 {
+		t.Fatalf("delegate CreateOrUpdate calls = %d, want 0", delegate.calls)
+	}
+}
+
+func referencedTestObject(kind string, ocid string, condition shared.OSOKConditionType) *unstructured.Unstructured {
+	object := &unstructured.Unstructured{Object: map[string]any{
+		"status": map[string]any{
+			"status": map[string]any{
+				"ocid":       ocid,
+				"reason":     string(condition),
+				"conditions": []any{map[string]any{"type": string(condition)}},
+			},
+		},
+	}}
+	object.SetAPIVersion("core.oracle.com/v1beta1")
+	object.SetKind(kind)
+	return object
+}
+
+type referenceTestReader map[string]*unstructured.Unstructured
+
+func (r referenceTestReader) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	target := obj.(*unstructured.Unstructured)
+	kind := target.GetKind()
+	stored, ok := r[kind+"/"+key.Namespace+"/"+key.Name]
+	if !ok {
+		return apierrors.NewNotFound(schema.GroupResource{Group: "core.oracle.com", Resource: kind}, key.Name)
+	}
+	stored.DeepCopyInto(target)
+	return nil
+}
+
+func (r referenceTestReader) List(context.Context, client.ObjectList, ...client.ListOption) error {
+	return nil
+}
+
+type referenceTestDelegate struct {
+	calls int
+}
+
+func (d *referenceTestDelegate) CreateOrUpdate(context.Context, *referenceTestResource, ctrl.Request) (servicemanager.OSOKResponse, error) {
+	d.calls++
+	return servicemanager.OSOKResponse{IsSuccessful: true}, nil
+}
+
+func (d *referenceTestDelegate) Delete(context.Context, *referenceTestResource) (bool, error) {
+	return true, nil
+}
@@ -0,0 +1,320 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package generatedruntime
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	shared "github.com/oracle/oci-service-operator/pkg/shared"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// replacementRequiredError marks create-only drift: a spec change that OCI
+// only accepts on a new resource. It fails the reconcile unless the resource
+// opted into replacement through servicemanager.WithReplaceStrategy.
+type replacementRequiredError struct {
+	err error
+}
+
+func (e replacementRequiredError) Error() string {
+	return e.err.Error()
+}
+
+func (e replacementRequiredError) Unwrap() error {
+	return e.err
+}
+
+// replaceStrategy returns the strategy that resolves err, if err is
+// create-only drift and the resource opted into replacement. A drift resync
+// without auto-correct never replaces.
+func (c ServiceClient[T]) replaceStrategy(ctx context.Context, err error) (shared.OSOKReplaceStrategy, bool) {
+	var replacementErr replacementRequiredError
+	if !errors.As(err, &replacementErr) {
+		return "", false
+	}
+	strategy := servicemanager.ReplaceStrategy(ctx)
+	if strategy == "" {
+		return "", false
+	}
+	if resync, autoCorrect := servicemanager.DriftCheck(ctx); resync && !autoCorrect {
+		return "", false
+	}
+	return strategy, true
+}
+
+func (c ServiceClient[T]) replacementUnsupportedReason() string {
+	switch {
+	case c.config.Create == nil || c.config.Delete == nil:
+		return "it has no OCI create and delete operations"
+	case c.getReadOperation() == nil:
+		return "it has no OCI get operation to confirm each step"
+	case c.generatedWorkRequestPhaseEnabled(shared.OSOKAsyncPhaseCreate) ||
+		c.generatedWorkRequestPhaseEnabled(shared.OSOKAsyncPhaseDelete):
+		return "its create or delete is tracked by a work request"
+	default:
+		return ""
+	}
+}
+
+// startReplacement begins replacing previousID after create-only drift. Each
+// step is recorded in status.async.current.replacement and the next reconcile
+// resumes from it, so an interrupted replacement neither leaks nor deletes
+// the wrong OCI resource.
+func (c ServiceClient[T]) startReplacement(
+	ctx context.Context,
+	resource T,
+	namespace string,
+	identity any,
+	previousID string,
+	strategy shared.OSOKReplaceStrategy,
+	cause error,
+) (servicemanager.OSOKResponse, error) {
+	if reason := c.replacementUnsupportedReason(); reason != "" {
+		return c.failCreateOrUpdate(resource, fmt.Errorf("%w; %s cannot be replaced because %s", cause, c.config.Kind, reason))
+	}
+
+	if servicemanager.PlanFrom(ctx) != nil {
+		return c.planReplacement(ctx, resource, namespace, previousID, strategy)
+	}
+
+	replacement := shared.OSOKAsyncReplacement{Strategy: strategy, PreviousOcid: shared.OCID(previousID)}
+	if strategy == shared.ReplaceCreateBeforeDelete {
+		c.detachCurrentResource(resource)
+		return c.createReplacement(ctx, resource, namespace, identity, replacement)
+	}
+	return c.deleteReplacedResource(ctx, resource, namespace, identity, replacement)
+}
+
+func (c ServiceClient[T]) currentReplacement(resource T) *shared.OSOKAsyncReplacement {
+	status, err := osokStatus(resource)
+	if err != nil || status.Async.Current == nil || status.Async.Current.Replacement == nil {
+		return nil
+	}
+	return status.Async.Current.Replacement.DeepCopy()
+}
+
+func (c ServiceClient[T]) resumeReplacement(
+	ctx context.Context,
+	resource T,
+	namespace string,
+	identity any,
+	replacement shared.OSOKAsyncReplacement,
+) (servicemanager.OSOKResponse, error) {
+	if replacement.Step == shared.OSOKAsyncPhaseDelete {
+		return c.deleteReplacedResource(ctx, resource, namespace, identity, replacement)
+	}
+	if currentID := c.currentID(resource); currentID == "" || currentID == string(replacement.PreviousOcid) {
+		return c.createReplacement(ctx, resource, namespace, identity, replacement)
+	}
+	return c.observeReplacement(ctx, resource, namespace, identity, replacement)
+}
+
+func (c ServiceClient[T]) createReplacement(
+	ctx context.Context,
+	resource T,
+	namespace string,
+	identity any,
+	replacement shared.OSOKAsyncReplacement,
+) (servicemanager.OSOKResponse, error) {
+	replacement.Step = shared.OSOKAsyncPhaseCreate
+
+	options := c.requestBuildOptions(ctx, namespace)
+	options.RetryToken = replacementRetryToken(resource, replacement.PreviousOcid)
+	response, err := c.invoke(ctx, c.config.Create, resource, "", options)
+	if err != nil {
+		return c.failReplacement(resource, replacement, err)
+	}
+	c.seedOpeningRequestID(resource, response)
+	if _, err := c.applySuccessWithIdentity(resource, response, shared.Provisioning, identity); err != nil {
+		return c.failReplacement(resource, replacement, err)
+	}
+	return c.trackReplacement(resource, replacement,
+		fmt.Sprintf("Creating %s to replace %s", c.currentID(resource), replacement.PreviousOcid))
+}
+
+// observeReplacement waits for the replacement to become active before the
+// previous resource is deleted.
+func (c ServiceClient[T]) observeReplacement(
+	ctx context.Context,
+	resource T,
+	namespace string,
+	identity any,
+	replacement shared.OSOKAsyncReplacement,
+) (servicemanager.OSOKResponse, error) {
+	currentID := c.currentID(resource)
+	response, err := c.invoke(ctx, c.getReadOperation(), resource, currentID, requestBuildOptions{})
+	if err != nil {
+		if isReadNotFound(err) {
+			c.detachCurrentResource(resource)
+			return c.createReplacement(ctx, resource, namespace, identity, replacement)
+		}
+		return c.failReplacement(resource, replacement, err)
+	}
+
+	osokResponse, err := c.applySuccessWithIdentity(resource, response, shared.Active, identity)
+	if err != nil {
+		return c.failReplacement(resource, replacement, err)
+	}
+	status, err := osokStatus(resource)
+	if err != nil {
+		return osokResponse, err
+	}
+	if current := status.Async.Current; current != nil {
+		if current.NormalizedClass == shared.OSOKAsyncClassPending {
+			return c.trackReplacement(resource, replacement,
+				fmt.Sprintf("Waiting for %s to become active before %s is deleted", currentID, replacement.PreviousOcid))
+		}
+		current.Replacement = replacement.DeepCopy()
+		return osokResponse, nil
+	}
+
+	if replacement.Strategy == shared.ReplaceCreateBeforeDelete {
+		return c.deleteReplacedResource(ctx, resource, namespace, identity, replacement)
+	}
+	return osokResponse, nil
+}
+
+// deleteReplacedResource deletes the previous resource and waits until OCI
+// no longer reports it.
+func (c ServiceClient[T]) deleteReplacedResource(
+	ctx context.Context,
+	resource T,
+	namespace string,
+	identity any,
+	replacement shared.OSOKAsyncReplacement,
+) (servicemanager.OSOKResponse, error) {
+	replacement.Step = shared.OSOKAsyncPhaseDelete
+
+	gone, err := c.deletePreviousResource(ctx, resource, replacement)
+	if err != nil {
+		return c.failReplacement(resource, replacement, err)
+	}
+	if !gone {
+		return c.trackReplacement(resource, replacement,
+			fmt.Sprintf("Deleting %s, which is being replaced", replacement.PreviousOcid))
+	}
+
+	if replacement.Strategy == shared.ReplaceDeleteBeforeCreate {
+		c.detachCurrentResource(resource)
+		return c.createReplacement(ctx, resource, namespace, identity, replacement)
+	}
+	return c.observeExistingResource(ctx, resource, c.currentID(resource), nil, identity)
+}
+
+// deletePreviousResource issues the delete of the replaced resource unless it
+// is already deleting, and reports whether it is gone.
+func (c ServiceClient[T]) deletePreviousResource(ctx context.Context, resource T, replacement shared.OSOKAsyncReplacement) (bool, error) {
+	previousID := string(replacement.PreviousOcid)
+	response, err := c.invoke(ctx, c.getReadOperation(), resource, previousID, requestBuildOptions{})
+	if err != nil {
+		if isDeleteNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+
+	lifecycleState := strings.ToUpper(responseLifecycleState(response))
+	if semantics := c.config.Semantics; semantics != nil && lifecycleState != "" {
+		if containsString(semantics.Delete.TerminalStates, lifecycleState) {
+			return true, nil
+		}
+		if containsString(semantics.Delete.PendingStates, lifecycleState) {
+			return false, nil
+		}
+	}
+
+	if _, err := c.invoke(ctx, c.config.Delete, resource, previousID, requestBuildOptions{}); err != nil {
+		if isDeleteNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	return false, nil
+}
+
+// abandonReplacement deletes the replaced resource of a create-before-delete
+// replacement that is interrupted by deleting the custom resource. The
+// regular delete then removes the resource that status.ocid tracks.
+func (c ServiceClient[T]) abandonReplacement(ctx context.Context, resource T) error {
+	replacement := c.currentReplacement(resource)
+	if replacement == nil {
+		return nil
+	}
+	if replacement.Strategy == shared.ReplaceCreateBeforeDelete {
+		_, err := c.invoke(ctx, c.config.Delete, resource, string(replacement.PreviousOcid), requestBuildOptions{})
+		if errors.Is(err, errPlannedMutation) {
+			return nil
+		}
+		if err != nil && !isDeleteNotFound(err) {
+			return fmt.Errorf("delete %s, which was being replaced: %w", replacement.PreviousOcid, err)
+		}
+	}
+	if status, err := osokStatus(resource); err == nil && status.Async.Current != nil {
+		status.Async.Current.Replacement = nil
+	}
+	return nil
+}
+
+// detachCurrentResource stops tracking the resource that is being replaced, so
+// status.ocid can record the replacement.
+func (c ServiceClient[T]) detachCurrentResource(resource T) {
+	c.clearTrackedIdentity(resource)
+	if status, err := osokStatus(resource); err == nil {
+		status.Ocid = ""
+	}
+}
+
+func (c ServiceClient[T]) trackReplacement(resource T, replacement shared.OSOKAsyncReplacement, message string) (servicemanager.OSOKResponse, error) {
+	status, err := osokStatus(resource)
+	if err != nil {
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+	now := metav1.Now()
+	projection := c.applyAsyncOperation(status, resource, &shared.OSOKAsyncOperation{
+		Source:          shared.OSOKAsyncSourceLifecycle,
+		Phase:           shared.OSOKAsyncPhaseUpdate,
+		NormalizedClass: shared.OSOKAsyncClassPending,
+		Message:         message,
+		UpdatedAt:       &now,
+		Replacement:     &replacement,
+	})
+	return servicemanager.OSOKResponse{
+		IsSuccessful:    true,
+		ShouldRequeue:   projection.ShouldRequeue,
+		RequeueDuration: defaultRequeueDuration,
+	}, nil
+}
+
+// failReplacement fails the reconcile but keeps the replacement record, so the
+// next reconcile retries the failed step.
+func (c ServiceClient[T]) failReplacement(resource T, replacement shared.OSOKAsyncReplacement, err error) (servicemanager.OSOKResponse, error) {
+	if errors.Is(err, errPlannedMutation) {
+		return servicemanager.OSOKResponse{}, err
+	}
+	if status, statusErr := osokStatus(resource); statusErr == nil {
+		now := metav1.Now()
+		status.Async.Current = &shared.OSOKAsyncOperation{
+			Source:          shared.OSOKAsyncSourceLifecycle,
+			Phase:           shared.OSOKAsyncPhaseUpdate,
+			NormalizedClass: shared.OSOKAsyncClassPending,
+			UpdatedAt:       &now,
+			Replacement:     &replacement,
+		}
+	}
+	return c.failCreateOrUpdate(resource, err)
+}
+
+// replacementRetryToken keeps the create of a replacement idempotent across
+// reconciles without colliding with the retry token of the original create.
+func replacementRetryToken(resource any, previousID shared.OCID) string {
+	sum := sha256.Sum256([]byte(resourceRetryToken(resource) + "/replace/" + string(previousID)))
+	return fmt.Sprintf("%x", sum[:16])
+}
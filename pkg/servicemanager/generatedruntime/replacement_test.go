@@ -0,0 +1,275 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package generatedruntime
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/oracle/oci-service-operator/pkg/errorutil/errortest"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	shared "github.com/oracle/oci-service-operator/pkg/shared"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const (
+	replacementPreviousID = "ocid1.thing.oc1..existing"
+	replacementNewID      = "ocid1.thing.oc1..replacement"
+)
+
+type replacementFakeOCI struct {
+	things      map[string]fakeThing
+	creates     int
+	deletes     []string
+	retryTokens []string
+}
+
+func newReplacementFakeOCI() *replacementFakeOCI {
+	return &replacementFakeOCI{things: map[string]fakeThing{
+		replacementPreviousID: {Id: replacementPreviousID, CompartmentId: "ocid1.compartment.oc1..old", LifecycleState: "ACTIVE"},
+	}}
+}
+
+func (f *replacementFakeOCI) setState(id string, state string) {
+	thing := f.things[id]
+	thing.LifecycleState = state
+	f.things[id] = thing
+}
+
+func (f *replacementFakeOCI) client(t *testing.T) ServiceClient[*fakeResource] {
+	t.Helper()
+	return NewServiceClient[*fakeResource](Config[*fakeResource]{
+		Kind:    "Thing",
+		SDKName: "Thing",
+		Semantics: &Semantics{
+			Lifecycle: LifecycleSemantics{ProvisioningStates: []string{"CREATING"}, ActiveStates: []string{"ACTIVE"}},
+			Delete:    DeleteSemantics{Policy: "required", PendingStates: []string{"DELETING"}, TerminalStates: []string{"DELETED"}},
+			Mutation:  MutationSemantics{Mutable: []string{"displayName"}, ForceNew: []string{"compartmentId"}},
+		},
+		Create: &Operation{NewRequest: func() any { return &fakeCreateThingRequest{} }, Call: func(_ context.Context, request any) (any, error) {
+			createRequest := request.(*fakeCreateThingRequest)
+			f.creates++
+			retryToken := ""
+			if createRequest.OpcRetryToken != nil {
+				retryToken = *createRequest.OpcRetryToken
+			}
+			f.retryTokens = append(f.retryTokens, retryToken)
+			thing := fakeThing{Id: replacementNewID, CompartmentId: createRequest.CompartmentId, LifecycleState: "CREATING"}
+			f.things[thing.Id] = thing
+			return fakeCreateThingResponse{Thing: thing}, nil
+		}},
+		Get: &Operation{NewRequest: func() any { return &fakeGetThingRequest{} }, Call: func(_ context.Context, request any) (any, error) {
+			thing, ok := f.things[*request.(*fakeGetThingRequest).ThingId]
+			if !ok {
+				return nil, errortest.NewServiceError(404, "NotAuthorizedOrNotFound", "thing not found")
+			}
+			return fakeGetThingResponse{Thing: thing}, nil
+		}},
+		Update: &Operation{NewRequest: func() any { return &fakeUpdateThingRequest{} }, Call: func(_ context.Context, _ any) (any, error) {
+			t.Fatal("Update() should not be called for a force-new change")
+			return nil, nil
+		}},
+		Delete: &Operation{NewRequest: func() any { return &fakeDeleteThingRequest{} }, Call: func(_ context.Context, request any) (any, error) {
+			id := *request.(*fakeDeleteThingRequest).ThingId
+			if _, ok := f.things[id]; !ok {
+				return nil, errortest.NewServiceError(404, "NotAuthorizedOrNotFound", "thing not found")
+			}
+			f.deletes = append(f.deletes, id)
+			f.setState(id, "DELETING")
+			return fakeDeleteThingResponse{}, nil
+		}},
+	})
+}
+
+func newReplacementResource() *fakeResource {
+	return &fakeResource{
+		Name:      "thing",
+		Namespace: "default",
+		UID:       "uid-1",
+		Spec:      fakeSpec{CompartmentId: "ocid1.compartment.oc1..new"},
+		Status: fakeStatus{
+			OsokStatus:    shared.OSOKStatus{Ocid: replacementPreviousID},
+			Id:            replacementPreviousID,
+			CompartmentId: "ocid1.compartment.oc1..old",
+		},
+	}
+}
+
+func reconcileReplacement(t *testing.T, ctx context.Context, client ServiceClient[*fakeResource], resource *fakeResource) servicemanager.OSOKResponse {
+	t.Helper()
+	response, err := client.CreateOrUpdate(ctx, resource, ctrl.Request{})
+	requireCreateOrUpdateSuccess(t, response, err)
+	return response
+}
+
+func requireReplacementStep(t *testing.T, resource *fakeResource, step shared.OSOKAsyncPhase) {
+	t.Helper()
+	current := resource.Status.OsokStatus.Async.Current
+	if current == nil || current.Replacement == nil {
+		t.Fatalf("status.async.current.replacement = nil, want step %q", step)
+	}
+	if current.Replacement.Step != step {
+		t.Fatalf("replacement step = %q, want %q", current.Replacement.Step, step)
+	}
+	if current.Replacement.PreviousOcid != replacementPreviousID {
+		t.Fatalf("replacement previousOcid = %q, want %q", current.Replacement.PreviousOcid, replacementPreviousID)
+	}
+}
+
+func requireReplacementDone(t *testing.T, resource *fakeResource) {
+	t.Helper()
+	if current := resource.Status.OsokStatus.Async.Current; current != nil && current.Replacement != nil {
+		t.Fatalf("status.async.current.replacement = %#v, want nil after the replacement", current.Replacement)
+	}
+	if got := string(resource.Status.OsokStatus.Ocid); got != replacementNewID {
+		t.Fatalf("status.ocid = %q, want %q", got, replacementNewID)
+	}
+}
+
+func TestServiceClientReplacesCreateBeforeDelete(t *testing.T) {
+	t.Parallel()
+
+	oci := newReplacementFakeOCI()
+	client := oci.client(t)
+	resource := newReplacementResource()
+	ctx := servicemanager.WithReplaceStrategy(context.Background(), shared.ReplaceCreateBeforeDelete)
+
+	response := reconcileReplacement(t, ctx, client, resource)
+	requireRequeueState(t, response, true, "CreateOrUpdate() should requeue while the replacement is created")
+	requireReplacementStep(t, resource, shared.OSOKAsyncPhaseCreate)
+	if oci.creates != 1 || len(oci.deletes) != 0 {
+		t.Fatalf("creates = %d, deletes = %v, want the replacement created before any delete", oci.creates, oci.deletes)
+	}
+	if got := string(resource.Status.OsokStatus.Ocid); got != replacementNewID {
+		t.Fatalf("status.ocid = %q, want the replacement %q", got, replacementNewID)
+	}
+
+	reconcileReplacement(t, ctx, client, resource)
+	requireReplacementStep(t, resource, shared.OSOKAsyncPhaseCreate)
+	if len(oci.deletes) != 0 {
+		t.Fatalf("deletes = %v, want none while the replacement is CREATING", oci.deletes)
+	}
+
+	oci.setState(replacementNewID, "ACTIVE")
+	reconcileReplacement(t, ctx, client, resource)
+	requireReplacementStep(t, resource, shared.OSOKAsyncPhaseDelete)
+	if len(oci.deletes) != 1 || oci.deletes[0] != replacementPreviousID {
+		t.Fatalf("deletes = %v, want only %q", oci.deletes, replacementPreviousID)
+	}
+
+	reconcileReplacement(t, ctx, client, resource)
+	if len(oci.deletes) != 1 {
+		t.Fatalf("deletes = %v, want no second delete while the previous resource is DELETING", oci.deletes)
+	}
+
+	oci.setState(replacementPreviousID, "DELETED")
+	response = reconcileReplacement(t, ctx, client, resource)
+	requireRequeueState(t, response, false, "CreateOrUpdate() should settle once the replacement is done")
+	requireReplacementDone(t, resource)
+	if oci.creates != 1 {
+		t.Fatalf("creates = %d, want 1", oci.creates)
+	}
+}
+
+func TestServiceClientReplacesDeleteBeforeCreate(t *testing.T) {
+	t.Parallel()
+
+	oci := newReplacementFakeOCI()
+	client := oci.client(t)
+	resource := newReplacementResource()
+	ctx := servicemanager.WithReplaceStrategy(context.Background(), shared.ReplaceDeleteBeforeCreate)
+
+	reconcileReplacement(t, ctx, client, resource)
+	requireReplacementStep(t, resource, shared.OSOKAsyncPhaseDelete)
+	if oci.creates != 0 || len(oci.deletes) != 1 {
+		t.Fatalf("creates = %d, deletes = %v, want the previous resource deleted first", oci.creates, oci.deletes)
+	}
+
+	delete(oci.things, replacementPreviousID)
+	reconcileReplacement(t, ctx, client, resource)
+	requireReplacementStep(t, resource, shared.OSOKAsyncPhaseCreate)
+	if oci.creates != 1 {
+		t.Fatalf("creates = %d, want the replacement created once the previous resource is gone", oci.creates)
+	}
+
+	oci.setState(replacementNewID, "ACTIVE")
+	reconcileReplacement(t, ctx, client, resource)
+	requireReplacementDone(t, resource)
+}
+
+func TestServiceClientResumesInterruptedReplacementCreate(t *testing.T) {
+	t.Parallel()
+
+	oci := newReplacementFakeOCI()
+	client := oci.client(t)
+	ctx := servicemanager.WithReplaceStrategy(context.Background(), shared.ReplaceCreateBeforeDelete)
+
+	// The first create reached OCI but the status that recorded it was lost.
+	resource := newReplacementResource()
+	resource.Status.OsokStatus.Ocid = ""
+	resource.Status.OsokStatus.Async.Current = &shared.OSOKAsyncOperation{
+		Source:          shared.OSOKAsyncSourceLifecycle,
+		Phase:           shared.OSOKAsyncPhaseUpdate,
+		NormalizedClass: shared.OSOKAsyncClassPending,
+		Replacement: &shared.OSOKAsyncReplacement{
+			Strategy:     shared.ReplaceCreateBeforeDelete,
+			PreviousOcid: replacementPreviousID,
+			Step:         shared.OSOKAsyncPhaseCreate,
+		},
+	}
+	reconcileReplacement(t, ctx, client, resource)
+	reconcileReplacement(t, ctx, client, newReplacementResourceFrom(resource))
+
+	if len(oci.retryTokens) != 2 || oci.retryTokens[0] == "" || oci.retryTokens[0] != oci.retryTokens[1] {
+		t.Fatalf("retry tokens = %v, want one stable token so OCI deduplicates the retried create", oci.retryTokens)
+	}
+	if oci.retryTokens[0] == resourceRetryToken(resource) {
+		t.Fatal("replacement create reused the retry token of the original create")
+	}
+	if len(oci.deletes) != 0 {
+		t.Fatalf("deletes = %v, want the previous resource kept until the replacement is active", oci.deletes)
+	}
+}
+
+func newReplacementResourceFrom(resource *fakeResource) *fakeResource {
+	retried := newReplacementResource()
+	retried.Status.OsokStatus.Ocid = ""
+	retried.Status.OsokStatus.Async.Current = resource.Status.OsokStatus.Async.Current.DeepCopy()
+	return retried
+}
+
+func TestServiceClientDoesNotReplaceWithoutStrategy(t *testing.T) {
+	t.Parallel()
+
+	oci := newReplacementFakeOCI()
+	client := oci.client(t)
+	resource := newReplacementResource()
+
+	if _, err := client.CreateOrUpdate(context.Background(), resource, ctrl.Request{}); err == nil || !strings.Contains(err.Error(), "require replacement when compartmentId changes") {
+		t.Fatalf("CreateOrUpdate() error = %v, want force-new replacement failure", err)
+	}
+	if oci.creates != 0 || len(oci.deletes) != 0 {
+		t.Fatalf("creates = %d, deletes = %v, want no OCI mutation without a replace strategy", oci.creates, oci.deletes)
+	}
+}
+
+func TestServiceClientDeleteRemovesResourceBeingReplaced(t *testing.T) {
+	t.Parallel()
+
+	oci := newReplacementFakeOCI()
+	client := oci.client(t)
+	resource := newReplacementResource()
+	ctx := servicemanager.WithReplaceStrategy(context.Background(), shared.ReplaceCreateBeforeDelete)
+
+	reconcileReplacement(t, ctx, client, resource)
+	if _, err := client.Delete(context.Background(), resource); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if len(oci.deletes) != 2 || oci.deletes[0] != replacementPreviousID || oci.deletes[1] != replacementNewID {
+		t.Fatalf("deletes = %v, want the previous resource and then the replacement", oci.deletes)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 	"unicode"
 
 	apmconfigsdk "github.com/oracle/oci-go-sdk/v65/apmconfig"
@@ -19,6 +20,10 @@ import (
 	databasemigrationsdk "github.com/oracle/oci-go-sdk/v65/databasemigration"
 	databasetoolssdk "github.com/oracle/oci-go-sdk/v65/databasetools"
 	"github.com/oracle/oci-service-operator/pkg/credhelper"
+	"github.com/oracle/oci-service-operator/pkg/metrics"
+	"github.com/oracle/oci-service-operator/pkg/ratelimit"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	"github.com/oracle/oci-service-operator/pkg/tracing"
 )
 
 func (c ServiceClient[T]) requestBuildOptions(ctx context.Context, namespace string) requestBuildOptions {
@@ -37,6 +42,10 @@ func (c ServiceClient[T]) invoke(ctx context.Context, op *Operation, resource T,
 	return c.invokeWithValues(ctx, op, resource, values, preferredID, options)
 }
 
+func (c ServiceClient[T]) isMutatingOperation(op *Operation) bool {
+	return op == c.config.Create || op == c.config.Update || op == c.config.Delete
+}
+
 func (c ServiceClient[T]) invokeWithValues(ctx context.Context, op *Operation, resource T, values map[string]any, preferredID string, options requestBuildOptions) (any, error) {
 	if op == nil {
 		return nil, fmt.Errorf("%s generated runtime does not define this OCI operation", c.config.Kind)
@@ -44,6 +53,9 @@ func (c ServiceClient[T]) invokeWithValues(ctx context.Context, op *Operation, r
 	if op.NewRequest == nil || op.Call == nil {
 		return nil, fmt.Errorf("%s generated runtime OCI operation is incomplete", c.config.Kind)
 	}
+	if servicemanager.IsObserveOnly(ctx) && c.isMutatingOperation(op) {
+		return nil, fmt.Errorf("%s generated runtime refused an OCI mutation while observing read-only", c.config.Kind)
+	}
 
 	request := op.NewRequest()
 	if request == nil {
@@ -56,8 +68,19 @@ func (c ServiceClient[T]) invokeWithValues(ctx context.Context, op *Operation, r
 	if err := buildRequest(request, resource, values, preferredID, op.Fields, c.idFieldAliases(), options, bodyOverride, hasBodyOverride); err != nil {
 		return nil, fmt.Errorf("build %s OCI request: %w", c.config.Kind, err)
 	}
+	if plan := servicemanager.PlanFrom(ctx); plan != nil && c.isMutatingOperation(op) {
+		return nil, c.planOperation(plan, c.operationPhase(op), resource, preferredID, request, options.CurrentResponse)
+	}
 
-	response, err := op.Call(ctx, request)
+	service, operation := metrics.OCIOperation(request)
+	response, err := ratelimit.Call(ctx, service, func(ctx context.Context) (any, error) {
+		callCtx, span := tracing.StartOCICall(ctx, service, operation)
+		start := time.Now()
+		response, err := op.Call(callCtx, request)
+		metrics.ObserveOCICall(request, response, err, time.Since(start))
+		tracing.EndOCICall(span, response, err)
+		return response, err
+	})
 	if err != nil {
 		return nil, normalizeOCIError(err)
 	}
@@ -99,6 +122,9 @@ type requestBuildOptions struct {
 	CredentialClient credhelper.CredentialClient
 	Namespace        string
 	CurrentResponse  any
+	// RetryToken replaces the resource-derived opc-retry-token, so that a
+	// second create for the same resource is not deduplicated by OCI.
+	RetryToken string
 }
 
 func buildRequest(
@@ -138,14 +164,14 @@ func buildRequest(
 		if err := buildExplicitRequest(requestStruct, values, preferredID, fields, resolvedSpec); err != nil {
 			return err
 		}
-		assignDeterministicRetryToken(requestStruct, resource)
+		assignDeterministicRetryToken(requestStruct, resource, options.RetryToken)
 		return nil
 	}
 
 	if err := buildHeuristicRequest(requestStruct, requestStruct.Type(), values, preferredID, idAliases, resolvedSpec); err != nil {
 		return err
 	}
-	assignDeterministicRetryToken(requestStruct, resource)
+	assignDeterministicRetryToken(requestStruct, resource, options.RetryToken)
 	return nil
 }
 
@@ -882,7 +908,7 @@ func jsonFieldString(payload []byte, field string) (string, error) {
 	return value, nil
 }
 
-func assignDeterministicRetryToken(requestStruct reflect.Value, resource any) {
+func assignDeterministicRetryToken(requestStruct reflect.Value, resource any, token string) {
 	field, ok := fieldValue(requestStruct, "OpcRetryToken")
 	if !ok || !field.IsValid() || !field.CanSet() {
 		return
@@ -901,7 +927,9 @@ func assignDeterministicRetryToken(requestStruct reflect.Value, resource any) {
 		return
 	}
 
-	token := resourceRetryToken(resource)
+	if token == "" {
+		token = resourceRetryToken(resource)
+	}
 	if token == "" {
 		return
 	}
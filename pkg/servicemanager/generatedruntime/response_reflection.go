@@ -6,6 +6,7 @@
 package generatedruntime
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
+	"github.com/oracle/oci-service-operator/pkg/ratelimit"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
 )
 
@@ -94,13 +96,55 @@ func mergeResponseIntoStatus(resource any, response any) error {
 	if err != nil {
 		return fmt.Errorf("marshal OCI response body: %w", err)
 	}
+	payload, err = escapeStatusEnvelopeKeys(payload)
+	if err != nil {
+		return fmt.Errorf("marshal OCI response body: %w", err)
+	}
 	if err := json.Unmarshal(payload, statusValue.Addr().Interface()); err != nil {
 		return fmt.Errorf("project OCI response body into status: %w", err)
 	}
 	return nil
 }
 
+// statusEnvelopeKeys maps OCI response keys that collide with the OSOK status
+// envelope to the sdk* JSON names the generator renders those fields under.
+var statusEnvelopeKeys = map[string]string{
+	"status":             "sdkStatus",
+	"conditions":         "sdkConditions",
+	"observedGeneration": "sdkObservedGeneration",
+}
+
+func escapeStatusEnvelopeKeys(payload []byte) ([]byte, error) {
+	var values map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	if err := decoder.Decode(&values); err != nil || values == nil {
+		return payload, nil
+	}
+
+	escaped := false
+	for key, replacement := range statusEnvelopeKeys {
+		value, ok := values[key]
+		if !ok {
+			continue
+		}
+		delete(values, key)
+		values[replacement] = value
+		escaped = true
+	}
+	if !escaped {
+		return payload, nil
+	}
+	return json.Marshal(values)
+}
+
 func normalizeOCIError(err error) error {
+	// The limiter already paused the service for the 429's Retry-After, so
+	// the OCI error replaces its wrapper like any other.
+	var throttled *ratelimit.ThrottledError
+	if errors.As(err, &throttled) && throttled.Err != nil {
+		err = throttled.Err
+	}
 	var serviceErr common.ServiceError
 	if !errors.As(err, &serviceErr) {
 		return err
@@ -0,0 +1,71 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package generatedruntime
+
+import (
+	shared "github.com/oracle/oci-service-operator/pkg/shared"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"testing"
+)
+
+type fakeEnvelopeThing struct {
+	Id                 string   `json:"id,omitempty"`
+	Status             string   `json:"status,omitempty"`
+	Conditions         []string `json:"conditions,omitempty"`
+	ObservedGeneration int64    `json:"observedGeneration,omitempty"`
+}
+
+type fakeEnvelopeGetResponse struct {
+	Thing fakeEnvelopeThing `presentIn:"body"`
+}
+
+type fakeEnvelopeResource struct {
+	Status fakeEnvelopeStatus `json:"status,omitempty"`
+}
+
+type fakeEnvelopeStatus struct {
+	OsokStatus            shared.OSOKStatus  `json:"status"`
+	Conditions            []metav1.Condition `json:"conditions,omitempty"`
+	ObservedGeneration    int64              `json:"observedGeneration,omitempty"`
+	Id                    string             `json:"id,omitempty"`
+	SdkStatus             string             `json:"sdkStatus,omitempty"`
+	SdkConditions         []string           `json:"sdkConditions,omitempty"`
+	SdkObservedGeneration int64              `json:"sdkObservedGeneration,omitempty"`
+}
+
+func TestMergeResponseIntoStatusEscapesStatusEnvelopeKeys(t *testing.T) {
+	t.Parallel()
+	resource := &fakeEnvelopeResource{Status: fakeEnvelopeStatus{
+		OsokStatus:         shared.OSOKStatus{Ocid: "ocid1.thing.oc1..existing"},
+		Conditions:         []metav1.Condition{{Type: shared.ReadyConditionType, Status: metav1.ConditionTrue}},
+		ObservedGeneration: 3,
+	}}
+	response := fakeEnvelopeGetResponse{Thing: fakeEnvelopeThing{
+		Id:                 "ocid1.thing.oc1..existing",
+		Status:             "ENABLED",
+		Conditions:         []string{"healthy"},
+		ObservedGeneration: 9007199254740993,
+	}}
+
+	if err := mergeResponseIntoStatus(resource, response); err != nil {
+		t.Fatalf("mergeResponseIntoStatus() error = %v", err)
+	}
+	if resource.Status.OsokStatus.Ocid != "ocid1.thing.oc1..existing" {
+		t.Fatalf("status.status.ocid = %q, want OSOK status preserved", resource.Status.OsokStatus.Ocid)
+	}
+	if len(resource.Status.Conditions) != 1 || resource.Status.ObservedGeneration != 3 {
+		t.Fatalf("status conditions/observedGeneration = %#v/%d, want reconciler-owned fields preserved", resource.Status.Conditions, resource.Status.ObservedGeneration)
+	}
+	if resource.Status.SdkStatus != "ENABLED" {
+		t.Fatalf("status.sdkStatus = %q, want ENABLED", resource.Status.SdkStatus)
+	}
+	if len(resource.Status.SdkConditions) != 1 || resource.Status.SdkConditions[0] != "healthy" {
+		t.Fatalf("status.sdkConditions = %#v, want [healthy]", resource.Status.SdkConditions)
+	}
+	if resource.Status.SdkObservedGeneration != 9007199254740993 {
+		t.Fatalf("status.sdkObservedGeneration = %d, want exact int64 value", resource.Status.SdkObservedGeneration)
+	}
+}
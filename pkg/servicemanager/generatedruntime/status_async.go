@@ -6,6 +6,7 @@
 package generatedruntime
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"strings"
@@ -95,6 +96,9 @@ func resolveRequeueDuration(response any, shouldRequeue bool, preferred time.Dur
 }
 
 func (c ServiceClient[T]) markFailure(resource T, err error) error {
+	if errors.Is(err, errPlannedMutation) {
+		return err
+	}
 	status, statusErr := osokStatus(resource)
 	if statusErr != nil {
 		return err
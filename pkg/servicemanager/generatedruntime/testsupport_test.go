@@ -13,11 +13,12 @@ import (
 )
 
 type fakeResource struct {
-	Name      string     `json:"-"`
-	Namespace string     `json:"-"`
-	UID       string     `json:"-"`
-	Spec      fakeSpec   `json:"spec,omitempty"`
-	Status    fakeStatus `json:"status,omitempty"`
+	Name        string            `json:"-"`
+	Namespace   string            `json:"-"`
+	UID         string            `json:"-"`
+	Annotations map[string]string `json:"-"`
+	Spec        fakeSpec          `json:"spec,omitempty"`
+	Status      fakeStatus        `json:"status,omitempty"`
 }
 
 type fakeSpec struct {
@@ -11,9 +11,13 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
+	"github.com/oracle/oci-service-operator/pkg/metrics"
+	"github.com/oracle/oci-service-operator/pkg/ratelimit"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
+	"github.com/oracle/oci-service-operator/pkg/tracing"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -128,7 +132,16 @@ func (c ServiceClient[T]) fetchGeneratedWorkRequest(ctx context.Context, workReq
 	if c.config.Async.GetWorkRequest == nil {
 		return nil, fmt.Errorf("%s workrequest async hooks require GetWorkRequest", c.config.Kind)
 	}
-	workRequest, err := c.config.Async.GetWorkRequest(ctx, strings.TrimSpace(workRequestID))
+	workRequestID = strings.TrimSpace(workRequestID)
+	service := c.workRequestService()
+	workRequest, err := ratelimit.Call(ctx, service, func(ctx context.Context) (any, error) {
+		pollCtx, span := tracing.StartWorkRequestPoll(ctx, service, workRequestID)
+		start := time.Now()
+		workRequest, err := c.config.Async.GetWorkRequest(pollCtx, workRequestID)
+		c.observeWorkRequestCall(workRequest, err, time.Since(start))
+		tracing.EndOCICall(span, workRequest, err)
+		return workRequest, err
+	})
 	if err != nil {
 		return nil, normalizeOCIError(err)
 	}
@@ -138,6 +151,22 @@ func (c ServiceClient[T]) fetchGeneratedWorkRequest(ctx context.Context, workReq
 	return workRequest, nil
 }
 
+// workRequestService names the OCI service of the kind's work requests after
+// its create operation, since the GetWorkRequest hook hides the SDK request.
+func (c ServiceClient[T]) workRequestService() string {
+	if c.config.Create == nil || c.config.Create.NewRequest == nil {
+		return ""
+	}
+	service, _ := metrics.OCIOperation(c.config.Create.NewRequest())
+	return service
+}
+
+func (c ServiceClient[T]) observeWorkRequestCall(workRequest any, err error, duration time.Duration) {
+	if service := c.workRequestService(); service != "" {
+		metrics.ObserveOCIOperation(service, "GetWorkRequest", workRequest, err, duration)
+	}
+}
+
 func (c ServiceClient[T]) buildGeneratedWorkRequestAsyncOperation(resource T, workRequest any, explicitPhase shared.OSOKAsyncPhase) (*shared.OSOKAsyncOperation, error) {
 	status, err := osokStatus(resource)
 	if err != nil {
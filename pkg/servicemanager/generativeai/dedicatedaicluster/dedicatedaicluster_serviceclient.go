@@ -13,6 +13,7 @@ import (
 
 	generativeaisdk "github.com/oracle/oci-go-sdk/v65/generativeai"
 	generativeaiv1beta1 "github.com/oracle/oci-service-operator/api/generativeai/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultDedicatedAiClusterServiceClient struct {
 var _ DedicatedAiClusterServiceClient = defaultDedicatedAiClusterServiceClient{}
 
 var newDedicatedAiClusterServiceClient = func(manager *DedicatedAiClusterServiceManager) DedicatedAiClusterServiceClient {
-	sdkClient, err := generativeaisdk.NewGenerativeAiClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, generativeaisdk.NewGenerativeAiClientWithConfigurationProvider)
 	hooks := newDedicatedAiClusterRuntimeHooks(manager, sdkClient)
 	config := buildDedicatedAiClusterGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
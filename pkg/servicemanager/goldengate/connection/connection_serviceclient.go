@@ -13,6 +13,7 @@ import (
 
 	goldengatesdk "github.com/oracle/oci-go-sdk/v65/goldengate"
 	goldengatev1beta1 "github.com/oracle/oci-service-operator/api/goldengate/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultConnectionServiceClient struct {
 var _ ConnectionServiceClient = defaultConnectionServiceClient{}
 
 var newConnectionServiceClient = func(manager *ConnectionServiceManager) ConnectionServiceClient {
-	sdkClient, err := goldengatesdk.NewGoldenGateClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, goldengatesdk.NewGoldenGateClientWithConfigurationProvider)
 	hooks := newConnectionRuntimeHooks(manager, sdkClient)
 	config := buildConnectionGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
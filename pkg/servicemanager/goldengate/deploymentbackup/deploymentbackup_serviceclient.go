@@ -13,6 +13,7 @@ import (
 
 	goldengatesdk "github.com/oracle/oci-go-sdk/v65/goldengate"
 	goldengatev1beta1 "github.com/oracle/oci-service-operator/api/goldengate/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultDeploymentBackupServiceClient struct {
 var _ DeploymentBackupServiceClient = defaultDeploymentBackupServiceClient{}
 
 var newDeploymentBackupServiceClient = func(manager *DeploymentBackupServiceManager) DeploymentBackupServiceClient {
-	sdkClient, err := goldengatesdk.NewGoldenGateClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, goldengatesdk.NewGoldenGateClientWithConfigurationProvider)
 	hooks := newDeploymentBackupRuntimeHooks(manager, sdkClient)
 	config := buildDeploymentBackupGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
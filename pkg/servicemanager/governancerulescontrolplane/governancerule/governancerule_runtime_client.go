@@ -18,6 +18,7 @@ import (
 	governancerulescontrolplanev1beta1 "github.com/oracle/oci-service-operator/api/governancerulescontrolplane/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -87,7 +88,7 @@ func newGovernanceRuleWorkRequestClient(manager *GovernanceRuleServiceManager) (
 	if manager == nil {
 		return nil, fmt.Errorf("GovernanceRule service manager is nil")
 	}
-	return governancerulescontrolplanesdk.NewWorkRequestClientWithConfigurationProvider(manager.Provider)
+	return ociclient.New(manager.Provider, governancerulescontrolplanesdk.NewWorkRequestClientWithConfigurationProvider)
 }
 
 func applyGovernanceRuleRuntimeHooks(
@@ -13,6 +13,7 @@ import (
 
 	governancerulescontrolplanesdk "github.com/oracle/oci-go-sdk/v65/governancerulescontrolplane"
 	governancerulescontrolplanev1beta1 "github.com/oracle/oci-service-operator/api/governancerulescontrolplane/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultGovernanceRuleServiceClient struct {
 var _ GovernanceRuleServiceClient = defaultGovernanceRuleServiceClient{}
 
 var newGovernanceRuleServiceClient = func(manager *GovernanceRuleServiceManager) GovernanceRuleServiceClient {
-	sdkClient, err := governancerulescontrolplanesdk.NewGovernanceRuleClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, governancerulescontrolplanesdk.NewGovernanceRuleClientWithConfigurationProvider)
 	hooks := newGovernanceRuleRuntimeHooks(manager, sdkClient)
 	config := buildGovernanceRuleGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
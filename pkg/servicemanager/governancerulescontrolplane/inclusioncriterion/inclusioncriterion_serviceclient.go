@@ -13,6 +13,7 @@ import (
 
 	governancerulescontrolplanesdk "github.com/oracle/oci-go-sdk/v65/governancerulescontrolplane"
 	governancerulescontrolplanev1beta1 "github.com/oracle/oci-service-operator/api/governancerulescontrolplane/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultInclusionCriterionServiceClient struct {
 var _ InclusionCriterionServiceClient = defaultInclusionCriterionServiceClient{}
 
 var newInclusionCriterionServiceClient = func(manager *InclusionCriterionServiceManager) InclusionCriterionServiceClient {
-	sdkClient, err := governancerulescontrolplanesdk.NewGovernanceRuleClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, governancerulescontrolplanesdk.NewGovernanceRuleClientWithConfigurationProvider)
 	hooks := newInclusionCriterionRuntimeHooks(manager, sdkClient)
 	config := buildInclusionCriterionGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	healthcheckssdk "github.com/oracle/oci-go-sdk/v65/healthchecks"
 	healthchecksv1beta1 "github.com/oracle/oci-service-operator/api/healthchecks/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultHttpMonitorServiceClient struct {
 var _ HttpMonitorServiceClient = defaultHttpMonitorServiceClient{}
 
 var newHttpMonitorServiceClient = func(manager *HttpMonitorServiceManager) HttpMonitorServiceClient {
-	sdkClient, err := healthcheckssdk.NewHealthChecksClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, healthcheckssdk.NewHealthChecksClientWithConfigurationProvider)
 	hooks := newHttpMonitorRuntimeHooks(manager, sdkClient)
 	config := buildHttpMonitorGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -15,6 +15,7 @@ import (
 	identitysdk "github.com/oracle/oci-go-sdk/v65/identity"
 	identityv1beta1 "github.com/oracle/oci-service-operator/api/identity/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -47,7 +48,7 @@ func appendCompartmentOrphanDeleteRuntimeWrapper(manager *CompartmentServiceMana
 func newCompartmentOrphanDeleteClient(manager *CompartmentServiceManager, delegate CompartmentServiceClient) CompartmentServiceClient {
 	client := compartmentOrphanDeleteClient{delegate: delegate}
 	client.deleteCompartment = func(ctx context.Context, compartmentID shared.OCID) error {
-		sdkClient, err := identitysdk.NewIdentityClientWithConfigurationProvider(manager.Provider)
+		sdkClient, err := ociclient.New(manager.Provider, identitysdk.NewIdentityClientWithConfigurationProvider)
 		if err != nil {
 			return fmt.Errorf("initialize Compartment delete OCI client: %w", err)
 		}
@@ -58,7 +59,7 @@ func newCompartmentOrphanDeleteClient(manager *CompartmentServiceManager, delega
 		return err
 	}
 	client.loadCompartment = func(ctx context.Context, compartmentID shared.OCID) (*identitysdk.Compartment, error) {
-		sdkClient, err := identitysdk.NewIdentityClientWithConfigurationProvider(manager.Provider)
+		sdkClient, err := ociclient.New(manager.Provider, identitysdk.NewIdentityClientWithConfigurationProvider)
 		if err != nil {
 			return nil, fmt.Errorf("initialize Compartment get OCI client: %w", err)
 		}
@@ -13,6 +13,7 @@ import (
 
 	identitysdk "github.com/oracle/oci-go-sdk/v65/identity"
 	identityv1beta1 "github.com/oracle/oci-service-operator/api/identity/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultCompartmentServiceClient struct {
 var _ CompartmentServiceClient = defaultCompartmentServiceClient{}
 
 var newCompartmentServiceClient = func(manager *CompartmentServiceManager) CompartmentServiceClient {
-	sdkClient, err := identitysdk.NewIdentityClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, identitysdk.NewIdentityClientWithConfigurationProvider)
 	hooks := newCompartmentRuntimeHooks(manager, sdkClient)
 	config := buildCompartmentGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -19,6 +19,7 @@ import (
 	integrationv1beta1 "github.com/oracle/oci-service-operator/api/integration/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -210,7 +211,7 @@ func wrapIntegrationInstanceNetworkEndpointAction(manager *IntegrationInstanceSe
 		return
 	}
 
-	sdkClient, initErr := integrationsdk.NewIntegrationInstanceClientWithConfigurationProvider(manager.Provider)
+	sdkClient, initErr := ociclient.New(manager.Provider, integrationsdk.NewIntegrationInstanceClientWithConfigurationProvider)
 	changeNetworkEndpoint := func(
 		ctx context.Context,
 		request integrationsdk.ChangeIntegrationInstanceNetworkEndpointRequest,
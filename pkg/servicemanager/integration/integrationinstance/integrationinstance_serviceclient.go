@@ -13,6 +13,7 @@ import (
 
 	integrationsdk "github.com/oracle/oci-go-sdk/v65/integration"
 	integrationv1beta1 "github.com/oracle/oci-service-operator/api/integration/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultIntegrationInstanceServiceClient struct {
 var _ IntegrationInstanceServiceClient = defaultIntegrationInstanceServiceClient{}
 
 var newIntegrationInstanceServiceClient = func(manager *IntegrationInstanceServiceManager) IntegrationInstanceServiceClient {
-	sdkClient, err := integrationsdk.NewIntegrationInstanceClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, integrationsdk.NewIntegrationInstanceClientWithConfigurationProvider)
 	hooks := newIntegrationInstanceRuntimeHooks(manager, sdkClient)
 	config := buildIntegrationInstanceGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
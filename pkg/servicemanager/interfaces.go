@@ -19,11 +19,20 @@ type OSOKResponse struct {
 	IsSuccessful    bool
 	ShouldRequeue   bool
 	RequeueDuration time.Duration
+	// DriftDetected reports that a drift resync found the OCI resource out of
+	// sync with spec. See WithDriftCheck.
+	DriftDetected bool
+	// DependencyNotReady reports that the resource is waiting on a referenced
+	// OSOK resource and was not sent to OCI.
+	DependencyNotReady bool
 }
 
 type OSOKDeleteResult struct {
 	Deleted         bool
 	RequeueDuration time.Duration
+	// Orphaned reports that the finalizer may be removed even though the OCI
+	// resource was intentionally left in place.
+	Orphaned bool
 }
 
 type OSOKServiceManager interface {
@@ -37,3 +46,11 @@ type OSOKServiceManager interface {
 type OSOKDeleteResultProvider interface {
 	DeleteWithResult(ctx context.Context, obj runtime.Object) (OSOKDeleteResult, error)
 }
+
+// OSOKOrphanCleaner is implemented by service managers that write Kubernetes
+// artifacts, such as endpoint or credential secrets, for their resource. The
+// artifacts carry no owner references, so they are removed through this hook
+// when a deletion policy orphans the OCI resource instead of deleting it.
+type OSOKOrphanCleaner interface {
+	CleanupOrphaned(ctx context.Context, obj runtime.Object) error
+}
@@ -13,6 +13,7 @@ import (
 
 	iotsdk "github.com/oracle/oci-go-sdk/v65/iot"
 	iotv1beta1 "github.com/oracle/oci-service-operator/api/iot/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultDigitalTwinInstanceServiceClient struct {
 var _ DigitalTwinInstanceServiceClient = defaultDigitalTwinInstanceServiceClient{}
 
 var newDigitalTwinInstanceServiceClient = func(manager *DigitalTwinInstanceServiceManager) DigitalTwinInstanceServiceClient {
-	sdkClient, err := iotsdk.NewIotClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, iotsdk.NewIotClientWithConfigurationProvider)
 	hooks := newDigitalTwinInstanceRuntimeHooks(manager, sdkClient)
 	config := buildDigitalTwinInstanceGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
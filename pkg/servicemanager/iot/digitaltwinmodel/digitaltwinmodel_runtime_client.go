@@ -17,6 +17,7 @@ import (
 	iotv1beta1 "github.com/oracle/oci-service-operator/api/iot/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	"github.com/oracle/oci-service-operator/pkg/shared"
@@ -89,7 +90,7 @@ func digitalTwinModelSpecGetterFromManager(manager *DigitalTwinModelServiceManag
 	if manager == nil || manager.Provider == nil {
 		return nil
 	}
-	sdkClient, err := iotsdk.NewIotClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, iotsdk.NewIotClientWithConfigurationProvider)
 	if err != nil {
 		return func(context.Context, iotsdk.GetDigitalTwinModelSpecRequest) (iotsdk.GetDigitalTwinModelSpecResponse, error) {
 			return iotsdk.GetDigitalTwinModelSpecResponse{}, fmt.Errorf("initialize DigitalTwinModel OCI client for spec read: %w", err)
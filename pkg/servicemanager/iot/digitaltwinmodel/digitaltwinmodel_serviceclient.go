@@ -13,6 +13,7 @@ import (
 
 	iotsdk "github.com/oracle/oci-go-sdk/v65/iot"
 	iotv1beta1 "github.com/oracle/oci-service-operator/api/iot/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultDigitalTwinModelServiceClient struct {
 var _ DigitalTwinModelServiceClient = defaultDigitalTwinModelServiceClient{}
 
 var newDigitalTwinModelServiceClient = func(manager *DigitalTwinModelServiceManager) DigitalTwinModelServiceClient {
-	sdkClient, err := iotsdk.NewIotClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, iotsdk.NewIotClientWithConfigurationProvider)
 	hooks := newDigitalTwinModelRuntimeHooks(manager, sdkClient)
 	config := buildDigitalTwinModelGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -16,6 +16,7 @@ import (
 	iotv1beta1 "github.com/oracle/oci-service-operator/api/iot/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	"github.com/oracle/oci-service-operator/pkg/shared"
@@ -87,7 +88,7 @@ func newIotDomainOCIClient(manager *IotDomainServiceManager) (iotDomainOCIClient
 	if manager == nil {
 		return nil, fmt.Errorf("IotDomain service manager is nil")
 	}
-	client, err := iotsdk.NewIotClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, iotsdk.NewIotClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
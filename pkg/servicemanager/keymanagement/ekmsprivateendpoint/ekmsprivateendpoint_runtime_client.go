@@ -17,6 +17,7 @@ import (
 	keymanagementv1beta1 "github.com/oracle/oci-service-operator/api/keymanagement/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -84,7 +85,7 @@ func newEkmsPrivateEndpointRuntimeClient(manager *EkmsPrivateEndpointServiceMana
 	if manager.Provider == nil {
 		return runtimeClient
 	}
-	sdkClient, err := keymanagementsdk.NewEkmClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, keymanagementsdk.NewEkmClientWithConfigurationProvider)
 	if err != nil {
 		runtimeClient.initErr = fmt.Errorf("initialize EkmsPrivateEndpoint OCI client: %w", err)
 		return runtimeClient
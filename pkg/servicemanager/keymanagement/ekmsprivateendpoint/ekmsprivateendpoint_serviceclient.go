@@ -13,6 +13,7 @@ import (
 
 	keymanagementsdk "github.com/oracle/oci-go-sdk/v65/keymanagement"
 	keymanagementv1beta1 "github.com/oracle/oci-service-operator/api/keymanagement/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultEkmsPrivateEndpointServiceClient struct {
 var _ EkmsPrivateEndpointServiceClient = defaultEkmsPrivateEndpointServiceClient{}
 
 var newEkmsPrivateEndpointServiceClient = func(manager *EkmsPrivateEndpointServiceManager) EkmsPrivateEndpointServiceClient {
-	sdkClient, err := keymanagementsdk.NewEkmClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, keymanagementsdk.NewEkmClientWithConfigurationProvider)
 	hooks := newEkmsPrivateEndpointRuntimeHooks(manager, sdkClient)
 	config := buildEkmsPrivateEndpointGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -18,6 +18,7 @@ import (
 	keymanagementv1beta1 "github.com/oracle/oci-service-operator/api/keymanagement/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -85,7 +86,7 @@ func newVaultRuntimeClient(manager *VaultServiceManager, delegate VaultServiceCl
 	if manager.Provider == nil {
 		return runtimeClient
 	}
-	sdkClient, err := keymanagementsdk.NewKmsVaultClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, keymanagementsdk.NewKmsVaultClientWithConfigurationProvider)
 	if err != nil {
 		runtimeClient.initErr = fmt.Errorf("initialize Vault OCI client: %w", err)
 		return runtimeClient
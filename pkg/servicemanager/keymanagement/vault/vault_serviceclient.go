@@ -13,6 +13,7 @@ import (
 
 	keymanagementsdk "github.com/oracle/oci-go-sdk/v65/keymanagement"
 	keymanagementv1beta1 "github.com/oracle/oci-service-operator/api/keymanagement/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultVaultServiceClient struct {
 var _ VaultServiceClient = defaultVaultServiceClient{}
 
 var newVaultServiceClient = func(manager *VaultServiceManager) VaultServiceClient {
-	sdkClient, err := keymanagementsdk.NewKmsVaultClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, keymanagementsdk.NewKmsVaultClientWithConfigurationProvider)
 	hooks := newVaultRuntimeHooks(manager, sdkClient)
 	config := buildVaultGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
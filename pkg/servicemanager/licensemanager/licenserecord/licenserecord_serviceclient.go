@@ -13,6 +13,7 @@ import (
 
 	licensemanagersdk "github.com/oracle/oci-go-sdk/v65/licensemanager"
 	licensemanagerv1beta1 "github.com/oracle/oci-service-operator/api/licensemanager/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultLicenseRecordServiceClient struct {
 var _ LicenseRecordServiceClient = defaultLicenseRecordServiceClient{}
 
 var newLicenseRecordServiceClient = func(manager *LicenseRecordServiceManager) LicenseRecordServiceClient {
-	sdkClient, err := licensemanagersdk.NewLicenseManagerClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, licensemanagersdk.NewLicenseManagerClientWithConfigurationProvider)
 	hooks := newLicenseRecordRuntimeHooks(manager, sdkClient)
 	config := buildLicenseRecordGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
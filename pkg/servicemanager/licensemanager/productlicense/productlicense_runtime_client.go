@@ -20,6 +20,7 @@ import (
 	licensemanagerv1beta1 "github.com/oracle/oci-service-operator/api/licensemanager/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -69,7 +70,7 @@ func newProductLicenseSDKClient(manager *ProductLicenseServiceManager) (productL
 	if manager == nil {
 		return nil, fmt.Errorf("ProductLicense service manager is nil")
 	}
-	client, err := licensemanagersdk.NewLicenseManagerClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, licensemanagersdk.NewLicenseManagerClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
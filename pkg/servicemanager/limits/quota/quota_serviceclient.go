@@ -13,6 +13,7 @@ import (
 
 	limitssdk "github.com/oracle/oci-go-sdk/v65/limits"
 	limitsv1beta1 "github.com/oracle/oci-service-operator/api/limits/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultQuotaServiceClient struct {
 var _ QuotaServiceClient = defaultQuotaServiceClient{}
 
 var newQuotaServiceClient = func(manager *QuotaServiceManager) QuotaServiceClient {
-	sdkClient, err := limitssdk.NewQuotasClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, limitssdk.NewQuotasClientWithConfigurationProvider)
 	hooks := newQuotaRuntimeHooks(manager, sdkClient)
 	config := buildQuotaGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	limitsincreasesdk "github.com/oracle/oci-go-sdk/v65/limitsincrease"
 	limitsincreasev1beta1 "github.com/oracle/oci-service-operator/api/limitsincrease/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultLimitsIncreaseRequestServiceClient struct {
 var _ LimitsIncreaseRequestServiceClient = defaultLimitsIncreaseRequestServiceClient{}
 
 var newLimitsIncreaseRequestServiceClient = func(manager *LimitsIncreaseRequestServiceManager) LimitsIncreaseRequestServiceClient {
-	sdkClient, err := limitsincreasesdk.NewLimitsIncreaseClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, limitsincreasesdk.NewLimitsIncreaseClientWithConfigurationProvider)
 	hooks := newLimitsIncreaseRequestRuntimeHooks(manager, sdkClient)
 	config := buildLimitsIncreaseRequestGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -19,6 +19,7 @@ import (
 	loadbalancerv1beta1 "github.com/oracle/oci-service-operator/api/loadbalancer/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -134,7 +135,7 @@ func newCertificateSDKClient(manager *CertificateServiceManager) (certificateRun
 	if manager == nil {
 		return nil, errors.New("Certificate service manager is nil")
 	}
-	client, err := loadbalancersdk.NewLoadBalancerClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, loadbalancersdk.NewLoadBalancerClientWithConfigurationProvider)
 	if err != nil {
 		return nil, fmt.Errorf("initialize Certificate OCI client: %w", err)
 	}
@@ -16,6 +16,7 @@ import (
 	loadbalancerv1beta1 "github.com/oracle/oci-service-operator/api/loadbalancer/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -120,7 +121,7 @@ func newHostnameWorkRequestClient(manager *HostnameServiceManager) (hostnameWork
 	if manager == nil {
 		return nil, fmt.Errorf("Hostname service manager is nil")
 	}
-	client, err := loadbalancersdk.NewLoadBalancerClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, loadbalancersdk.NewLoadBalancerClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -13,6 +13,7 @@ import (
 
 	loadbalancersdk "github.com/oracle/oci-go-sdk/v65/loadbalancer"
 	loadbalancerv1beta1 "github.com/oracle/oci-service-operator/api/loadbalancer/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultHostnameServiceClient struct {
 var _ HostnameServiceClient = defaultHostnameServiceClient{}
 
 var newHostnameServiceClient = func(manager *HostnameServiceManager) HostnameServiceClient {
-	sdkClient, err := loadbalancersdk.NewLoadBalancerClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, loadbalancersdk.NewLoadBalancerClientWithConfigurationProvider)
 	hooks := newHostnameRuntimeHooks(manager, sdkClient)
 	config := buildHostnameGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
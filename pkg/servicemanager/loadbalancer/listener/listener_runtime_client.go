@@ -18,6 +18,7 @@ import (
 	loadbalancerv1beta1 "github.com/oracle/oci-service-operator/api/loadbalancer/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/credhelper"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
 )
@@ -201,7 +202,7 @@ func providerListenerGetLoadBalancerCall(
 			return loadbalancersdk.GetLoadBalancerResponse{}, fmt.Errorf("listener read OCI client is not configured")
 		}
 
-		client, err := loadbalancersdk.NewLoadBalancerClientWithConfigurationProvider(manager.Provider)
+		client, err := ociclient.New(manager.Provider, loadbalancersdk.NewLoadBalancerClientWithConfigurationProvider)
 		if err != nil {
 			return loadbalancersdk.GetLoadBalancerResponse{}, fmt.Errorf("initialize Listener OCI client: %w", err)
 		}
@@ -14,6 +14,7 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/common"
 	loadbalancersdk "github.com/oracle/oci-go-sdk/v65/loadbalancer"
 	loadbalancerv1beta1 "github.com/oracle/oci-service-operator/api/loadbalancer/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -84,7 +85,7 @@ func newRoutingPolicyWorkRequestClient(manager *RoutingPolicyServiceManager) (ro
 	if manager == nil {
 		return nil, fmt.Errorf("RoutingPolicy service manager is nil")
 	}
-	client, err := loadbalancersdk.NewLoadBalancerClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, loadbalancersdk.NewLoadBalancerClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -16,6 +16,7 @@ import (
 	loadbalancersdk "github.com/oracle/oci-go-sdk/v65/loadbalancer"
 	loadbalancerv1beta1 "github.com/oracle/oci-service-operator/api/loadbalancer/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -213,7 +214,7 @@ func newSSLCipherSuiteSDKClient(manager *SSLCipherSuiteServiceManager) (sslCiphe
 	if manager == nil {
 		return nil, errors.New("SSLCipherSuite service manager is nil")
 	}
-	client, err := loadbalancersdk.NewLoadBalancerClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, loadbalancersdk.NewLoadBalancerClientWithConfigurationProvider)
 	if err != nil {
 		return nil, fmt.Errorf("initialize SSLCipherSuite OCI client: %w", err)
 	}
@@ -13,6 +13,7 @@ import (
 
 	lockboxsdk "github.com/oracle/oci-go-sdk/v65/lockbox"
 	lockboxv1beta1 "github.com/oracle/oci-service-operator/api/lockbox/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultApprovalTemplateServiceClient struct {
 var _ ApprovalTemplateServiceClient = defaultApprovalTemplateServiceClient{}
 
 var newApprovalTemplateServiceClient = func(manager *ApprovalTemplateServiceManager) ApprovalTemplateServiceClient {
-	sdkClient, err := lockboxsdk.NewLockboxClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, lockboxsdk.NewLockboxClientWithConfigurationProvider)
 	hooks := newApprovalTemplateRuntimeHooks(manager, sdkClient)
 	config := buildApprovalTemplateGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
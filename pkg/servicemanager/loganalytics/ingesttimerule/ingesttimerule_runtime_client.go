@@ -17,6 +17,7 @@ import (
 	loganalyticsv1beta1 "github.com/oracle/oci-service-operator/api/loganalytics/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -90,7 +91,7 @@ func appendIngestTimeRuleNamespaceRuntimeWrapper(manager *IngestTimeRuleServiceM
 
 	hooks.WrapGeneratedClient = append(hooks.WrapGeneratedClient, func(delegate IngestTimeRuleServiceClient) IngestTimeRuleServiceClient {
 		client := &namespaceResolvingIngestTimeRuleServiceClient{delegate: delegate}
-		sdkClient, err := loganalyticssdk.NewLogAnalyticsClientWithConfigurationProvider(manager.Provider)
+		sdkClient, err := ociclient.New(manager.Provider, loganalyticssdk.NewLogAnalyticsClientWithConfigurationProvider)
 		if err == nil {
 			client.namespaceLister = sdkClient
 			client.deleteReader = sdkClient
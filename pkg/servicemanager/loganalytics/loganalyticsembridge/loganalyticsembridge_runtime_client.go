@@ -17,6 +17,7 @@ import (
 	loganalyticsv1beta1 "github.com/oracle/oci-service-operator/api/loganalytics/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -100,7 +101,7 @@ func newLogAnalyticsEmBridgeNamespaceLister(provider common.ConfigurationProvide
 	if provider == nil {
 		return nil
 	}
-	client, err := loganalyticssdk.NewLogAnalyticsClientWithConfigurationProvider(provider)
+	client, err := ociclient.New(provider, loganalyticssdk.NewLogAnalyticsClientWithConfigurationProvider)
 	if err != nil {
 		return nil
 	}
@@ -13,6 +13,7 @@ import (
 
 	loganalyticssdk "github.com/oracle/oci-go-sdk/v65/loganalytics"
 	loganalyticsv1beta1 "github.com/oracle/oci-service-operator/api/loganalytics/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultLogAnalyticsEmBridgeServiceClient struct {
 var _ LogAnalyticsEmBridgeServiceClient = defaultLogAnalyticsEmBridgeServiceClient{}
 
 var newLogAnalyticsEmBridgeServiceClient = func(manager *LogAnalyticsEmBridgeServiceManager) LogAnalyticsEmBridgeServiceClient {
-	sdkClient, err := loganalyticssdk.NewLogAnalyticsClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, loganalyticssdk.NewLogAnalyticsClientWithConfigurationProvider)
 	hooks := newLogAnalyticsEmBridgeRuntimeHooks(manager, sdkClient)
 	config := buildLogAnalyticsEmBridgeGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
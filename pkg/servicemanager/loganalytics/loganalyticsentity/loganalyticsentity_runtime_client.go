@@ -17,6 +17,7 @@ import (
 	loganalyticssdk "github.com/oracle/oci-go-sdk/v65/loganalytics"
 	loganalyticsv1beta1 "github.com/oracle/oci-service-operator/api/loganalytics/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -65,7 +66,7 @@ func newLogAnalyticsEntityNamespaceClient(manager *LogAnalyticsEntityServiceMana
 	if manager == nil {
 		return nil, fmt.Errorf("LogAnalyticsEntity service manager is nil")
 	}
-	return loganalyticssdk.NewLogAnalyticsClientWithConfigurationProvider(manager.Provider)
+	return ociclient.New(manager.Provider, loganalyticssdk.NewLogAnalyticsClientWithConfigurationProvider)
 }
 
 func applyLogAnalyticsEntityRuntimeHooks(
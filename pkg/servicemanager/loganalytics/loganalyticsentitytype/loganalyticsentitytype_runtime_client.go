@@ -17,6 +17,7 @@ import (
 	loganalyticsv1beta1 "github.com/oracle/oci-service-operator/api/loganalytics/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -141,7 +142,7 @@ func newLogAnalyticsEntityTypeNamespaceResolver(manager *LogAnalyticsEntityTypeS
 		resolver.initErr = fmt.Errorf("LogAnalyticsEntityType namespace resolver requires service manager")
 		return resolver
 	}
-	sdkClient, err := objectstoragesdk.NewObjectStorageClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, objectstoragesdk.NewObjectStorageClientWithConfigurationProvider)
 	if err != nil {
 		resolver.initErr = fmt.Errorf("initialize Object Storage namespace client for LogAnalyticsEntityType: %w", err)
 		return resolver
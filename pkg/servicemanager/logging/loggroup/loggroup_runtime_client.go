@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/common"
 	loggingsdk "github.com/oracle/oci-go-sdk/v65/logging"
 	loggingv1beta1 "github.com/oracle/oci-service-operator/api/logging/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -47,7 +48,7 @@ func newLogGroupWorkRequestClient(manager *LogGroupServiceManager) (logGroupWork
 	if manager == nil {
 		return nil, fmt.Errorf("LogGroup service manager is nil")
 	}
-	client, err := loggingsdk.NewLoggingManagementClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, loggingsdk.NewLoggingManagementClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
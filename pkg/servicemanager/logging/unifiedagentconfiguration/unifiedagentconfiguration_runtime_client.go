@@ -14,6 +14,7 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/common"
 	loggingsdk "github.com/oracle/oci-go-sdk/v65/logging"
 	loggingv1beta1 "github.com/oracle/oci-service-operator/api/logging/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -54,7 +55,7 @@ func newUnifiedAgentConfigurationSDKClient(manager *UnifiedAgentConfigurationSer
 	if manager == nil {
 		return nil, fmt.Errorf("UnifiedAgentConfiguration service manager is nil")
 	}
-	client, err := loggingsdk.NewLoggingManagementClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, loggingsdk.NewLoggingManagementClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
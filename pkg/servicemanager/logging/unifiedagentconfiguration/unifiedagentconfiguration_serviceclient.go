@@ -13,6 +13,7 @@ import (
 
 	loggingsdk "github.com/oracle/oci-go-sdk/v65/logging"
 	loggingv1beta1 "github.com/oracle/oci-service-operator/api/logging/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultUnifiedAgentConfigurationServiceClient struct {
 var _ UnifiedAgentConfigurationServiceClient = defaultUnifiedAgentConfigurationServiceClient{}
 
 var newUnifiedAgentConfigurationServiceClient = func(manager *UnifiedAgentConfigurationServiceManager) UnifiedAgentConfigurationServiceClient {
-	sdkClient, err := loggingsdk.NewLoggingManagementClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, loggingsdk.NewLoggingManagementClientWithConfigurationProvider)
 	hooks := newUnifiedAgentConfigurationRuntimeHooks(manager, sdkClient)
 	config := buildUnifiedAgentConfigurationGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
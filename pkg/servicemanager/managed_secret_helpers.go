@@ -6,11 +6,16 @@
 package servicemanager
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
+	"text/template"
 
 	"github.com/oracle/oci-service-operator/pkg/credhelper"
+	"github.com/oracle/oci-service-operator/pkg/shared"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
@@ -64,9 +69,41 @@ func EnsureOwnedSecret(
 	ownerKind,
 	ownerName string,
 	data map[string][]byte,
+) (bool, error) {
+	return ensureOwnedSecret(ctx, client, secretName, secretNamespace, ownerKind, ownerName,
+		ManagedSecretLabels(ownerKind, ownerName), data)
+}
+
+// EnsureOwnedConnectionSecret is EnsureOwnedSecret for the connection secret of
+// the resource named ownerName, written as configured by target.
+func EnsureOwnedConnectionSecret(
+	ctx context.Context,
+	client credhelper.CredentialClient,
+	target *shared.ConnectionSecretTarget,
+	secretNamespace,
+	ownerKind,
+	ownerName string,
+	data map[string][]byte,
+) (bool, error) {
+	rendered, err := RenderConnectionSecretData(target, data)
+	if err != nil {
+		return false, err
+	}
+	return ensureOwnedSecret(ctx, client, ConnectionSecretName(target, ownerName), secretNamespace, ownerKind, ownerName,
+		ConnectionSecretLabels(target, ManagedSecretLabels(ownerKind, ownerName)), rendered)
+}
+
+func ensureOwnedSecret(
+	ctx context.Context,
+	client credhelper.CredentialClient,
+	secretName,
+	secretNamespace,
+	ownerKind,
+	ownerName string,
+	labels map[string]string,
+	data map[string][]byte,
 ) (bool, error) {
 	managedData := AddManagedSecretData(data, ownerKind, ownerName)
-	labels := ManagedSecretLabels(ownerKind, ownerName)
 
 	ok, err := client.CreateSecret(ctx, secretName, secretNamespace, labels, managedData)
 	if err == nil {
@@ -119,6 +156,76 @@ func DeleteOwnedSecretIfPresent(
 	return true, nil
 }
 
+// ConnectionSecretName returns the name of the Secret the resource named
+// resourceName writes its connection details to.
+func ConnectionSecretName(target *shared.ConnectionSecretTarget, resourceName string) string {
+	if target != nil && strings.TrimSpace(target.Name) != "" {
+		return strings.TrimSpace(target.Name)
+	}
+	return resourceName
+}
+
+// ConnectionSecretLabels merges the labels of target with ownerLabels. Owner
+// labels win, so target cannot claim or drop ownership.
+func ConnectionSecretLabels(target *shared.ConnectionSecretTarget, ownerLabels map[string]string) map[string]string {
+	if target == nil || len(target.Labels) == 0 {
+		return ownerLabels
+	}
+	merged := make(map[string]string, len(target.Labels)+len(ownerLabels))
+	for key, value := range target.Labels {
+		merged[key] = value
+	}
+	for key, value := range ownerLabels {
+		merged[key] = value
+	}
+	return merged
+}
+
+// ConnectionSecretLabelsCurrent reports whether labels already holds every
+// entry of desired.
+func ConnectionSecretLabelsCurrent(labels, desired map[string]string) bool {
+	for key, value := range desired {
+		if current, ok := labels[key]; !ok || current != value {
+			return false
+		}
+	}
+	return true
+}
+
+// RenderConnectionSecretData applies the key templates of target to the
+// default connection keys in data. Each template sees the default keys as
+// string fields, so "{{ .endpoint }}" yields the default endpoint value. A
+// template that names a missing key fails instead of rendering "<no value>".
+func RenderConnectionSecretData(target *shared.ConnectionSecretTarget, data map[string][]byte) (map[string][]byte, error) {
+	if target == nil || len(target.Keys) == 0 {
+		return data, nil
+	}
+
+	values := make(map[string]string, len(data))
+	for key, value := range data {
+		values[key] = string(value)
+	}
+	keys := make([]string, 0, len(target.Keys))
+	for key := range target.Keys {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	rendered := make(map[string][]byte, len(keys))
+	for _, key := range keys {
+		tmpl, err := template.New(key).Option("missingkey=error").Parse(target.Keys[key])
+		if err != nil {
+			return nil, fmt.Errorf("parse connection secret key %q: %w", key, err)
+		}
+		var out bytes.Buffer
+		if err := tmpl.Execute(&out, values); err != nil {
+			return nil, fmt.Errorf("render connection secret key %q: %w", key, err)
+		}
+		rendered[key] = out.Bytes()
+	}
+	return rendered, nil
+}
+
 func stripManagedSecretData(data map[string][]byte) map[string][]byte {
 	stripped := make(map[string][]byte, len(data))
 	for key, value := range data {
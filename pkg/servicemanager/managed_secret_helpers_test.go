@@ -0,0 +1,79 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package servicemanager
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	shared "github.com/oracle/oci-service-operator/pkg/shared"
+)
+
+func TestRenderConnectionSecretData(t *testing.T) {
+	t.Parallel()
+
+	data := map[string][]byte{
+		"PrivateIPAddress": []byte("10.0.0.5"),
+		"MySQLPort":        []byte("3306"),
+	}
+
+	got, err := RenderConnectionSecretData(nil, data)
+	if err != nil {
+		t.Fatalf("RenderConnectionSecretData(nil) error = %v", err)
+	}
+	if !reflect.DeepEqual(got, data) {
+		t.Fatalf("RenderConnectionSecretData(nil) = %v, want the default keys", got)
+	}
+
+	target := &shared.ConnectionSecretTarget{Keys: map[string]string{
+		"url":  "jdbc:mysql://{{ .PrivateIPAddress }}:{{ .MySQLPort }}/app",
+		"host": "{{ .PrivateIPAddress }}",
+	}}
+	got, err = RenderConnectionSecretData(target, data)
+	if err != nil {
+		t.Fatalf("RenderConnectionSecretData() error = %v", err)
+	}
+	want := map[string][]byte{
+		"url":  []byte("jdbc:mysql://10.0.0.5:3306/app"),
+		"host": []byte("10.0.0.5"),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("RenderConnectionSecretData() = %v, want %v", got, want)
+	}
+
+	target.Keys["port"] = "{{ .Port }}"
+	if _, err := RenderConnectionSecretData(target, data); err == nil || !strings.Contains(err.Error(), `"port"`) {
+		t.Fatalf("RenderConnectionSecretData() error = %v, want a missing key error for port", err)
+	}
+}
+
+func TestConnectionSecretNameAndLabels(t *testing.T) {
+	t.Parallel()
+
+	if got := ConnectionSecretName(nil, "queue"); got != "queue" {
+		t.Fatalf("ConnectionSecretName(nil) = %q, want the resource name", got)
+	}
+	target := &shared.ConnectionSecretTarget{
+		Name:   "queue-conn",
+		Labels: map[string]string{"team": "payments", "owner": "spoofed"},
+	}
+	if got := ConnectionSecretName(target, "queue"); got != "queue-conn" {
+		t.Fatalf("ConnectionSecretName() = %q, want queue-conn", got)
+	}
+
+	labels := ConnectionSecretLabels(target, map[string]string{"owner": "queue-uid"})
+	want := map[string]string{"team": "payments", "owner": "queue-uid"}
+	if !reflect.DeepEqual(labels, want) {
+		t.Fatalf("ConnectionSecretLabels() = %v, want %v", labels, want)
+	}
+	if !ConnectionSecretLabelsCurrent(map[string]string{"team": "payments", "owner": "queue-uid", "extra": "x"}, want) {
+		t.Fatal("ConnectionSecretLabelsCurrent() = false, want true for a superset")
+	}
+	if ConnectionSecretLabelsCurrent(map[string]string{"owner": "queue-uid"}, want) {
+		t.Fatal("ConnectionSecretLabelsCurrent() = true, want false when a label is missing")
+	}
+}
@@ -17,6 +17,7 @@ import (
 	managedkafkasdk "github.com/oracle/oci-go-sdk/v65/managedkafka"
 	managedkafkav1beta1 "github.com/oracle/oci-service-operator/api/managedkafka/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -77,7 +78,7 @@ func newKafkaClusterWorkRequestClient(manager *KafkaClusterServiceManager) (kafk
 	if manager == nil {
 		return nil, fmt.Errorf("KafkaCluster service manager is nil")
 	}
-	client, err := managedkafkasdk.NewKafkaClusterClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, managedkafkasdk.NewKafkaClusterClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -13,6 +13,7 @@ import (
 
 	managedkafkasdk "github.com/oracle/oci-go-sdk/v65/managedkafka"
 	managedkafkav1beta1 "github.com/oracle/oci-service-operator/api/managedkafka/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultKafkaClusterServiceClient struct {
 var _ KafkaClusterServiceClient = defaultKafkaClusterServiceClient{}
 
 var newKafkaClusterServiceClient = func(manager *KafkaClusterServiceManager) KafkaClusterServiceClient {
-	sdkClient, err := managedkafkasdk.NewKafkaClusterClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, managedkafkasdk.NewKafkaClusterClientWithConfigurationProvider)
 	hooks := newKafkaClusterRuntimeHooks(manager, sdkClient)
 	config := buildKafkaClusterGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	managedkafkasdk "github.com/oracle/oci-go-sdk/v65/managedkafka"
 	managedkafkav1beta1 "github.com/oracle/oci-service-operator/api/managedkafka/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultKafkaClusterConfigServiceClient struct {
 var _ KafkaClusterConfigServiceClient = defaultKafkaClusterConfigServiceClient{}
 
 var newKafkaClusterConfigServiceClient = func(manager *KafkaClusterConfigServiceManager) KafkaClusterConfigServiceClient {
-	sdkClient, err := managedkafkasdk.NewKafkaClusterClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, managedkafkasdk.NewKafkaClusterClientWithConfigurationProvider)
 	hooks := newKafkaClusterConfigRuntimeHooks(manager, sdkClient)
 	config := buildKafkaClusterConfigGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
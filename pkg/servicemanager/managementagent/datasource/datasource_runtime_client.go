@@ -17,6 +17,7 @@ import (
 	managementagentv1beta1 "github.com/oracle/oci-service-operator/api/managementagent/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -128,7 +129,7 @@ func newDataSourceSDKOCIClient(manager *DataSourceServiceManager) dataSourceSDKO
 	if manager == nil {
 		return dataSourceSDKOCIClient{initErr: fmt.Errorf("DataSource service manager is nil")}
 	}
-	client, err := managementagentsdk.NewManagementAgentClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, managementagentsdk.NewManagementAgentClientWithConfigurationProvider)
 	return dataSourceSDKOCIClient{
 		client:  client,
 		initErr: err,
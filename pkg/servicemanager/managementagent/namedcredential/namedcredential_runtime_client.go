@@ -17,6 +17,7 @@ import (
 	managementagentv1beta1 "github.com/oracle/oci-service-operator/api/managementagent/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	"github.com/oracle/oci-service-operator/pkg/shared"
@@ -83,7 +84,7 @@ func newNamedCredentialOCIClient(manager *NamedCredentialServiceManager) (namedC
 	if manager == nil {
 		return nil, fmt.Errorf("named credential service manager is nil")
 	}
-	client, err := managementagentsdk.NewManagementAgentClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, managementagentsdk.NewManagementAgentClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -13,6 +13,7 @@ import (
 
 	managementagentsdk "github.com/oracle/oci-go-sdk/v65/managementagent"
 	managementagentv1beta1 "github.com/oracle/oci-service-operator/api/managementagent/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultNamedCredentialServiceClient struct {
 var _ NamedCredentialServiceClient = defaultNamedCredentialServiceClient{}
 
 var newNamedCredentialServiceClient = func(manager *NamedCredentialServiceManager) NamedCredentialServiceClient {
-	sdkClient, err := managementagentsdk.NewManagementAgentClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, managementagentsdk.NewManagementAgentClientWithConfigurationProvider)
 	hooks := newNamedCredentialRuntimeHooks(manager, sdkClient)
 	config := buildNamedCredentialGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	managementdashboardsdk "github.com/oracle/oci-go-sdk/v65/managementdashboard"
 	managementdashboardv1beta1 "github.com/oracle/oci-service-operator/api/managementdashboard/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultManagementDashboardServiceClient struct {
 var _ ManagementDashboardServiceClient = defaultManagementDashboardServiceClient{}
 
 var newManagementDashboardServiceClient = func(manager *ManagementDashboardServiceManager) ManagementDashboardServiceClient {
-	sdkClient, err := managementdashboardsdk.NewDashxApisClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, managementdashboardsdk.NewDashxApisClientWithConfigurationProvider)
 	hooks := newManagementDashboardRuntimeHooks(manager, sdkClient)
 	config := buildManagementDashboardGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 	marketplacesdk "github.com/oracle/oci-go-sdk/v65/marketplace"
 	marketplacev1beta1 "github.com/oracle/oci-service-operator/api/marketplace/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -98,7 +99,7 @@ func newAcceptedAgreementRuntimeWrapper(
 	if manager.Provider == nil {
 		return runtimeClient
 	}
-	if _, err := marketplacesdk.NewMarketplaceClientWithConfigurationProvider(manager.Provider); err != nil {
+	if _, err := ociclient.New(manager.Provider, marketplacesdk.NewMarketplaceClientWithConfigurationProvider); err != nil {
 		runtimeClient.initErr = fmt.Errorf("initialize AcceptedAgreement OCI client: %w", err)
 	}
 	return runtimeClient
@@ -13,6 +13,7 @@ import (
 
 	marketplacesdk "github.com/oracle/oci-go-sdk/v65/marketplace"
 	marketplacev1beta1 "github.com/oracle/oci-service-operator/api/marketplace/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultPublicationServiceClient struct {
 var _ PublicationServiceClient = defaultPublicationServiceClient{}
 
 var newPublicationServiceClient = func(manager *PublicationServiceManager) PublicationServiceClient {
-	sdkClient, err := marketplacesdk.NewMarketplaceClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, marketplacesdk.NewMarketplaceClientWithConfigurationProvider)
 	hooks := newPublicationRuntimeHooks(manager, sdkClient)
 	config := buildPublicationGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -19,6 +19,7 @@ import (
 	offerv1beta1 "github.com/oracle/oci-service-operator/api/marketplaceprivateoffer/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -61,7 +62,7 @@ func newOfferSDKClient(manager *OfferServiceManager) (offerOCIClient, error) {
 	if manager == nil {
 		return nil, fmt.Errorf("offer service manager is nil")
 	}
-	client, err := offersdk.NewOfferClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, offersdk.NewOfferClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -13,6 +13,7 @@ import (
 
 	marketplaceprivateoffersdk "github.com/oracle/oci-go-sdk/v65/marketplaceprivateoffer"
 	marketplaceprivateofferv1beta1 "github.com/oracle/oci-service-operator/api/marketplaceprivateoffer/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultOfferServiceClient struct {
 var _ OfferServiceClient = defaultOfferServiceClient{}
 
 var newOfferServiceClient = func(manager *OfferServiceManager) OfferServiceClient {
-	sdkClient, err := marketplaceprivateoffersdk.NewOfferClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, marketplaceprivateoffersdk.NewOfferClientWithConfigurationProvider)
 	hooks := newOfferRuntimeHooks(manager, sdkClient)
 	config := buildOfferGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -17,6 +17,7 @@ import (
 	marketplacepublisherv1beta1 "github.com/oracle/oci-service-operator/api/marketplacepublisher/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -149,7 +150,7 @@ func newArtifactSDKClient(manager *ArtifactServiceManager) (artifactOCIClient, e
 	if manager == nil {
 		return nil, fmt.Errorf("%s service manager is nil", artifactKind)
 	}
-	client, err := marketplacepublishersdk.NewMarketplacePublisherClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, marketplacepublishersdk.NewMarketplacePublisherClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -13,6 +13,7 @@ import (
 
 	marketplacepublishersdk "github.com/oracle/oci-go-sdk/v65/marketplacepublisher"
 	marketplacepublisherv1beta1 "github.com/oracle/oci-service-operator/api/marketplacepublisher/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultArtifactServiceClient struct {
 var _ ArtifactServiceClient = defaultArtifactServiceClient{}
 
 var newArtifactServiceClient = func(manager *ArtifactServiceManager) ArtifactServiceClient {
-	sdkClient, err := marketplacepublishersdk.NewMarketplacePublisherClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, marketplacepublishersdk.NewMarketplacePublisherClientWithConfigurationProvider)
 	hooks := newArtifactRuntimeHooks(manager, sdkClient)
 	config := buildArtifactGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -18,6 +18,7 @@ import (
 	marketplacepublisherv1beta1 "github.com/oracle/oci-service-operator/api/marketplacepublisher/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 )
@@ -93,7 +94,7 @@ func newListingRevisionSDKClient(manager *ListingRevisionServiceManager) (listin
 	if manager == nil {
 		return nil, fmt.Errorf("%s service manager is nil", listingRevisionKind)
 	}
-	client, err := marketplacepublishersdk.NewMarketplacePublisherClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, marketplacepublishersdk.NewMarketplacePublisherClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -13,6 +13,7 @@ import (
 
 	marketplacepublishersdk "github.com/oracle/oci-go-sdk/v65/marketplacepublisher"
 	marketplacepublisherv1beta1 "github.com/oracle/oci-service-operator/api/marketplacepublisher/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultListingRevisionServiceClient struct {
 var _ ListingRevisionServiceClient = defaultListingRevisionServiceClient{}
 
 var newListingRevisionServiceClient = func(manager *ListingRevisionServiceManager) ListingRevisionServiceClient {
-	sdkClient, err := marketplacepublishersdk.NewMarketplacePublisherClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, marketplacepublishersdk.NewMarketplacePublisherClientWithConfigurationProvider)
 	hooks := newListingRevisionRuntimeHooks(manager, sdkClient)
 	config := buildListingRevisionGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -18,6 +18,7 @@ import (
 	marketplacepublisherv1beta1 "github.com/oracle/oci-service-operator/api/marketplacepublisher/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	"github.com/oracle/oci-service-operator/pkg/shared"
@@ -206,7 +207,7 @@ func appendListingRevisionAttachmentConcreteClientWrapper(
 		return
 	}
 	hooks.WrapGeneratedClient = append(hooks.WrapGeneratedClient, func(ListingRevisionAttachmentServiceClient) ListingRevisionAttachmentServiceClient {
-		sdkClient, err := marketplacepublishersdk.NewMarketplacePublisherClientWithConfigurationProvider(manager.Provider)
+		sdkClient, err := ociclient.New(manager.Provider, marketplacepublishersdk.NewMarketplacePublisherClientWithConfigurationProvider)
 		customHooks := newListingRevisionAttachmentDefaultRuntimeHooks(sdkClient)
 		applyListingRevisionAttachmentRuntimeHookSettings(&customHooks)
 		config := buildListingRevisionAttachmentConcreteGeneratedRuntimeConfig(manager, customHooks)
@@ -13,6 +13,7 @@ import (
 
 	monitoringsdk "github.com/oracle/oci-go-sdk/v65/monitoring"
 	monitoringv1beta1 "github.com/oracle/oci-service-operator/api/monitoring/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultAlarmServiceClient struct {
 var _ AlarmServiceClient = defaultAlarmServiceClient{}
 
 var newAlarmServiceClient = func(manager *AlarmServiceManager) AlarmServiceClient {
-	sdkClient, err := monitoringsdk.NewMonitoringClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, monitoringsdk.NewMonitoringClientWithConfigurationProvider)
 	hooks := newAlarmRuntimeHooks(manager, sdkClient)
 	config := buildAlarmGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
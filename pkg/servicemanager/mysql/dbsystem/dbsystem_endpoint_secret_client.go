@@ -18,6 +18,7 @@ import (
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
@@ -42,6 +43,18 @@ type dbSystemEndpointSecretClient struct {
 
 var _ DbSystemServiceClient = dbSystemEndpointSecretClient{}
 
+var _ servicemanager.OSOKOrphanCleaner = (*DbSystemServiceManager)(nil)
+
+// CleanupOrphaned removes the endpoint secret when a deletion policy leaves the
+// OCI MySQL DbSystem in place, because the secret is not garbage collected with the CR.
+func (c *DbSystemServiceManager) CleanupOrphaned(ctx context.Context, obj runtime.Object) error {
+	resource, err := c.convert(obj)
+	if err != nil {
+		return err
+	}
+	return newDbSystemEndpointSecretClient(c, c.client).deleteEndpointSecret(ctx, resource)
+}
+
 func appendDbSystemEndpointSecretRuntimeWrapper(manager *DbSystemServiceManager, hooks *DbSystemRuntimeHooks) {
 	if manager == nil || hooks == nil {
 		return
@@ -52,7 +65,7 @@ func appendDbSystemEndpointSecretRuntimeWrapper(manager *DbSystemServiceManager,
 	})
 }
 
-func newDbSystemEndpointSecretClient(manager *DbSystemServiceManager, delegate DbSystemServiceClient) DbSystemServiceClient {
+func newDbSystemEndpointSecretClient(manager *DbSystemServiceManager, delegate DbSystemServiceClient) dbSystemEndpointSecretClient {
 	client := dbSystemEndpointSecretClient{
 		delegate:         delegate,
 		credentialClient: manager.CredentialClient,
@@ -131,8 +144,12 @@ func (c dbSystemEndpointSecretClient) syncEndpointSecret(ctx context.Context, re
 	if err != nil {
 		return err
 	}
+	desiredData, err = servicemanager.RenderConnectionSecretData(resource.Spec.WriteConnectionSecretToRef, desiredData)
+	if err != nil {
+		return err
+	}
 
-	currentRecord, err := c.secretRecordReader.GetSecretRecord(ctx, resource.Name, resource.Namespace)
+	currentRecord, err := c.secretRecordReader.GetSecretRecord(ctx, dbSystemEndpointSecretName(resource), resource.Namespace)
 	if err == nil {
 		return c.syncExistingEndpointSecret(ctx, resource, currentRecord, desiredData)
 	}
@@ -148,12 +165,12 @@ func (c dbSystemEndpointSecretClient) createEndpointSecret(
 	ownerLabels map[string]string,
 	data map[string][]byte,
 ) error {
-	_, err := c.credentialClient.CreateSecret(ctx, resource.Name, resource.Namespace, ownerLabels, data)
+	_, err := c.credentialClient.CreateSecret(ctx, dbSystemEndpointSecretName(resource), resource.Namespace, ownerLabels, data)
 	switch {
 	case err == nil:
 		return nil
 	case apierrors.IsAlreadyExists(err):
-		currentRecord, rereadErr := c.secretRecordReader.GetSecretRecord(ctx, resource.Name, resource.Namespace)
+		currentRecord, rereadErr := c.secretRecordReader.GetSecretRecord(ctx, dbSystemEndpointSecretName(resource), resource.Namespace)
 		if rereadErr != nil {
 			return rereadErr
 		}
@@ -173,11 +190,19 @@ func (c dbSystemEndpointSecretClient) syncExistingEndpointSecret(
 	if err != nil {
 		return err
 	}
+	ownerLabels, err := dbSystemEndpointSecretLabels(resource)
+	if err != nil {
+		return err
+	}
 	if owned {
-		if reflect.DeepEqual(currentRecord.Data, desiredData) {
+		var labels map[string]string
+		if !servicemanager.ConnectionSecretLabelsCurrent(currentRecord.Labels, ownerLabels) {
+			labels = mergeDbSystemEndpointSecretLabels(currentRecord.Labels, ownerLabels)
+		}
+		if labels == nil && reflect.DeepEqual(currentRecord.Data, desiredData) {
 			return nil
 		}
-		_, err = c.guardedSecretMutator.UpdateSecretIfCurrent(ctx, resource.Name, resource.Namespace, currentRecord, nil, desiredData)
+		_, err = c.guardedSecretMutator.UpdateSecretIfCurrent(ctx, dbSystemEndpointSecretName(resource), resource.Namespace, currentRecord, labels, desiredData)
 		return err
 	}
 
@@ -189,12 +214,12 @@ func (c dbSystemEndpointSecretClient) syncExistingEndpointSecret(
 		return fmt.Errorf(
 			"mysql dbsystem endpoint secret %s/%s is not owned by DbSystem UID %q",
 			resource.Namespace,
-			resource.Name,
+			dbSystemEndpointSecretName(resource),
 			resource.UID,
 		)
 	}
 
-	_, err = c.guardedSecretMutator.UpdateSecretIfCurrent(ctx, resource.Name, resource.Namespace, currentRecord, adoptionLabels, desiredData)
+	_, err = c.guardedSecretMutator.UpdateSecretIfCurrent(ctx, dbSystemEndpointSecretName(resource), resource.Namespace, currentRecord, adoptionLabels, desiredData)
 	return err
 }
 
@@ -255,7 +280,7 @@ func (c dbSystemEndpointSecretClient) deleteEndpointSecret(ctx context.Context,
 		return fmt.Errorf("mysql dbsystem endpoint secret ownership checks require guarded secret mutations")
 	}
 
-	record, err := c.secretRecordReader.GetSecretRecord(ctx, resource.Name, resource.Namespace)
+	record, err := c.secretRecordReader.GetSecretRecord(ctx, dbSystemEndpointSecretName(resource), resource.Namespace)
 	switch {
 	case apierrors.IsNotFound(err):
 		return nil
@@ -271,7 +296,7 @@ func (c dbSystemEndpointSecretClient) deleteEndpointSecret(ctx context.Context,
 		return nil
 	}
 
-	_, err = c.guardedSecretMutator.DeleteSecretIfCurrent(ctx, resource.Name, resource.Namespace, record)
+	_, err = c.guardedSecretMutator.DeleteSecretIfCurrent(ctx, dbSystemEndpointSecretName(resource), resource.Namespace, record)
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
@@ -283,9 +308,15 @@ func dbSystemEndpointSecretLabels(resource *mysqlv1beta1.DbSystem) (map[string]s
 	if err != nil {
 		return nil, err
 	}
-	return map[string]string{
+	return servicemanager.ConnectionSecretLabels(resource.Spec.WriteConnectionSecretToRef, map[string]string{
 		dbSystemEndpointSecretOwnerUIDLabel: ownerUID,
-	}, nil
+	}), nil
+}
+
+// dbSystemEndpointSecretName returns the name of the Secret the endpoint details are
+// written to, spec.writeConnectionSecretToRef.name when set.
+func dbSystemEndpointSecretName(resource *mysqlv1beta1.DbSystem) string {
+	return servicemanager.ConnectionSecretName(resource.Spec.WriteConnectionSecretToRef, resource.Name)
 }
 
 func dbSystemOwnsEndpointSecret(resource *mysqlv1beta1.DbSystem, labels map[string]string) (bool, error) {
@@ -16,6 +16,7 @@ import (
 	mysqlsdk "github.com/oracle/oci-go-sdk/v65/mysql"
 	mysqlv1beta1 "github.com/oracle/oci-service-operator/api/mysql/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/credhelper"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 )
 
@@ -25,7 +26,7 @@ type dbSystemAvailabilityDomainClient interface {
 }
 
 var newDbSystemAvailabilityDomainClient = func(provider common.ConfigurationProvider) (dbSystemAvailabilityDomainClient, error) {
-	return identity.NewIdentityClientWithConfigurationProvider(provider)
+	return ociclient.New(provider, identity.NewIdentityClientWithConfigurationProvider)
 }
 
 func init() {
@@ -13,6 +13,7 @@ import (
 
 	mysqlsdk "github.com/oracle/oci-go-sdk/v65/mysql"
 	mysqlv1beta1 "github.com/oracle/oci-service-operator/api/mysql/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultDbSystemServiceClient struct {
 var _ DbSystemServiceClient = defaultDbSystemServiceClient{}
 
 var newDbSystemServiceClient = func(manager *DbSystemServiceManager) DbSystemServiceClient {
-	sdkClient, err := mysqlsdk.NewDbSystemClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, mysqlsdk.NewDbSystemClientWithConfigurationProvider)
 	hooks := newDbSystemRuntimeHooks(manager, sdkClient)
 	config := buildDbSystemGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
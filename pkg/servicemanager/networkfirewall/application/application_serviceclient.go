@@ -13,6 +13,7 @@ import (
 
 	networkfirewallsdk "github.com/oracle/oci-go-sdk/v65/networkfirewall"
 	networkfirewallv1beta1 "github.com/oracle/oci-service-operator/api/networkfirewall/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultApplicationServiceClient struct {
 var _ ApplicationServiceClient = defaultApplicationServiceClient{}
 
 var newApplicationServiceClient = func(manager *ApplicationServiceManager) ApplicationServiceClient {
-	sdkClient, err := networkfirewallsdk.NewNetworkFirewallClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, networkfirewallsdk.NewNetworkFirewallClientWithConfigurationProvider)
 	hooks := newApplicationRuntimeHooks(manager, sdkClient)
 	config := buildApplicationGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -14,6 +14,7 @@ import (
 	networkloadbalancersdk "github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
 	networkloadbalancerv1beta1 "github.com/oracle/oci-service-operator/api/networkloadbalancer/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -63,7 +64,7 @@ func newBackendRuntimeOCIClient(manager *BackendServiceManager) (backendRuntimeO
 	if manager == nil {
 		return nil, fmt.Errorf("backend service manager is nil")
 	}
-	client, err := networkloadbalancersdk.NewNetworkLoadBalancerClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, networkloadbalancersdk.NewNetworkLoadBalancerClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
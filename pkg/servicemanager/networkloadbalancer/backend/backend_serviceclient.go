@@ -13,6 +13,7 @@ import (
 
 	networkloadbalancersdk "github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
 	networkloadbalancerv1beta1 "github.com/oracle/oci-service-operator/api/networkloadbalancer/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultBackendServiceClient struct {
 var _ BackendServiceClient = defaultBackendServiceClient{}
 
 var newBackendServiceClient = func(manager *BackendServiceManager) BackendServiceClient {
-	sdkClient, err := networkloadbalancersdk.NewNetworkLoadBalancerClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, networkloadbalancersdk.NewNetworkLoadBalancerClientWithConfigurationProvider)
 	hooks := newBackendRuntimeHooks(manager, sdkClient)
 	config := buildBackendGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
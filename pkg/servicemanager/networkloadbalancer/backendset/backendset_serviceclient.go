@@ -13,6 +13,7 @@ import (
 
 	networkloadbalancersdk "github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
 	networkloadbalancerv1beta1 "github.com/oracle/oci-service-operator/api/networkloadbalancer/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultBackendSetServiceClient struct {
 var _ BackendSetServiceClient = defaultBackendSetServiceClient{}
 
 var newBackendSetServiceClient = func(manager *BackendSetServiceManager) BackendSetServiceClient {
-	sdkClient, err := networkloadbalancersdk.NewNetworkLoadBalancerClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, networkloadbalancersdk.NewNetworkLoadBalancerClientWithConfigurationProvider)
 	hooks := newBackendSetRuntimeHooks(manager, sdkClient)
 	config := buildBackendSetGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -18,6 +18,7 @@ import (
 	networkloadbalancerv1beta1 "github.com/oracle/oci-service-operator/api/networkloadbalancer/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -233,7 +234,7 @@ func newListenerSDKClient(manager *ListenerServiceManager) (listenerRuntimeOCICl
 	if manager == nil {
 		return nil, errors.New("listener service manager is nil")
 	}
-	client, err := networkloadbalancersdk.NewNetworkLoadBalancerClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, networkloadbalancersdk.NewNetworkLoadBalancerClientWithConfigurationProvider)
 	if err != nil {
 		return nil, fmt.Errorf("initialize Listener OCI client: %w", err)
 	}
@@ -19,6 +19,7 @@ import (
 	nosqlv1beta1 "github.com/oracle/oci-service-operator/api/nosql/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -81,7 +82,7 @@ func newTableSDKClient(manager *TableServiceManager) (tableOCIClient, error) {
 		return nil, fmt.Errorf("Table service manager is nil")
 	}
 
-	client, err := nosqlsdk.NewNosqlClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, nosqlsdk.NewNosqlClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -13,6 +13,7 @@ import (
 
 	nosqlsdk "github.com/oracle/oci-go-sdk/v65/nosql"
 	nosqlv1beta1 "github.com/oracle/oci-service-operator/api/nosql/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultTableServiceClient struct {
 var _ TableServiceClient = defaultTableServiceClient{}
 
 var newTableServiceClient = func(manager *TableServiceManager) TableServiceClient {
-	sdkClient, err := nosqlsdk.NewNosqlClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, nosqlsdk.NewNosqlClientWithConfigurationProvider)
 	hooks := newTableRuntimeHooks(manager, sdkClient)
 	config := buildTableGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/common"
 	objectstoragesdk "github.com/oracle/oci-go-sdk/v65/objectstorage"
 	objectstoragev1beta1 "github.com/oracle/oci-service-operator/api/objectstorage/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -65,7 +66,7 @@ func newNamespaceResolvingBucketServiceClient(manager *BucketServiceManager, del
 		return client
 	}
 
-	sdkClient, err := objectstoragesdk.NewObjectStorageClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, objectstoragesdk.NewObjectStorageClientWithConfigurationProvider)
 	if err == nil {
 		client.namespaceGetter = sdkClient
 	}
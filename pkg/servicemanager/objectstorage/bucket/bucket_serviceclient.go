@@ -13,6 +13,7 @@ import (
 
 	objectstoragesdk "github.com/oracle/oci-go-sdk/v65/objectstorage"
 	objectstoragev1beta1 "github.com/oracle/oci-service-operator/api/objectstorage/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultBucketServiceClient struct {
 var _ BucketServiceClient = defaultBucketServiceClient{}
 
 var newBucketServiceClient = func(manager *BucketServiceManager) BucketServiceClient {
-	sdkClient, err := objectstoragesdk.NewObjectStorageClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, objectstoragesdk.NewObjectStorageClientWithConfigurationProvider)
 	hooks := newBucketRuntimeHooks(manager, sdkClient)
 	config := buildBucketGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	ocvpsdk "github.com/oracle/oci-go-sdk/v65/ocvp"
 	ocvpv1beta1 "github.com/oracle/oci-service-operator/api/ocvp/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultEsxiHostServiceClient struct {
 var _ EsxiHostServiceClient = defaultEsxiHostServiceClient{}
 
 var newEsxiHostServiceClient = func(manager *EsxiHostServiceManager) EsxiHostServiceClient {
-	sdkClient, err := ocvpsdk.NewEsxiHostClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, ocvpsdk.NewEsxiHostClientWithConfigurationProvider)
 	hooks := newEsxiHostRuntimeHooks(manager, sdkClient)
 	config := buildEsxiHostGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -20,6 +20,7 @@ import (
 	odav1beta1 "github.com/oracle/oci-service-operator/api/oda/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -106,8 +107,8 @@ func newDigitalAssistantSDKClient(manager *DigitalAssistantServiceManager) (digi
 	if manager == nil {
 		return nil, fmt.Errorf("DigitalAssistant service manager is nil")
 	}
-	managementClient, managementErr := odasdk.NewManagementClientWithConfigurationProvider(manager.Provider)
-	workClient, workErr := odasdk.NewOdaClientWithConfigurationProvider(manager.Provider)
+	managementClient, managementErr := ociclient.New(manager.Provider, odasdk.NewManagementClientWithConfigurationProvider)
+	workClient, workErr := ociclient.New(manager.Provider, odasdk.NewOdaClientWithConfigurationProvider)
 	if managementErr != nil || workErr != nil {
 		return nil, fmt.Errorf("initialize DigitalAssistant OCI clients: %w", joinErrors(managementErr, workErr))
 	}
@@ -13,6 +13,7 @@ import (
 
 	odasdk "github.com/oracle/oci-go-sdk/v65/oda"
 	odav1beta1 "github.com/oracle/oci-service-operator/api/oda/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultDigitalAssistantServiceClient struct {
 var _ DigitalAssistantServiceClient = defaultDigitalAssistantServiceClient{}
 
 var newDigitalAssistantServiceClient = func(manager *DigitalAssistantServiceManager) DigitalAssistantServiceClient {
-	sdkClient, err := odasdk.NewManagementClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, odasdk.NewManagementClientWithConfigurationProvider)
 	hooks := newDigitalAssistantRuntimeHooks(manager, sdkClient)
 	config := buildDigitalAssistantGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	odasdk "github.com/oracle/oci-go-sdk/v65/oda"
 	odav1beta1 "github.com/oracle/oci-service-operator/api/oda/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultImportedPackageServiceClient struct {
 var _ ImportedPackageServiceClient = defaultImportedPackageServiceClient{}
 
 var newImportedPackageServiceClient = func(manager *ImportedPackageServiceManager) ImportedPackageServiceClient {
-	sdkClient, err := odasdk.NewOdapackageClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, odasdk.NewOdapackageClientWithConfigurationProvider)
 	hooks := newImportedPackageRuntimeHooks(manager, sdkClient)
 	config := buildImportedPackageGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -19,6 +19,7 @@ import (
 	odav1beta1 "github.com/oracle/oci-service-operator/api/oda/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -74,7 +75,7 @@ func newOdaInstanceAttachmentSDKClient(manager *OdaInstanceAttachmentServiceMana
 	if manager == nil {
 		return nil, fmt.Errorf("OdaInstanceAttachment service manager is nil")
 	}
-	client, err := odasdk.NewOdaClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, odasdk.NewOdaClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
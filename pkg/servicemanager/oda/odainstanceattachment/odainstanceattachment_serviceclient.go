@@ -13,6 +13,7 @@ import (
 
 	odasdk "github.com/oracle/oci-go-sdk/v65/oda"
 	odav1beta1 "github.com/oracle/oci-service-operator/api/oda/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultOdaInstanceAttachmentServiceClient struct {
 var _ OdaInstanceAttachmentServiceClient = defaultOdaInstanceAttachmentServiceClient{}
 
 var newOdaInstanceAttachmentServiceClient = func(manager *OdaInstanceAttachmentServiceManager) OdaInstanceAttachmentServiceClient {
-	sdkClient, err := odasdk.NewOdaClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, odasdk.NewOdaClientWithConfigurationProvider)
 	hooks := newOdaInstanceAttachmentRuntimeHooks(manager, sdkClient)
 	config := buildOdaInstanceAttachmentGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
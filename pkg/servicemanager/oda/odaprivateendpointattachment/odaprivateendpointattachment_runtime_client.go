@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/common"
 	odasdk "github.com/oracle/oci-go-sdk/v65/oda"
 	odav1beta1 "github.com/oracle/oci-service-operator/api/oda/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
 )
@@ -72,7 +73,7 @@ func newOdaPrivateEndpointAttachmentParentReader(manager *OdaPrivateEndpointAtta
 		return nil, nil
 	}
 
-	client, err := odasdk.NewManagementClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, odasdk.NewManagementClientWithConfigurationProvider)
 	if err != nil {
 		return nil, fmt.Errorf("initialize OdaPrivateEndpointAttachment parent OCI client: %w", err)
 	}
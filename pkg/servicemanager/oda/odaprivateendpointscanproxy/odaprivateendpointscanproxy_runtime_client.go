@@ -18,6 +18,7 @@ import (
 	odav1beta1 "github.com/oracle/oci-service-operator/api/oda/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -62,7 +63,7 @@ func newOdaPrivateEndpointScanProxySDKClient(manager *OdaPrivateEndpointScanProx
 	if manager == nil {
 		return nil, fmt.Errorf("OdaPrivateEndpointScanProxy service manager is nil")
 	}
-	client, err := odasdk.NewManagementClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, odasdk.NewManagementClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
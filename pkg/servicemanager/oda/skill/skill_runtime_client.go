@@ -20,6 +20,7 @@ import (
 	odasdk "github.com/oracle/oci-go-sdk/v65/oda"
 	odav1beta1 "github.com/oracle/oci-service-operator/api/oda/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -97,11 +98,11 @@ func newSkillSDKClient(manager *SkillServiceManager) (skillOCIClient, error) {
 	if manager == nil {
 		return nil, fmt.Errorf("Skill service manager is nil")
 	}
-	managementClient, err := odasdk.NewManagementClientWithConfigurationProvider(manager.Provider)
+	managementClient, err := ociclient.New(manager.Provider, odasdk.NewManagementClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
-	odaClient, err := odasdk.NewOdaClientWithConfigurationProvider(manager.Provider)
+	odaClient, err := ociclient.New(manager.Provider, odasdk.NewOdaClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
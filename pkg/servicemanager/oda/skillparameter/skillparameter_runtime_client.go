@@ -18,6 +18,7 @@ import (
 	odasdk "github.com/oracle/oci-go-sdk/v65/oda"
 	odav1beta1 "github.com/oracle/oci-service-operator/api/oda/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -86,7 +87,7 @@ func newSkillParameterSDKClient(manager *SkillParameterServiceManager) (skillPar
 		return nil, fmt.Errorf("SkillParameter service manager is nil")
 	}
 
-	client, err := odasdk.NewManagementClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, odasdk.NewManagementClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -13,6 +13,7 @@ import (
 
 	onssdk "github.com/oracle/oci-go-sdk/v65/ons"
 	onsv1beta1 "github.com/oracle/oci-service-operator/api/ons/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultSubscriptionServiceClient struct {
 var _ SubscriptionServiceClient = defaultSubscriptionServiceClient{}
 
 var newSubscriptionServiceClient = func(manager *SubscriptionServiceManager) SubscriptionServiceClient {
-	sdkClient, err := onssdk.NewNotificationDataPlaneClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, onssdk.NewNotificationDataPlaneClientWithConfigurationProvider)
 	hooks := newSubscriptionRuntimeHooks(manager, sdkClient)
 	config := buildSubscriptionGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	onssdk "github.com/oracle/oci-go-sdk/v65/ons"
 	onsv1beta1 "github.com/oracle/oci-service-operator/api/ons/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultTopicServiceClient struct {
 var _ TopicServiceClient = defaultTopicServiceClient{}
 
 var newTopicServiceClient = func(manager *TopicServiceManager) TopicServiceClient {
-	sdkClient, err := onssdk.NewNotificationControlPlaneClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, onssdk.NewNotificationControlPlaneClientWithConfigurationProvider)
 	hooks := newTopicRuntimeHooks(manager, sdkClient)
 	config := buildTopicGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
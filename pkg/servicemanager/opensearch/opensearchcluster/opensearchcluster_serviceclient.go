@@ -13,6 +13,7 @@ import (
 
 	opensearchsdk "github.com/oracle/oci-go-sdk/v65/opensearch"
 	opensearchv1beta1 "github.com/oracle/oci-service-operator/api/opensearch/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultOpensearchClusterServiceClient struct {
 var _ OpensearchClusterServiceClient = defaultOpensearchClusterServiceClient{}
 
 var newOpensearchClusterServiceClient = func(manager *OpensearchClusterServiceManager) OpensearchClusterServiceClient {
-	sdkClient, err := opensearchsdk.NewOpensearchClusterClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, opensearchsdk.NewOpensearchClusterClientWithConfigurationProvider)
 	hooks := newOpensearchClusterRuntimeHooks(manager, sdkClient)
 	config := buildOpensearchClusterGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
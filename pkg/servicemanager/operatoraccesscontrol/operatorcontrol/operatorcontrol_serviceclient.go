@@ -13,6 +13,7 @@ import (
 
 	operatoraccesscontrolsdk "github.com/oracle/oci-go-sdk/v65/operatoraccesscontrol"
 	operatoraccesscontrolv1beta1 "github.com/oracle/oci-service-operator/api/operatoraccesscontrol/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultOperatorControlServiceClient struct {
 var _ OperatorControlServiceClient = defaultOperatorControlServiceClient{}
 
 var newOperatorControlServiceClient = func(manager *OperatorControlServiceManager) OperatorControlServiceClient {
-	sdkClient, err := operatoraccesscontrolsdk.NewOperatorControlClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, operatoraccesscontrolsdk.NewOperatorControlClientWithConfigurationProvider)
 	hooks := newOperatorControlRuntimeHooks(manager, sdkClient)
 	config := buildOperatorControlGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
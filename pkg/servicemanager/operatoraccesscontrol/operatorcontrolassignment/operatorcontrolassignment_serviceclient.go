@@ -13,6 +13,7 @@ import (
 
 	operatoraccesscontrolsdk "github.com/oracle/oci-go-sdk/v65/operatoraccesscontrol"
 	operatoraccesscontrolv1beta1 "github.com/oracle/oci-service-operator/api/operatoraccesscontrol/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultOperatorControlAssignmentServiceClient struct {
 var _ OperatorControlAssignmentServiceClient = defaultOperatorControlAssignmentServiceClient{}
 
 var newOperatorControlAssignmentServiceClient = func(manager *OperatorControlAssignmentServiceManager) OperatorControlAssignmentServiceClient {
-	sdkClient, err := operatoraccesscontrolsdk.NewOperatorControlAssignmentClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, operatoraccesscontrolsdk.NewOperatorControlAssignmentClientWithConfigurationProvider)
 	hooks := newOperatorControlAssignmentRuntimeHooks(manager, sdkClient)
 	config := buildOperatorControlAssignmentGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
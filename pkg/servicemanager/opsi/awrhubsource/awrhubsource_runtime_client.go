@@ -19,6 +19,7 @@ import (
 	opsiv1beta1 "github.com/oracle/oci-service-operator/api/opsi/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -108,7 +109,7 @@ func newAwrHubSourceWorkRequestClient(manager *AwrHubSourceServiceManager) (awrH
 	if manager == nil {
 		return nil, fmt.Errorf("%s service manager is nil", awrHubSourceKind)
 	}
-	client, err := opsisdk.NewOperationsInsightsClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, opsisdk.NewOperationsInsightsClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
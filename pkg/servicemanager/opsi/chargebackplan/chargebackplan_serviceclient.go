@@ -13,6 +13,7 @@ import (
 
 	opsisdk "github.com/oracle/oci-go-sdk/v65/opsi"
 	opsiv1beta1 "github.com/oracle/oci-service-operator/api/opsi/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultChargebackPlanServiceClient struct {
 var _ ChargebackPlanServiceClient = defaultChargebackPlanServiceClient{}
 
 var newChargebackPlanServiceClient = func(manager *ChargebackPlanServiceManager) ChargebackPlanServiceClient {
-	sdkClient, err := opsisdk.NewOperationsInsightsClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, opsisdk.NewOperationsInsightsClientWithConfigurationProvider)
 	hooks := newChargebackPlanRuntimeHooks(manager, sdkClient)
 	config := buildChargebackPlanGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -18,6 +18,7 @@ import (
 	opsiv1beta1 "github.com/oracle/oci-service-operator/api/opsi/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -89,7 +90,7 @@ func newChargebackPlanReportRuntimeOCIClient(manager *ChargebackPlanReportServic
 	if manager == nil {
 		return nil, fmt.Errorf("chargeback plan report service manager is nil")
 	}
-	return opsisdk.NewOperationsInsightsClientWithConfigurationProvider(manager.Provider)
+	return ociclient.New(manager.Provider, opsisdk.NewOperationsInsightsClientWithConfigurationProvider)
 }
 
 func configureChargebackPlanReportRuntimeHooks(
@@ -17,6 +17,7 @@ import (
 	opsiv1beta1 "github.com/oracle/oci-service-operator/api/opsi/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -61,7 +62,7 @@ func newEnterpriseManagerBridgeSDKClient(manager *EnterpriseManagerBridgeService
 	if manager == nil {
 		return nil, fmt.Errorf("EnterpriseManagerBridge service manager is nil")
 	}
-	client, err := opsisdk.NewOperationsInsightsClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, opsisdk.NewOperationsInsightsClientWithConfigurationProvider)
 	if err != nil {
 		return nil, fmt.Errorf("initialize EnterpriseManagerBridge OCI client: %w", err)
 	}
@@ -19,6 +19,7 @@ import (
 	opsiv1beta1 "github.com/oracle/oci-service-operator/api/opsi/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -87,7 +88,7 @@ func newExadataInsightOCIClient(manager *ExadataInsightServiceManager) (exadataI
 	if manager == nil {
 		return nil, fmt.Errorf("exadata insight service manager is nil")
 	}
-	client, err := opsisdk.NewOperationsInsightsClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, opsisdk.NewOperationsInsightsClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
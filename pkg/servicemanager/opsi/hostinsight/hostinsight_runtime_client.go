@@ -17,6 +17,7 @@ import (
 	opsiv1beta1 "github.com/oracle/oci-service-operator/api/opsi/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	"github.com/oracle/oci-service-operator/pkg/shared"
@@ -125,7 +126,7 @@ func newHostInsightSDKClient(manager *HostInsightServiceManager) (hostInsightOCI
 	if manager == nil {
 		return nil, fmt.Errorf("%s service manager is nil", hostInsightKind)
 	}
-	client, err := opsisdk.NewOperationsInsightsClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, opsisdk.NewOperationsInsightsClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
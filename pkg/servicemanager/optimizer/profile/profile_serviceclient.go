@@ -13,6 +13,7 @@ import (
 
 	optimizersdk "github.com/oracle/oci-go-sdk/v65/optimizer"
 	optimizerv1beta1 "github.com/oracle/oci-service-operator/api/optimizer/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultProfileServiceClient struct {
 var _ ProfileServiceClient = defaultProfileServiceClient{}
 
 var newProfileServiceClient = func(manager *ProfileServiceManager) ProfileServiceClient {
-	sdkClient, err := optimizersdk.NewOptimizerClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, optimizersdk.NewOptimizerClientWithConfigurationProvider)
 	hooks := newProfileRuntimeHooks(manager, sdkClient)
 	config := buildProfileGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	osmanagementhubsdk "github.com/oracle/oci-go-sdk/v65/osmanagementhub"
 	osmanagementhubv1beta1 "github.com/oracle/oci-service-operator/api/osmanagementhub/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultLifecycleEnvironmentServiceClient struct {
 var _ LifecycleEnvironmentServiceClient = defaultLifecycleEnvironmentServiceClient{}
 
 var newLifecycleEnvironmentServiceClient = func(manager *LifecycleEnvironmentServiceManager) LifecycleEnvironmentServiceClient {
-	sdkClient, err := osmanagementhubsdk.NewLifecycleEnvironmentClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, osmanagementhubsdk.NewLifecycleEnvironmentClientWithConfigurationProvider)
 	hooks := newLifecycleEnvironmentRuntimeHooks(manager, sdkClient)
 	config := buildLifecycleEnvironmentGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -19,6 +19,7 @@ import (
 	osmanagementhubv1beta1 "github.com/oracle/oci-service-operator/api/osmanagementhub/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	"github.com/oracle/oci-service-operator/pkg/shared"
@@ -92,7 +93,7 @@ func newManagedInstanceGroupServiceClientWithOCIClient(
 }
 
 func newManagedInstanceGroupRuntimeServiceClient(manager *ManagedInstanceGroupServiceManager) ManagedInstanceGroupServiceClient {
-	sdkClient, err := osmanagementhubsdk.NewManagedInstanceGroupClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, osmanagementhubsdk.NewManagedInstanceGroupClientWithConfigurationProvider)
 	hooks := newManagedInstanceGroupRuntimeHooks(manager, sdkClient)
 	config := buildManagedInstanceGroupGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
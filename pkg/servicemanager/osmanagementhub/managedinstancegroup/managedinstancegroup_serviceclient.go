@@ -13,6 +13,7 @@ import (
 
 	osmanagementhubsdk "github.com/oracle/oci-go-sdk/v65/osmanagementhub"
 	osmanagementhubv1beta1 "github.com/oracle/oci-service-operator/api/osmanagementhub/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultManagedInstanceGroupServiceClient struct {
 var _ ManagedInstanceGroupServiceClient = defaultManagedInstanceGroupServiceClient{}
 
 var newManagedInstanceGroupServiceClient = func(manager *ManagedInstanceGroupServiceManager) ManagedInstanceGroupServiceClient {
-	sdkClient, err := osmanagementhubsdk.NewManagedInstanceGroupClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, osmanagementhubsdk.NewManagedInstanceGroupClientWithConfigurationProvider)
 	hooks := newManagedInstanceGroupRuntimeHooks(manager, sdkClient)
 	config := buildManagedInstanceGroupGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	osmanagementhubsdk "github.com/oracle/oci-go-sdk/v65/osmanagementhub"
 	osmanagementhubv1beta1 "github.com/oracle/oci-service-operator/api/osmanagementhub/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultManagementStationServiceClient struct {
 var _ ManagementStationServiceClient = defaultManagementStationServiceClient{}
 
 var newManagementStationServiceClient = func(manager *ManagementStationServiceManager) ManagementStationServiceClient {
-	sdkClient, err := osmanagementhubsdk.NewManagementStationClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, osmanagementhubsdk.NewManagementStationClientWithConfigurationProvider)
 	hooks := newManagementStationRuntimeHooks(manager, sdkClient)
 	config := buildManagementStationGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	osmanagementhubsdk "github.com/oracle/oci-go-sdk/v65/osmanagementhub"
 	osmanagementhubv1beta1 "github.com/oracle/oci-service-operator/api/osmanagementhub/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultScheduledJobServiceClient struct {
 var _ ScheduledJobServiceClient = defaultScheduledJobServiceClient{}
 
 var newScheduledJobServiceClient = func(manager *ScheduledJobServiceManager) ScheduledJobServiceClient {
-	sdkClient, err := osmanagementhubsdk.NewScheduledJobClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, osmanagementhubsdk.NewScheduledJobClientWithConfigurationProvider)
 	hooks := newScheduledJobRuntimeHooks(manager, sdkClient)
 	config := buildScheduledJobGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	osmanagementhubsdk "github.com/oracle/oci-go-sdk/v65/osmanagementhub"
 	osmanagementhubv1beta1 "github.com/oracle/oci-service-operator/api/osmanagementhub/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultSoftwareSourceServiceClient struct {
 var _ SoftwareSourceServiceClient = defaultSoftwareSourceServiceClient{}
 
 var newSoftwareSourceServiceClient = func(manager *SoftwareSourceServiceManager) SoftwareSourceServiceClient {
-	sdkClient, err := osmanagementhubsdk.NewSoftwareSourceClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, osmanagementhubsdk.NewSoftwareSourceClientWithConfigurationProvider)
 	hooks := newSoftwareSourceRuntimeHooks(manager, sdkClient)
 	config := buildSoftwareSourceGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -0,0 +1,97 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package servicemanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	shared "github.com/oracle/oci-service-operator/pkg/shared"
+)
+
+// PlannedOperation is an OCI create, update, or delete that a service client
+// skipped in plan mode.
+type PlannedOperation struct {
+	Operation shared.OSOKAsyncPhase `json:"operation"`
+	// OCID is the resource the operation targets. It is empty for a create.
+	OCID string `json:"ocid,omitempty"`
+	// Request is the request body the operation would have sent.
+	Request json.RawMessage `json:"request,omitempty"`
+	// Changes lists the spec fields that differ from the live resource.
+	Changes []PlannedFieldChange `json:"changes,omitempty"`
+}
+
+// PlannedFieldChange is one field that a planned update would change.
+type PlannedFieldChange struct {
+	Path    string `json:"path"`
+	Current any    `json:"current,omitempty"`
+	Desired any    `json:"desired,omitempty"`
+}
+
+// Plan collects the operations planned during one reconcile. See WithPlan.
+type Plan struct {
+	mu         sync.Mutex
+	operations []PlannedOperation
+}
+
+// Record adds operation to the plan.
+func (p *Plan) Record(operation PlannedOperation) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.operations = append(p.operations, operation)
+}
+
+// Operations returns the planned operations in the order they were recorded.
+func (p *Plan) Operations() []PlannedOperation {
+	if p == nil {
+		return nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]PlannedOperation(nil), p.operations...)
+}
+
+// Summary describes the plan in one line, for a status condition.
+func (p *Plan) Summary() string {
+	operations := p.Operations()
+	if len(operations) == 0 {
+		return "Plan mode: no OCI mutations planned"
+	}
+
+	parts := make([]string, 0, len(operations))
+	for _, operation := range operations {
+		part := "would " + string(operation.Operation)
+		if operation.OCID != "" {
+			part += " " + operation.OCID
+		}
+		if len(operation.Changes) > 0 {
+			paths := make([]string, 0, len(operation.Changes))
+			for _, change := range operation.Changes {
+				paths = append(paths, change.Path)
+			}
+			part += fmt.Sprintf(" (%s)", strings.Join(paths, ", "))
+		}
+		parts = append(parts, part)
+	}
+	return "Plan mode: " + strings.Join(parts, "; ")
+}
+
+// MarshalJSON renders the plan as {"operations": [...]}, the structured form
+// recorded in the Planned event.
+func (p *Plan) MarshalJSON() ([]byte, error) {
+	operations := p.Operations()
+	if operations == nil {
+		operations = []PlannedOperation{}
+	}
+	return json.Marshal(struct {
+		Operations []PlannedOperation `json:"operations"`
+	}{Operations: operations})
+}
@@ -19,6 +19,7 @@ import (
 	psqlv1beta1 "github.com/oracle/oci-service-operator/api/psql/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/credhelper"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
 	"github.com/oracle/oci-service-operator/pkg/util"
@@ -64,7 +65,7 @@ func newManualDbSystemServiceClient(manager *DbSystemServiceManager) DbSystemSer
 		return client
 	}
 
-	sdkClient, err := psqlsdk.NewPostgresqlClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, psqlsdk.NewPostgresqlClientWithConfigurationProvider)
 	if err != nil {
 		client.initErr = fmt.Errorf("initialize DbSystem OCI client: %w", err)
 	}
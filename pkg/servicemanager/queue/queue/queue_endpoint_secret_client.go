@@ -16,6 +16,7 @@ import (
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
@@ -34,7 +35,19 @@ type queueEndpointSecretClient struct {
 
 var _ QueueServiceClient = queueEndpointSecretClient{}
 
-func newQueueEndpointSecretClient(manager *QueueServiceManager, delegate QueueServiceClient) QueueServiceClient {
+var _ servicemanager.OSOKOrphanCleaner = (*QueueServiceManager)(nil)
+
+// CleanupOrphaned removes the endpoint secret when a deletion policy leaves the
+// OCI Queue in place, because the secret is not garbage collected with the CR.
+func (c *QueueServiceManager) CleanupOrphaned(ctx context.Context, obj runtime.Object) error {
+	resource, err := c.convert(obj)
+	if err != nil {
+		return err
+	}
+	return newQueueEndpointSecretClient(c, c.client).deleteEndpointSecret(ctx, resource)
+}
+
+func newQueueEndpointSecretClient(manager *QueueServiceManager, delegate QueueServiceClient) queueEndpointSecretClient {
 	client := queueEndpointSecretClient{
 		delegate:         delegate,
 		credentialClient: manager.CredentialClient,
@@ -109,8 +122,12 @@ func (c queueEndpointSecretClient) syncEndpointSecret(ctx context.Context, resou
 	if err != nil {
 		return err
 	}
+	data, err = servicemanager.RenderConnectionSecretData(resource.Spec.WriteConnectionSecretToRef, data)
+	if err != nil {
+		return err
+	}
 
-	currentRecord, err := c.secretRecordReader.GetSecretRecord(ctx, resource.Name, resource.Namespace)
+	currentRecord, err := c.secretRecordReader.GetSecretRecord(ctx, queueEndpointSecretName(resource), resource.Namespace)
 	if err == nil {
 		return c.syncExistingEndpointSecret(ctx, resource, currentRecord, data)
 	}
@@ -126,12 +143,12 @@ func (c queueEndpointSecretClient) createEndpointSecret(
 	ownerLabels map[string]string,
 	data map[string][]byte,
 ) error {
-	_, err := c.credentialClient.CreateSecret(ctx, resource.Name, resource.Namespace, ownerLabels, data)
+	_, err := c.credentialClient.CreateSecret(ctx, queueEndpointSecretName(resource), resource.Namespace, ownerLabels, data)
 	switch {
 	case err == nil:
 		return nil
 	case apierrors.IsAlreadyExists(err):
-		currentRecord, rereadErr := c.secretRecordReader.GetSecretRecord(ctx, resource.Name, resource.Namespace)
+		currentRecord, rereadErr := c.secretRecordReader.GetSecretRecord(ctx, queueEndpointSecretName(resource), resource.Namespace)
 		if rereadErr != nil {
 			return rereadErr
 		}
@@ -151,11 +168,19 @@ func (c queueEndpointSecretClient) syncExistingEndpointSecret(
 	if err != nil {
 		return err
 	}
+	ownerLabels, err := queueEndpointSecretLabels(resource)
+	if err != nil {
+		return err
+	}
 	if owned {
-		if reflect.DeepEqual(currentRecord.Data, desiredData) {
+		var labels map[string]string
+		if !servicemanager.ConnectionSecretLabelsCurrent(currentRecord.Labels, ownerLabels) {
+			labels = mergeQueueEndpointSecretLabels(currentRecord.Labels, ownerLabels)
+		}
+		if labels == nil && reflect.DeepEqual(currentRecord.Data, desiredData) {
 			return nil
 		}
-		_, err = c.guardedSecretMutator.UpdateSecretIfCurrent(ctx, resource.Name, resource.Namespace, currentRecord, nil, desiredData)
+		_, err = c.guardedSecretMutator.UpdateSecretIfCurrent(ctx, queueEndpointSecretName(resource), resource.Namespace, currentRecord, labels, desiredData)
 		return err
 	}
 
@@ -164,10 +189,10 @@ func (c queueEndpointSecretClient) syncExistingEndpointSecret(
 		return err
 	}
 	if !adoptable {
-		return fmt.Errorf("queue endpoint secret %s/%s is not owned by Queue UID %q", resource.Namespace, resource.Name, resource.UID)
+		return fmt.Errorf("queue endpoint secret %s/%s is not owned by Queue UID %q", resource.Namespace, queueEndpointSecretName(resource), resource.UID)
 	}
 
-	_, err = c.guardedSecretMutator.UpdateSecretIfCurrent(ctx, resource.Name, resource.Namespace, currentRecord, adoptionLabels, desiredData)
+	_, err = c.guardedSecretMutator.UpdateSecretIfCurrent(ctx, queueEndpointSecretName(resource), resource.Namespace, currentRecord, adoptionLabels, desiredData)
 	return err
 }
 
@@ -191,7 +216,7 @@ func (c queueEndpointSecretClient) deleteEndpointSecret(ctx context.Context, res
 		return fmt.Errorf("queue endpoint secret ownership checks require guarded secret mutations")
 	}
 
-	record, err := c.secretRecordReader.GetSecretRecord(ctx, resource.Name, resource.Namespace)
+	record, err := c.secretRecordReader.GetSecretRecord(ctx, queueEndpointSecretName(resource), resource.Namespace)
 	switch {
 	case apierrors.IsNotFound(err):
 		return nil
@@ -207,7 +232,7 @@ func (c queueEndpointSecretClient) deleteEndpointSecret(ctx context.Context, res
 		return nil
 	}
 
-	_, err = c.guardedSecretMutator.DeleteSecretIfCurrent(ctx, resource.Name, resource.Namespace, record)
+	_, err = c.guardedSecretMutator.DeleteSecretIfCurrent(ctx, queueEndpointSecretName(resource), resource.Namespace, record)
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
@@ -219,9 +244,15 @@ func queueEndpointSecretLabels(resource *queuev1beta1.Queue) (map[string]string,
 	if err != nil {
 		return nil, err
 	}
-	return map[string]string{
+	return servicemanager.ConnectionSecretLabels(resource.Spec.WriteConnectionSecretToRef, map[string]string{
 		queueEndpointSecretOwnerUIDLabel: ownerUID,
-	}, nil
+	}), nil
+}
+
+// queueEndpointSecretName returns the name of the Secret the endpoint details are
+// written to, spec.writeConnectionSecretToRef.name when set.
+func queueEndpointSecretName(resource *queuev1beta1.Queue) string {
+	return servicemanager.ConnectionSecretName(resource.Spec.WriteConnectionSecretToRef, resource.Name)
 }
 
 func queueOwnsEndpointSecret(resource *queuev1beta1.Queue, labels map[string]string) (bool, error) {
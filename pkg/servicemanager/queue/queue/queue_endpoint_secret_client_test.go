@@ -7,6 +7,7 @@ package queue
 
 import (
 	"context"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -319,6 +320,81 @@ func TestQueueEndpointSecretClientCreatesSecretAfterActiveReconcile(t *testing.T
 	requireQueueSecretData(t, createdData, testQueueEndpoint, "secret")
 }
 
+func TestQueueEndpointSecretClientWritesConfiguredConnectionSecret(t *testing.T) {
+	t.Parallel()
+
+	credClient := &fakeQueueCredentialClient{}
+	var createdName string
+	var createdLabels map[string]string
+	var createdData map[string][]byte
+	credClient.getSecretFn = func(_ context.Context, name string, _ string) (map[string][]byte, error) {
+		if name != "queue-conn" {
+			t.Fatalf("GetSecret() name = %q, want queue-conn", name)
+		}
+		return nil, apierrors.NewNotFound(v1.Resource("secret"), name)
+	}
+	credClient.createSecretFn = func(_ context.Context, name string, _ string, labels map[string]string, data map[string][]byte) (bool, error) {
+		createdName = name
+		createdLabels = cloneQueueSecretLabels(labels)
+		createdData = cloneQueueSecretData(data)
+		return true, nil
+	}
+
+	client := newActiveQueueEndpointSecretClient(credClient)
+	resource := newTestQueueResource()
+	resource.Spec.WriteConnectionSecretToRef = &shared.ConnectionSecretTarget{
+		Name:   "queue-conn",
+		Labels: map[string]string{"team": "payments"},
+		Keys:   map[string]string{"QUEUE_URL": "{{ .endpoint }}/20210201/queues"},
+	}
+
+	response, err := client.CreateOrUpdate(context.Background(), resource, ctrl.Request{})
+	requireQueueCreateOrUpdateSuccess(t, response, err, "secret sync")
+	if createdName != "queue-conn" {
+		t.Fatalf("CreateSecret() name = %q, want queue-conn", createdName)
+	}
+	requireOwnedQueueSecretLabels(t, createdLabels, testQueueUID)
+	if createdLabels["team"] != "payments" {
+		t.Fatalf("secret labels = %v, want the configured team label", createdLabels)
+	}
+	want := map[string][]byte{"QUEUE_URL": []byte(testQueueEndpoint + "/20210201/queues")}
+	if !reflect.DeepEqual(createdData, want) {
+		t.Fatalf("secret data = %v, want %v", createdData, want)
+	}
+}
+
+func TestQueueEndpointSecretClientUpdatesConfiguredLabels(t *testing.T) {
+	t.Parallel()
+
+	credClient := &fakeQueueCredentialClient{
+		defaultSecretLabels: ownedQueueEndpointSecretLabels(testQueueUID),
+	}
+	var updatedLabels map[string]string
+	credClient.getSecretFn = func(_ context.Context, name string, namespace string) (map[string][]byte, error) {
+		requireQueueSecretTarget(t, "GetSecret", name, namespace)
+		return map[string][]byte{"endpoint": []byte(testQueueEndpoint)}, nil
+	}
+	credClient.updateSecretIfCurrentFn = func(_ context.Context, name string, namespace string, _ credhelper.SecretRecord, labels map[string]string, _ map[string][]byte) (bool, error) {
+		requireQueueSecretTarget(t, "UpdateSecret", name, namespace)
+		updatedLabels = cloneQueueSecretLabels(labels)
+		return true, nil
+	}
+
+	client := newActiveQueueEndpointSecretClient(credClient)
+	resource := newTestQueueResource()
+	resource.Spec.WriteConnectionSecretToRef = &shared.ConnectionSecretTarget{
+		Labels: map[string]string{"team": "payments"},
+	}
+
+	response, err := client.CreateOrUpdate(context.Background(), resource, ctrl.Request{})
+	requireQueueCreateOrUpdateSuccess(t, response, err, "a label update")
+	assertQueueCredentialCalls(t, credClient, queueSecretCallExpectation{get: true, update: true})
+	requireOwnedQueueSecretLabels(t, updatedLabels, testQueueUID)
+	if updatedLabels["team"] != "payments" {
+		t.Fatalf("updated labels = %v, want the configured team label", updatedLabels)
+	}
+}
+
 func TestQueueEndpointSecretClientSkipsSecretUpdateWhenExistingDataMatches(t *testing.T) {
 	t.Parallel()
 
@@ -602,3 +678,34 @@ func TestQueueManagerInstallsExplicitRuntimePath(t *testing.T) {
 		t.Fatalf("overlay delegate type = %T, want defaultQueueServiceClient", overlayClient.delegate)
 	}
 }
+
+func TestQueueManagerCleanupOrphanedDeletesOwnedSecretWithoutOCIDelete(t *testing.T) {
+	t.Parallel()
+
+	credClient := &fakeQueueCredentialClient{}
+	credClient.getSecretRecordFn = func(_ context.Context, name string, namespace string) (credhelper.SecretRecord, error) {
+		requireQueueSecretTarget(t, "GetSecret", name, namespace)
+		return credhelper.SecretRecord{
+			UID:    testQueueSecretUID,
+			Labels: ownedQueueEndpointSecretLabels(testQueueUID),
+		}, nil
+	}
+
+	manager := NewQueueServiceManager(
+		common.NewRawConfigurationProvider("", "", "", "", "", nil),
+		credClient,
+		nil,
+		loggerutil.OSOKLogger{Logger: ctrl.Log.WithName("test")},
+		nil,
+	).WithClient(fakeQueueServiceClient{
+		deleteFn: func(context.Context, *queuev1beta1.Queue) (bool, error) {
+			t.Fatal("Delete() should not be called when the Queue is orphaned")
+			return false, nil
+		},
+	})
+
+	if err := manager.CleanupOrphaned(context.Background(), newTestQueueResource()); err != nil {
+		t.Fatalf("CleanupOrphaned() error = %v", err)
+	}
+	assertQueueCredentialCalls(t, credClient, queueSecretCallExpectation{get: true, delete: true})
+}
@@ -0,0 +1,101 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package queue
+
+import (
+	"context"
+	"testing"
+
+	queuesdk "github.com/oracle/oci-go-sdk/v65/queue"
+	queuev1beta1 "github.com/oracle/oci-service-operator/api/queue/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime/fakeoci"
+	shared "github.com/oracle/oci-service-operator/pkg/shared"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func newFakeOCIQueueClient(service *fakeoci.Service) *fakeQueueOCIClient {
+	return &fakeQueueOCIClient{
+		createFn:         fakeoci.Handler[queuesdk.CreateQueueRequest, queuesdk.CreateQueueResponse](service),
+		getFn:            fakeoci.Handler[queuesdk.GetQueueRequest, queuesdk.GetQueueResponse](service),
+		listFn:           fakeoci.Handler[queuesdk.ListQueuesRequest, queuesdk.ListQueuesResponse](service),
+		updateFn:         fakeoci.Handler[queuesdk.UpdateQueueRequest, queuesdk.UpdateQueueResponse](service),
+		deleteFn:         fakeoci.Handler[queuesdk.DeleteQueueRequest, queuesdk.DeleteQueueResponse](service),
+		getWorkRequestFn: fakeoci.Handler[queuesdk.GetWorkRequestRequest, queuesdk.GetWorkRequestResponse](service),
+	}
+}
+
+func reconcileFakeOCIQueue(t *testing.T, manager *QueueServiceManager, resource *queuev1beta1.Queue) {
+	t.Helper()
+	for attempt := 0; attempt < 10; attempt++ {
+		response, err := manager.CreateOrUpdate(context.Background(), resource, ctrl.Request{})
+		if err != nil {
+			t.Fatalf("CreateOrUpdate() error = %v", err)
+		}
+		if !response.ShouldRequeue {
+			return
+		}
+	}
+	t.Fatal("CreateOrUpdate() still requeues after 10 reconciles")
+}
+
+func TestQueueRuntimeCreateDriftAndDeleteAgainstFakeOCI(t *testing.T) {
+	service := fakeoci.New(fakeoci.Options{
+		Lifecycle:       fakeoci.Lifecycle{Provisioning: "CREATING"},
+		WorkRequests:    true,
+		WorkRequestStep: 50,
+		ForgetDeleted:   true,
+	})
+	manager := newQueueTestManager(newFakeOCIQueueClient(service))
+	resource := makeSpecQueue()
+
+	reconcileFakeOCIQueue(t, manager, resource)
+	id := string(resource.Status.OsokStatus.Ocid)
+	if live, ok := service.Resource(id); !ok || live["lifecycleState"] != "ACTIVE" {
+		t.Fatalf("fake queue %q = %v, want ACTIVE", id, live)
+	}
+	if got := service.Calls("GetWorkRequest"); got < 2 {
+		t.Fatalf("Calls(GetWorkRequest) = %d, want the create work request polled to completion", got)
+	}
+	if resource.Status.OsokStatus.Async.Current != nil {
+		t.Fatalf("status.async.current = %+v, want it cleared once the queue is ACTIVE", resource.Status.OsokStatus.Async.Current)
+	}
+	requireQueueCondition(t, resource, shared.Active)
+
+	if err := service.Mutate(id, func(values map[string]any) { values["visibilityInSeconds"] = 90 }); err != nil {
+		t.Fatalf("Mutate() error = %v", err)
+	}
+	reconcileFakeOCIQueue(t, manager, resource)
+	if got := service.Calls("UpdateQueue"); got != 1 {
+		t.Fatalf("Calls(UpdateQueue) = %d, want one update to correct the drift", got)
+	}
+	if live, _ := service.Resource(id); live["visibilityInSeconds"] != float64(30) {
+		t.Fatalf("fake queue visibilityInSeconds = %v, want the spec value 30", live["visibilityInSeconds"])
+	}
+
+	for attempt := 0; ; attempt++ {
+		deleted, err := manager.Delete(context.Background(), resource)
+		if err != nil {
+			t.Fatalf("Delete() error = %v", err)
+		}
+		if deleted {
+			break
+		}
+		if attempt == 10 {
+			t.Fatal("Delete() is still pending after 10 attempts")
+		}
+	}
+	if _, ok := service.Resource(id); ok {
+		t.Fatalf("fake queue %s still exists after delete", id)
+	}
+}
+
+func requireQueueCondition(t *testing.T, resource *queuev1beta1.Queue, want shared.OSOKConditionType) {
+	t.Helper()
+	conditions := resource.Status.OsokStatus.Conditions
+	if len(conditions) == 0 || conditions[len(conditions)-1].Type != want {
+		t.Fatalf("status conditions = %+v, want trailing %s", conditions, want)
+	}
+}
@@ -16,6 +16,7 @@ import (
 	queuev1beta1 "github.com/oracle/oci-service-operator/api/queue/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
 	"github.com/oracle/oci-service-operator/pkg/util"
@@ -72,7 +73,7 @@ func newQueueGeneratedRuntimeOverlayClient(
 		return overlay
 	}
 
-	sdkClient, err := queuesdk.NewQueueAdminClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, queuesdk.NewQueueAdminClientWithConfigurationProvider)
 	overlay.client = sdkClient
 	if err != nil && overlay.initErr == nil {
 		overlay.initErr = fmt.Errorf("initialize Queue OCI client: %w", err)
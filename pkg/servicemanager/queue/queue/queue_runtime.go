@@ -17,6 +17,7 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/common"
 	queuesdk "github.com/oracle/oci-go-sdk/v65/queue"
 	queuev1beta1 "github.com/oracle/oci-service-operator/api/queue/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
 	"github.com/oracle/oci-service-operator/pkg/util"
@@ -62,7 +63,7 @@ func newQueueWorkRequestClient(manager *QueueServiceManager) (queueWorkRequestCl
 	if manager == nil {
 		return nil, fmt.Errorf("Queue service manager is nil")
 	}
-	client, err := queuesdk.NewQueueAdminClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, queuesdk.NewQueueAdminClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -13,6 +13,7 @@ import (
 
 	queuesdk "github.com/oracle/oci-go-sdk/v65/queue"
 	queuev1beta1 "github.com/oracle/oci-service-operator/api/queue/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultQueueServiceClient struct {
 var _ QueueServiceClient = defaultQueueServiceClient{}
 
 var newQueueServiceClient = func(manager *QueueServiceManager) QueueServiceClient {
-	sdkClient, err := queuesdk.NewQueueAdminClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, queuesdk.NewQueueAdminClientWithConfigurationProvider)
 	hooks := newQueueRuntimeHooks(manager, sdkClient)
 	config := buildQueueGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
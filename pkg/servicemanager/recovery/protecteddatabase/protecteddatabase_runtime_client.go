@@ -21,6 +21,7 @@ import (
 	"github.com/oracle/oci-service-operator/pkg/credhelper"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	"github.com/oracle/oci-service-operator/pkg/shared"
@@ -28,6 +29,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
@@ -88,7 +90,7 @@ func newProtectedDatabaseSDKClient(manager *ProtectedDatabaseServiceManager) (pr
 	if manager == nil {
 		return nil, fmt.Errorf("ProtectedDatabase service manager is nil")
 	}
-	client, err := recoverysdk.NewDatabaseRecoveryClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, recoverysdk.NewDatabaseRecoveryClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -1051,6 +1053,18 @@ type protectedDatabasePasswordTrackingClient struct {
 	credentialClient credhelper.CredentialClient
 }
 
+var _ servicemanager.OSOKOrphanCleaner = (*ProtectedDatabaseServiceManager)(nil)
+
+// CleanupOrphaned removes the password tracking secret when a deletion policy
+// leaves the OCI ProtectedDatabase in place.
+func (c *ProtectedDatabaseServiceManager) CleanupOrphaned(ctx context.Context, obj runtime.Object) error {
+	resource, err := c.convert(obj)
+	if err != nil {
+		return err
+	}
+	return protectedDatabasePasswordTrackingClient{credentialClient: c.CredentialClient}.deletePasswordStateSecret(ctx, resource)
+}
+
 func (c protectedDatabasePasswordTrackingClient) CreateOrUpdate(
 	ctx context.Context,
 	resource *recoveryv1beta1.ProtectedDatabase,
@@ -13,6 +13,7 @@ import (
 
 	recoverysdk "github.com/oracle/oci-go-sdk/v65/recovery"
 	recoveryv1beta1 "github.com/oracle/oci-service-operator/api/recovery/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultProtectedDatabaseServiceClient struct {
 var _ ProtectedDatabaseServiceClient = defaultProtectedDatabaseServiceClient{}
 
 var newProtectedDatabaseServiceClient = func(manager *ProtectedDatabaseServiceManager) ProtectedDatabaseServiceClient {
-	sdkClient, err := recoverysdk.NewDatabaseRecoveryClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, recoverysdk.NewDatabaseRecoveryClientWithConfigurationProvider)
 	hooks := newProtectedDatabaseRuntimeHooks(manager, sdkClient)
 	config := buildProtectedDatabaseGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
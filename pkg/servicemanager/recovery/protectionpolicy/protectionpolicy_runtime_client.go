@@ -16,6 +16,7 @@ import (
 	recoveryv1beta1 "github.com/oracle/oci-service-operator/api/recovery/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -80,7 +81,7 @@ func newProtectionPolicyOCIClient(manager *ProtectionPolicyServiceManager) (prot
 	if manager == nil {
 		return nil, fmt.Errorf("protectionPolicy service manager is nil")
 	}
-	client, err := recoverysdk.NewDatabaseRecoveryClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, recoverysdk.NewDatabaseRecoveryClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
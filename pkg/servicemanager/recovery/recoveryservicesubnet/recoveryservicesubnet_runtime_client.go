@@ -17,6 +17,7 @@ import (
 	recoveryv1beta1 "github.com/oracle/oci-service-operator/api/recovery/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	"github.com/oracle/oci-service-operator/pkg/shared"
@@ -85,7 +86,7 @@ func newRecoveryServiceSubnetWorkRequestClient(manager *RecoveryServiceSubnetSer
 	if manager == nil {
 		return nil, fmt.Errorf("recovery service subnet manager is nil")
 	}
-	client, err := recoverysdk.NewDatabaseRecoveryClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, recoverysdk.NewDatabaseRecoveryClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
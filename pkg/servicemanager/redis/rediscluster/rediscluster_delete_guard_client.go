@@ -15,6 +15,7 @@ import (
 	redissdk "github.com/oracle/oci-go-sdk/v65/redis"
 	redisv1beta1 "github.com/oracle/oci-service-operator/api/redis/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -30,7 +31,7 @@ var _ RedisClusterServiceClient = redisDeleteGuardClient{}
 func newRedisDeleteGuardClient(manager *RedisClusterServiceManager, delegate RedisClusterServiceClient) RedisClusterServiceClient {
 	client := redisDeleteGuardClient{delegate: delegate}
 	client.loadRedisCluster = func(ctx context.Context, clusterID shared.OCID) (*redissdk.RedisCluster, error) {
-		sdkClient, err := redissdk.NewRedisClusterClientWithConfigurationProvider(manager.Provider)
+		sdkClient, err := ociclient.New(manager.Provider, redissdk.NewRedisClusterClientWithConfigurationProvider)
 		if err != nil {
 			return nil, fmt.Errorf("initialize RedisCluster delete guard OCI client: %w", err)
 		}
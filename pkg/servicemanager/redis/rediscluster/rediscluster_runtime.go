@@ -15,6 +15,7 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/common"
 	redissdk "github.com/oracle/oci-go-sdk/v65/redis"
 	redisv1beta1 "github.com/oracle/oci-service-operator/api/redis/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
 	"github.com/oracle/oci-service-operator/pkg/util"
@@ -60,7 +61,7 @@ func newRedisWorkRequestClient(manager *RedisClusterServiceManager) (redisWorkRe
 	if manager == nil {
 		return nil, fmt.Errorf("RedisCluster service manager is nil")
 	}
-	client, err := redissdk.NewRedisClusterClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, redissdk.NewRedisClusterClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
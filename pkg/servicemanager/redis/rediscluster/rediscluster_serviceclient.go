@@ -13,6 +13,7 @@ import (
 
 	redissdk "github.com/oracle/oci-go-sdk/v65/redis"
 	redisv1beta1 "github.com/oracle/oci-service-operator/api/redis/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultRedisClusterServiceClient struct {
 var _ RedisClusterServiceClient = defaultRedisClusterServiceClient{}
 
 var newRedisClusterServiceClient = func(manager *RedisClusterServiceManager) RedisClusterServiceClient {
-	sdkClient, err := redissdk.NewRedisClusterClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, redissdk.NewRedisClusterClientWithConfigurationProvider)
 	hooks := newRedisClusterRuntimeHooks(manager, sdkClient)
 	config := buildRedisClusterGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
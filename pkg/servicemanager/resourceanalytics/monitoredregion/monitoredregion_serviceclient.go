@@ -13,6 +13,7 @@ import (
 
 	resourceanalyticssdk "github.com/oracle/oci-go-sdk/v65/resourceanalytics"
 	resourceanalyticsv1beta1 "github.com/oracle/oci-service-operator/api/resourceanalytics/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultMonitoredRegionServiceClient struct {
 var _ MonitoredRegionServiceClient = defaultMonitoredRegionServiceClient{}
 
 var newMonitoredRegionServiceClient = func(manager *MonitoredRegionServiceManager) MonitoredRegionServiceClient {
-	sdkClient, err := resourceanalyticssdk.NewMonitoredRegionClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, resourceanalyticssdk.NewMonitoredRegionClientWithConfigurationProvider)
 	hooks := newMonitoredRegionRuntimeHooks(manager, sdkClient)
 	config := buildMonitoredRegionGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -19,6 +19,7 @@ import (
 	resourceanalyticssdk "github.com/oracle/oci-go-sdk/v65/resourceanalytics"
 	resourceanalyticsv1beta1 "github.com/oracle/oci-service-operator/api/resourceanalytics/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -81,7 +82,7 @@ func newResourceAnalyticsInstanceOCIClient(manager *ResourceAnalyticsInstanceSer
 	if manager == nil {
 		return nil, fmt.Errorf("%s service manager is nil", resourceAnalyticsInstanceKind)
 	}
-	client, err := resourceanalyticssdk.NewResourceAnalyticsInstanceClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, resourceanalyticssdk.NewResourceAnalyticsInstanceClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
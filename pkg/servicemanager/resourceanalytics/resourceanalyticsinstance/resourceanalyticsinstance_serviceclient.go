@@ -13,6 +13,7 @@ import (
 
 	resourceanalyticssdk "github.com/oracle/oci-go-sdk/v65/resourceanalytics"
 	resourceanalyticsv1beta1 "github.com/oracle/oci-service-operator/api/resourceanalytics/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultResourceAnalyticsInstanceServiceClient struct {
 var _ ResourceAnalyticsInstanceServiceClient = defaultResourceAnalyticsInstanceServiceClient{}
 
 var newResourceAnalyticsInstanceServiceClient = func(manager *ResourceAnalyticsInstanceServiceManager) ResourceAnalyticsInstanceServiceClient {
-	sdkClient, err := resourceanalyticssdk.NewResourceAnalyticsInstanceClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, resourceanalyticssdk.NewResourceAnalyticsInstanceClientWithConfigurationProvider)
 	hooks := newResourceAnalyticsInstanceRuntimeHooks(manager, sdkClient)
 	config := buildResourceAnalyticsInstanceGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	resourcemanagersdk "github.com/oracle/oci-go-sdk/v65/resourcemanager"
 	resourcemanagerv1beta1 "github.com/oracle/oci-service-operator/api/resourcemanager/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultPrivateEndpointServiceClient struct {
 var _ PrivateEndpointServiceClient = defaultPrivateEndpointServiceClient{}
 
 var newPrivateEndpointServiceClient = func(manager *PrivateEndpointServiceManager) PrivateEndpointServiceClient {
-	sdkClient, err := resourcemanagersdk.NewResourceManagerClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, resourcemanagersdk.NewResourceManagerClientWithConfigurationProvider)
 	hooks := newPrivateEndpointRuntimeHooks(manager, sdkClient)
 	config := buildPrivateEndpointGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
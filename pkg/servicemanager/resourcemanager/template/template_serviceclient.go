@@ -13,6 +13,7 @@ import (
 
 	resourcemanagersdk "github.com/oracle/oci-go-sdk/v65/resourcemanager"
 	resourcemanagerv1beta1 "github.com/oracle/oci-service-operator/api/resourcemanager/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultTemplateServiceClient struct {
 var _ TemplateServiceClient = defaultTemplateServiceClient{}
 
 var newTemplateServiceClient = func(manager *TemplateServiceManager) TemplateServiceClient {
-	sdkClient, err := resourcemanagersdk.NewResourceManagerClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, resourcemanagersdk.NewResourceManagerClientWithConfigurationProvider)
 	hooks := newTemplateRuntimeHooks(manager, sdkClient)
 	config := buildTemplateGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
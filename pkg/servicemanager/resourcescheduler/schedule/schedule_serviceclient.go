@@ -13,6 +13,7 @@ import (
 
 	resourceschedulersdk "github.com/oracle/oci-go-sdk/v65/resourcescheduler"
 	resourceschedulerv1beta1 "github.com/oracle/oci-service-operator/api/resourcescheduler/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultScheduleServiceClient struct {
 var _ ScheduleServiceClient = defaultScheduleServiceClient{}
 
 var newScheduleServiceClient = func(manager *ScheduleServiceManager) ScheduleServiceClient {
-	sdkClient, err := resourceschedulersdk.NewScheduleClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, resourceschedulersdk.NewScheduleClientWithConfigurationProvider)
 	hooks := newScheduleRuntimeHooks(manager, sdkClient)
 	config := buildScheduleGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -19,6 +19,7 @@ import (
 	"github.com/oracle/oci-service-operator/pkg/credhelper"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -63,7 +64,7 @@ func newServiceConnectorWorkRequestClient(manager *ServiceConnectorServiceManage
 	if manager == nil {
 		return nil, fmt.Errorf("ServiceConnector service manager is nil")
 	}
-	client, err := schsdk.NewServiceConnectorClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, schsdk.NewServiceConnectorClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -13,6 +13,7 @@ import (
 
 	schsdk "github.com/oracle/oci-go-sdk/v65/sch"
 	schv1beta1 "github.com/oracle/oci-service-operator/api/sch/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultServiceConnectorServiceClient struct {
 var _ ServiceConnectorServiceClient = defaultServiceConnectorServiceClient{}
 
 var newServiceConnectorServiceClient = func(manager *ServiceConnectorServiceManager) ServiceConnectorServiceClient {
-	sdkClient, err := schsdk.NewServiceConnectorClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, schsdk.NewServiceConnectorClientWithConfigurationProvider)
 	hooks := newServiceConnectorRuntimeHooks(manager, sdkClient)
 	config := buildServiceConnectorGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	securityattributesdk "github.com/oracle/oci-go-sdk/v65/securityattribute"
 	securityattributev1beta1 "github.com/oracle/oci-service-operator/api/securityattribute/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultSecurityAttributeNamespaceServiceClient struct {
 var _ SecurityAttributeNamespaceServiceClient = defaultSecurityAttributeNamespaceServiceClient{}
 
 var newSecurityAttributeNamespaceServiceClient = func(manager *SecurityAttributeNamespaceServiceManager) SecurityAttributeNamespaceServiceClient {
-	sdkClient, err := securityattributesdk.NewSecurityAttributeClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, securityattributesdk.NewSecurityAttributeClientWithConfigurationProvider)
 	hooks := newSecurityAttributeNamespaceRuntimeHooks(manager, sdkClient)
 	config := buildSecurityAttributeNamespaceGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
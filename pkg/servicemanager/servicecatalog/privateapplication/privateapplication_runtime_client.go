@@ -20,6 +20,7 @@ import (
 	servicecatalogv1beta1 "github.com/oracle/oci-service-operator/api/servicecatalog/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -98,7 +99,7 @@ func privateApplicationPackageClientForManager(
 	if manager == nil {
 		return nil, nil
 	}
-	client, err := servicecatalogsdk.NewServiceCatalogClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, servicecatalogsdk.NewServiceCatalogClientWithConfigurationProvider)
 	if err != nil {
 		return nil, fmt.Errorf("initialize PrivateApplication package OCI client: %w", err)
 	}
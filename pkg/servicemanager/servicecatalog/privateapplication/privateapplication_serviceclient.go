@@ -13,6 +13,7 @@ import (
 
 	servicecatalogsdk "github.com/oracle/oci-go-sdk/v65/servicecatalog"
 	servicecatalogv1beta1 "github.com/oracle/oci-service-operator/api/servicecatalog/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultPrivateApplicationServiceClient struct {
 var _ PrivateApplicationServiceClient = defaultPrivateApplicationServiceClient{}
 
 var newPrivateApplicationServiceClient = func(manager *PrivateApplicationServiceManager) PrivateApplicationServiceClient {
-	sdkClient, err := servicecatalogsdk.NewServiceCatalogClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, servicecatalogsdk.NewServiceCatalogClientWithConfigurationProvider)
 	hooks := newPrivateApplicationRuntimeHooks(manager, sdkClient)
 	config := buildPrivateApplicationGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	servicecatalogsdk "github.com/oracle/oci-go-sdk/v65/servicecatalog"
 	servicecatalogv1beta1 "github.com/oracle/oci-service-operator/api/servicecatalog/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultServiceCatalogServiceClient struct {
 var _ ServiceCatalogServiceClient = defaultServiceCatalogServiceClient{}
 
 var newServiceCatalogServiceClient = func(manager *ServiceCatalogServiceManager) ServiceCatalogServiceClient {
-	sdkClient, err := servicecatalogsdk.NewServiceCatalogClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, servicecatalogsdk.NewServiceCatalogClientWithConfigurationProvider)
 	hooks := newServiceCatalogRuntimeHooks(manager, sdkClient)
 	config := buildServiceCatalogGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -1188,7 +1188,7 @@ func projectAlarmConditionStatus(
 		ResourceType:         alarmConditionStringValue(current.ResourceType),
 		MetricName:           alarmConditionStringValue(current.MetricName),
 		ConditionType:        string(current.ConditionType),
-		Conditions:           alarmConditionStatusConditions(current.Conditions),
+		SdkConditions:        alarmConditionStatusConditions(current.Conditions),
 		Status:               string(current.Status),
 		LifecycleState:       string(current.LifecycleState),
 		CompositeType:        alarmConditionStringValue(current.CompositeType),
@@ -1304,7 +1304,7 @@ func alarmConditionStatusMap(current stackmonitoringsdk.AlarmCondition) map[stri
 		"resourceType":         alarmConditionStringValue(current.ResourceType),
 		"metricName":           alarmConditionStringValue(current.MetricName),
 		"conditionType":        string(current.ConditionType),
-		"conditions":           alarmConditionStatusConditions(current.Conditions),
+		"sdkConditions":        alarmConditionStatusConditions(current.Conditions),
 		"sdkStatus":            string(current.Status),
 		"lifecycleState":       string(current.LifecycleState),
 		"compositeType":        alarmConditionStringValue(current.CompositeType),
@@ -1328,8 +1328,8 @@ func alarmConditionFromStatusMap(values map[string]any) (stackmonitoringsdk.Alar
 	if err := json.Unmarshal(payload, &status); err != nil {
 		return stackmonitoringsdk.AlarmCondition{}, false
 	}
-	conditions, err := alarmConditionConditionsFromSpec(status.Conditions)
-	if err != nil && len(status.Conditions) != 0 {
+	conditions, err := alarmConditionConditionsFromSpec(status.SdkConditions)
+	if err != nil && len(status.SdkConditions) != 0 {
 		return stackmonitoringsdk.AlarmCondition{}, false
 	}
 	return stackmonitoringsdk.AlarmCondition{
@@ -16,6 +16,7 @@ import (
 	stackmonitoringsdk "github.com/oracle/oci-go-sdk/v65/stackmonitoring"
 	stackmonitoringv1beta1 "github.com/oracle/oci-service-operator/api/stackmonitoring/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -64,7 +65,7 @@ func newBaselineableMetricSDKClient(manager *BaselineableMetricServiceManager) (
 	if manager == nil {
 		return nil, fmt.Errorf("BaselineableMetric service manager is nil")
 	}
-	client, err := stackmonitoringsdk.NewStackMonitoringClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, stackmonitoringsdk.NewStackMonitoringClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
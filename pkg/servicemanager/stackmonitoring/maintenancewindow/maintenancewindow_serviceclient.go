@@ -13,6 +13,7 @@ import (
 
 	stackmonitoringsdk "github.com/oracle/oci-go-sdk/v65/stackmonitoring"
 	stackmonitoringv1beta1 "github.com/oracle/oci-service-operator/api/stackmonitoring/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultMaintenanceWindowServiceClient struct {
 var _ MaintenanceWindowServiceClient = defaultMaintenanceWindowServiceClient{}
 
 var newMaintenanceWindowServiceClient = func(manager *MaintenanceWindowServiceManager) MaintenanceWindowServiceClient {
-	sdkClient, err := stackmonitoringsdk.NewStackMonitoringClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, stackmonitoringsdk.NewStackMonitoringClientWithConfigurationProvider)
 	hooks := newMaintenanceWindowRuntimeHooks(manager, sdkClient)
 	config := buildMaintenanceWindowGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	stackmonitoringsdk "github.com/oracle/oci-go-sdk/v65/stackmonitoring"
 	stackmonitoringv1beta1 "github.com/oracle/oci-service-operator/api/stackmonitoring/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultMonitoredResourceServiceClient struct {
 var _ MonitoredResourceServiceClient = defaultMonitoredResourceServiceClient{}
 
 var newMonitoredResourceServiceClient = func(manager *MonitoredResourceServiceManager) MonitoredResourceServiceClient {
-	sdkClient, err := stackmonitoringsdk.NewStackMonitoringClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, stackmonitoringsdk.NewStackMonitoringClientWithConfigurationProvider)
 	hooks := newMonitoredResourceRuntimeHooks(manager, sdkClient)
 	config := buildMonitoredResourceGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
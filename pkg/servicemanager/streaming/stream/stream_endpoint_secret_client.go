@@ -15,9 +15,11 @@ import (
 	streamingsdk "github.com/oracle/oci-go-sdk/v65/streaming"
 	streamingv1beta1 "github.com/oracle/oci-service-operator/api/streaming/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/credhelper"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
@@ -43,6 +45,18 @@ type streamEndpointSecretClient struct {
 
 var _ StreamServiceClient = streamEndpointSecretClient{}
 
+var _ servicemanager.OSOKOrphanCleaner = (*StreamServiceManager)(nil)
+
+// CleanupOrphaned removes the endpoint secret when a deletion policy leaves the
+// OCI Stream in place, because the secret is not garbage collected with the CR.
+func (c *StreamServiceManager) CleanupOrphaned(ctx context.Context, obj runtime.Object) error {
+	resource, err := c.convert(obj)
+	if err != nil {
+		return err
+	}
+	return newStreamEndpointSecretClient(c, c.client).deleteEndpointSecret(ctx, resource)
+}
+
 func appendStreamEndpointSecretRuntimeWrapper(manager *StreamServiceManager, hooks *StreamRuntimeHooks) {
 	if manager == nil || hooks == nil {
 		return
@@ -53,12 +67,12 @@ func appendStreamEndpointSecretRuntimeWrapper(manager *StreamServiceManager, hoo
 	})
 }
 
-func newStreamEndpointSecretClient(manager *StreamServiceManager, delegate StreamServiceClient) StreamServiceClient {
+func newStreamEndpointSecretClient(manager *StreamServiceManager, delegate StreamServiceClient) streamEndpointSecretClient {
 	client := streamEndpointSecretClient{
 		delegate:         delegate,
 		credentialClient: manager.CredentialClient,
 		loadStream: func(ctx context.Context, streamID shared.OCID) (*streamingsdk.Stream, error) {
-			sdkClient, err := streamingsdk.NewStreamAdminClientWithConfigurationProvider(manager.Provider)
+			sdkClient, err := ociclient.New(manager.Provider, streamingsdk.NewStreamAdminClientWithConfigurationProvider)
 			if err != nil {
 				return nil, fmt.Errorf("initialize Stream endpoint secret OCI client: %w", err)
 			}
@@ -156,8 +170,12 @@ func (c streamEndpointSecretClient) syncEndpointSecret(ctx context.Context, reso
 	if err != nil {
 		return err
 	}
+	data, err = servicemanager.RenderConnectionSecretData(resource.Spec.WriteConnectionSecretToRef, data)
+	if err != nil {
+		return err
+	}
 
-	currentRecord, err := c.secretRecordReader.GetSecretRecord(ctx, resource.Name, resource.Namespace)
+	currentRecord, err := c.secretRecordReader.GetSecretRecord(ctx, streamEndpointSecretName(resource), resource.Namespace)
 	if err == nil {
 		return c.syncExistingEndpointSecret(ctx, resource, currentRecord, data)
 	}
@@ -173,14 +191,14 @@ func (c streamEndpointSecretClient) createEndpointSecret(
 	ownerLabels map[string]string,
 	data map[string][]byte,
 ) error {
-	_, err := c.credentialClient.CreateSecret(ctx, resource.Name, resource.Namespace, ownerLabels, data)
+	_, err := c.credentialClient.CreateSecret(ctx, streamEndpointSecretName(resource), resource.Namespace, ownerLabels, data)
 	switch {
 	case err == nil:
 		return nil
 	case apierrors.IsAlreadyExists(err):
 		// Manager-backed clients can observe a stale NotFound on the cached read while the direct create
 		// already sees the Secret. Re-read and converge so repeat ACTIVE reconciles stay idempotent.
-		currentRecord, rereadErr := c.secretRecordReader.GetSecretRecord(ctx, resource.Name, resource.Namespace)
+		currentRecord, rereadErr := c.secretRecordReader.GetSecretRecord(ctx, streamEndpointSecretName(resource), resource.Namespace)
 		if rereadErr != nil {
 			return rereadErr
 		}
@@ -200,12 +218,20 @@ func (c streamEndpointSecretClient) syncExistingEndpointSecret(
 	if err != nil {
 		return err
 	}
+	ownerLabels, err := streamEndpointSecretLabels(resource)
+	if err != nil {
+		return err
+	}
 	if owned {
-		if reflect.DeepEqual(currentRecord.Data, desiredData) {
+		var labels map[string]string
+		if !servicemanager.ConnectionSecretLabelsCurrent(currentRecord.Labels, ownerLabels) {
+			labels = mergeEndpointSecretLabels(currentRecord.Labels, ownerLabels)
+		}
+		if labels == nil && reflect.DeepEqual(currentRecord.Data, desiredData) {
 			return nil
 		}
 
-		_, err = c.guardedSecretMutator.UpdateSecretIfCurrent(ctx, resource.Name, resource.Namespace, currentRecord, nil, desiredData)
+		_, err = c.guardedSecretMutator.UpdateSecretIfCurrent(ctx, streamEndpointSecretName(resource), resource.Namespace, currentRecord, labels, desiredData)
 		return err
 	}
 
@@ -217,12 +243,12 @@ func (c streamEndpointSecretClient) syncExistingEndpointSecret(
 		return fmt.Errorf(
 			"stream endpoint secret %s/%s is not owned by Stream UID %q",
 			resource.Namespace,
-			resource.Name,
+			streamEndpointSecretName(resource),
 			resource.UID,
 		)
 	}
 
-	_, err = c.guardedSecretMutator.UpdateSecretIfCurrent(ctx, resource.Name, resource.Namespace, currentRecord, adoptionLabels, desiredData)
+	_, err = c.guardedSecretMutator.UpdateSecretIfCurrent(ctx, streamEndpointSecretName(resource), resource.Namespace, currentRecord, adoptionLabels, desiredData)
 	return err
 }
 
@@ -247,7 +273,7 @@ func (c streamEndpointSecretClient) deleteEndpointSecret(ctx context.Context, re
 		return fmt.Errorf("stream endpoint secret ownership checks require guarded secret mutations")
 	}
 
-	record, err := c.secretRecordReader.GetSecretRecord(ctx, resource.Name, resource.Namespace)
+	record, err := c.secretRecordReader.GetSecretRecord(ctx, streamEndpointSecretName(resource), resource.Namespace)
 	switch {
 	case apierrors.IsNotFound(err):
 		return nil
@@ -263,7 +289,7 @@ func (c streamEndpointSecretClient) deleteEndpointSecret(ctx context.Context, re
 		return nil
 	}
 
-	_, err = c.guardedSecretMutator.DeleteSecretIfCurrent(ctx, resource.Name, resource.Namespace, record)
+	_, err = c.guardedSecretMutator.DeleteSecretIfCurrent(ctx, streamEndpointSecretName(resource), resource.Namespace, record)
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
@@ -275,9 +301,15 @@ func streamEndpointSecretLabels(resource *streamingv1beta1.Stream) (map[string]s
 	if err != nil {
 		return nil, err
 	}
-	return map[string]string{
+	return servicemanager.ConnectionSecretLabels(resource.Spec.WriteConnectionSecretToRef, map[string]string{
 		streamEndpointSecretOwnerUIDLabel: ownerUID,
-	}, nil
+	}), nil
+}
+
+// streamEndpointSecretName returns the name of the Secret the endpoint details are
+// written to, spec.writeConnectionSecretToRef.name when set.
+func streamEndpointSecretName(resource *streamingv1beta1.Stream) string {
+	return servicemanager.ConnectionSecretName(resource.Spec.WriteConnectionSecretToRef, resource.Name)
 }
 
 func streamOwnsEndpointSecret(resource *streamingv1beta1.Stream, labels map[string]string) (bool, error) {
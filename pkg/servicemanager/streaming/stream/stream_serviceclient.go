@@ -13,6 +13,7 @@ import (
 
 	streamingsdk "github.com/oracle/oci-go-sdk/v65/streaming"
 	streamingv1beta1 "github.com/oracle/oci-service-operator/api/streaming/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultStreamServiceClient struct {
 var _ StreamServiceClient = defaultStreamServiceClient{}
 
 var newStreamServiceClient = func(manager *StreamServiceManager) StreamServiceClient {
-	sdkClient, err := streamingsdk.NewStreamAdminClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, streamingsdk.NewStreamAdminClientWithConfigurationProvider)
 	hooks := newStreamRuntimeHooks(manager, sdkClient)
 	config := buildStreamGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
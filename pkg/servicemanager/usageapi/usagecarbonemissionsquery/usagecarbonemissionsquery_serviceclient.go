@@ -13,6 +13,7 @@ import (
 
 	usageapisdk "github.com/oracle/oci-go-sdk/v65/usageapi"
 	usageapiv1beta1 "github.com/oracle/oci-service-operator/api/usageapi/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultUsageCarbonEmissionsQueryServiceClient struct {
 var _ UsageCarbonEmissionsQueryServiceClient = defaultUsageCarbonEmissionsQueryServiceClient{}
 
 var newUsageCarbonEmissionsQueryServiceClient = func(manager *UsageCarbonEmissionsQueryServiceManager) UsageCarbonEmissionsQueryServiceClient {
-	sdkClient, err := usageapisdk.NewUsageapiClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, usageapisdk.NewUsageapiClientWithConfigurationProvider)
 	hooks := newUsageCarbonEmissionsQueryRuntimeHooks(manager, sdkClient)
 	config := buildUsageCarbonEmissionsQueryGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
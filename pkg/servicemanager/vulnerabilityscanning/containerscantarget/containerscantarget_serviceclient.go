@@ -13,6 +13,7 @@ import (
 
 	vulnerabilityscanningsdk "github.com/oracle/oci-go-sdk/v65/vulnerabilityscanning"
 	vulnerabilityscanningv1beta1 "github.com/oracle/oci-service-operator/api/vulnerabilityscanning/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultContainerScanTargetServiceClient struct {
 var _ ContainerScanTargetServiceClient = defaultContainerScanTargetServiceClient{}
 
 var newContainerScanTargetServiceClient = func(manager *ContainerScanTargetServiceManager) ContainerScanTargetServiceClient {
-	sdkClient, err := vulnerabilityscanningsdk.NewVulnerabilityScanningClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, vulnerabilityscanningsdk.NewVulnerabilityScanningClientWithConfigurationProvider)
 	hooks := newContainerScanTargetRuntimeHooks(manager, sdkClient)
 	config := buildContainerScanTargetGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -20,6 +20,7 @@ import (
 	waav1beta1 "github.com/oracle/oci-service-operator/api/waa/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -134,7 +135,7 @@ func newWebAppAccelerationCompartmentMoveCall(
 	if manager == nil || manager.Provider == nil {
 		return nil, nil
 	}
-	client, err := waasdk.NewWaaClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, waasdk.NewWaaClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -147,7 +148,7 @@ func newWebAppAccelerationWorkRequestCall(
 	if manager == nil || manager.Provider == nil {
 		return nil, nil
 	}
-	client, err := waasdk.NewWorkRequestClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, waasdk.NewWorkRequestClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -13,6 +13,7 @@ import (
 
 	waasdk "github.com/oracle/oci-go-sdk/v65/waa"
 	waav1beta1 "github.com/oracle/oci-service-operator/api/waa/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultWebAppAccelerationServiceClient struct {
 var _ WebAppAccelerationServiceClient = defaultWebAppAccelerationServiceClient{}
 
 var newWebAppAccelerationServiceClient = func(manager *WebAppAccelerationServiceManager) WebAppAccelerationServiceClient {
-	sdkClient, err := waasdk.NewWaaClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, waasdk.NewWaaClientWithConfigurationProvider)
 	hooks := newWebAppAccelerationRuntimeHooks(manager, sdkClient)
 	config := buildWebAppAccelerationGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
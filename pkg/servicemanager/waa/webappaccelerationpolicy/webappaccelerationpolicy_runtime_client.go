@@ -18,6 +18,7 @@ import (
 	waav1beta1 "github.com/oracle/oci-service-operator/api/waa/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	"github.com/oracle/oci-service-operator/pkg/shared"
@@ -97,11 +98,11 @@ func newWebAppAccelerationPolicyCompartmentMoveClient(
 	if manager == nil {
 		return nil, fmt.Errorf("WebAppAccelerationPolicy manager is nil")
 	}
-	policyClient, err := waasdk.NewWaaClientWithConfigurationProvider(manager.Provider)
+	policyClient, err := ociclient.New(manager.Provider, waasdk.NewWaaClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
-	workRequestClient, err := waasdk.NewWorkRequestClientWithConfigurationProvider(manager.Provider)
+	workRequestClient, err := ociclient.New(manager.Provider, waasdk.NewWorkRequestClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
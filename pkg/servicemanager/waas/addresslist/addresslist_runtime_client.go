@@ -18,6 +18,7 @@ import (
 	waasv1beta1 "github.com/oracle/oci-service-operator/api/waas/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	"github.com/oracle/oci-service-operator/pkg/shared"
@@ -49,7 +50,7 @@ func newAddressListCompartmentMoveClient(manager *AddressListServiceManager) (ad
 	if manager == nil {
 		return nil, fmt.Errorf("addressList manager is nil")
 	}
-	client, err := waassdk.NewWaasClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, waassdk.NewWaasClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
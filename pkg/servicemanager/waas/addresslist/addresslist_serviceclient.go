@@ -13,6 +13,7 @@ import (
 
 	waassdk "github.com/oracle/oci-go-sdk/v65/waas"
 	waasv1beta1 "github.com/oracle/oci-service-operator/api/waas/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultAddressListServiceClient struct {
 var _ AddressListServiceClient = defaultAddressListServiceClient{}
 
 var newAddressListServiceClient = func(manager *AddressListServiceManager) AddressListServiceClient {
-	sdkClient, err := waassdk.NewWaasClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, waassdk.NewWaasClientWithConfigurationProvider)
 	hooks := newAddressListRuntimeHooks(manager, sdkClient)
 	config := buildAddressListGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
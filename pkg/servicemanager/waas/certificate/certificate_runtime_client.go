@@ -20,6 +20,7 @@ import (
 	waasv1beta1 "github.com/oracle/oci-service-operator/api/waas/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	"github.com/oracle/oci-service-operator/pkg/shared"
@@ -89,7 +90,7 @@ func newCertificateCompartmentMoveClient(manager *CertificateServiceManager) (ce
 	if manager == nil {
 		return nil, fmt.Errorf("certificate manager is nil")
 	}
-	client, err := waassdk.NewWaasClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, waassdk.NewWaasClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
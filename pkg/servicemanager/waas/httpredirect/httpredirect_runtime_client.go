@@ -20,6 +20,7 @@ import (
 	waasv1beta1 "github.com/oracle/oci-service-operator/api/waas/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	"github.com/oracle/oci-service-operator/pkg/shared"
@@ -78,7 +79,7 @@ func newHttpRedirectWorkRequestClient(manager *HttpRedirectServiceManager) (http
 	if manager == nil {
 		return nil, fmt.Errorf("HttpRedirect service manager is nil")
 	}
-	client, err := waassdk.NewWaasClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, waassdk.NewWaasClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -13,6 +13,7 @@ import (
 
 	waassdk "github.com/oracle/oci-go-sdk/v65/waas"
 	waasv1beta1 "github.com/oracle/oci-service-operator/api/waas/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultHttpRedirectServiceClient struct {
 var _ HttpRedirectServiceClient = defaultHttpRedirectServiceClient{}
 
 var newHttpRedirectServiceClient = func(manager *HttpRedirectServiceManager) HttpRedirectServiceClient {
-	sdkClient, err := waassdk.NewRedirectClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, waassdk.NewRedirectClientWithConfigurationProvider)
 	hooks := newHttpRedirectRuntimeHooks(manager, sdkClient)
 	config := buildHttpRedirectGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
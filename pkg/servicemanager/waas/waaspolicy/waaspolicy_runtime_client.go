@@ -19,6 +19,7 @@ import (
 	waasv1beta1 "github.com/oracle/oci-service-operator/api/waas/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	"github.com/oracle/oci-service-operator/pkg/shared"
@@ -196,7 +197,7 @@ func newWaasPolicyWorkRequestClient(manager *WaasPolicyServiceManager) (waasPoli
 	if manager == nil {
 		return nil, fmt.Errorf("WaasPolicy service manager is nil")
 	}
-	client, err := waassdk.NewWaasClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, waassdk.NewWaasClientWithConfigurationProvider)
 	if err != nil {
 		return nil, fmt.Errorf("initialize WaasPolicy work request OCI client: %w", err)
 	}
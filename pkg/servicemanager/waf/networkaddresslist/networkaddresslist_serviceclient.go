@@ -13,6 +13,7 @@ import (
 
 	wafsdk "github.com/oracle/oci-go-sdk/v65/waf"
 	wafv1beta1 "github.com/oracle/oci-service-operator/api/waf/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultNetworkAddressListServiceClient struct {
 var _ NetworkAddressListServiceClient = defaultNetworkAddressListServiceClient{}
 
 var newNetworkAddressListServiceClient = func(manager *NetworkAddressListServiceManager) NetworkAddressListServiceClient {
-	sdkClient, err := wafsdk.NewWafClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, wafsdk.NewWafClientWithConfigurationProvider)
 	hooks := newNetworkAddressListRuntimeHooks(manager, sdkClient)
 	config := buildNetworkAddressListGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	wafsdk "github.com/oracle/oci-go-sdk/v65/waf"
 	wafv1beta1 "github.com/oracle/oci-service-operator/api/waf/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultWebAppFirewallServiceClient struct {
 var _ WebAppFirewallServiceClient = defaultWebAppFirewallServiceClient{}
 
 var newWebAppFirewallServiceClient = func(manager *WebAppFirewallServiceManager) WebAppFirewallServiceClient {
-	sdkClient, err := wafsdk.NewWafClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, wafsdk.NewWafClientWithConfigurationProvider)
 	hooks := newWebAppFirewallRuntimeHooks(manager, sdkClient)
 	config := buildWebAppFirewallGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
@@ -18,6 +18,7 @@ import (
 	wafv1beta1 "github.com/oracle/oci-service-operator/api/waf/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	shared "github.com/oracle/oci-service-operator/pkg/shared"
@@ -89,7 +90,7 @@ func newWebAppFirewallPolicySDKClient(manager *WebAppFirewallPolicyServiceManage
 	if manager == nil {
 		return nil, fmt.Errorf("WebAppFirewallPolicy service manager is nil")
 	}
-	client, err := wafsdk.NewWafClientWithConfigurationProvider(manager.Provider)
+	client, err := ociclient.New(manager.Provider, wafsdk.NewWafClientWithConfigurationProvider)
 	if err != nil {
 		return nil, err
 	}
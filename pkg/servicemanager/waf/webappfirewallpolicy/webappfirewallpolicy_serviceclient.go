@@ -13,6 +13,7 @@ import (
 
 	wafsdk "github.com/oracle/oci-go-sdk/v65/waf"
 	wafv1beta1 "github.com/oracle/oci-service-operator/api/waf/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/ociclient"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	generatedruntime "github.com/oracle/oci-service-operator/pkg/servicemanager/generatedruntime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,7 +34,7 @@ type defaultWebAppFirewallPolicyServiceClient struct {
 var _ WebAppFirewallPolicyServiceClient = defaultWebAppFirewallPolicyServiceClient{}
 
 var newWebAppFirewallPolicyServiceClient = func(manager *WebAppFirewallPolicyServiceManager) WebAppFirewallPolicyServiceClient {
-	sdkClient, err := wafsdk.NewWafClientWithConfigurationProvider(manager.Provider)
+	sdkClient, err := ociclient.New(manager.Provider, wafsdk.NewWafClientWithConfigurationProvider)
 	hooks := newWebAppFirewallPolicyRuntimeHooks(manager, sdkClient)
 	config := buildWebAppFirewallPolicyGeneratedRuntimeConfig(manager, hooks)
 	if err != nil {
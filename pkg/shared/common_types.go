@@ -29,12 +29,32 @@ type OSOKAsyncPhase string
 // +kubebuilder:validation:Enum=pending;succeeded;failed;canceled;attention;unknown
 type OSOKAsyncNormalizedClass string
 
+// OSOKReplaceStrategy orders the create and delete calls of a replacement.
+// +kubebuilder:validation:Enum=CreateBeforeDelete;DeleteBeforeCreate
+type OSOKReplaceStrategy string
+
+const (
+	Provisioning  OSOKConditionType = "Provisioning"
+	Active        OSOKConditionType = "Active"
+	Failed        OSOKConditionType = "Failed"
+	Terminating   OSOKConditionType = "Terminating"
+	Updating      OSOKConditionType = "Updating"
+	Paused        OSOKConditionType = "Paused"
+	DriftDetected OSOKConditionType = "DriftDetected"
+	// Planned reports the OCI mutations a reconcile in plan mode would have
+	// made instead of making them.
+	Planned OSOKConditionType = "Planned"
+	// DependencyNotReady reports that a spec *Ref field names an OSOK resource
+	// that is missing or not yet Active.
+	DependencyNotReady OSOKConditionType = "DependencyNotReady"
+)
+
+// Standard condition types published in each resource's status.conditions so
+// kstatus-aware tools (kubectl wait, Argo CD, Flux) can read resource health.
 const (
-	Provisioning OSOKConditionType = "Provisioning"
-	Active       OSOKConditionType = "Active"
-	Failed       OSOKConditionType = "Failed"
-	Terminating  OSOKConditionType = "Terminating"
-	Updating     OSOKConditionType = "Updating"
+	ReadyConditionType       = "Ready"
+	ReconcilingConditionType = "Reconciling"
+	StalledConditionType     = "Stalled"
 )
 
 const (
@@ -49,6 +69,15 @@ const (
 	OSOKAsyncPhaseDelete OSOKAsyncPhase = "delete"
 )
 
+const (
+	// ReplaceCreateBeforeDelete creates the replacement and deletes the
+	// previous OCI resource once the replacement is active.
+	ReplaceCreateBeforeDelete OSOKReplaceStrategy = "CreateBeforeDelete"
+	// ReplaceDeleteBeforeCreate deletes the previous OCI resource first, for
+	// resources whose name or other unique field cannot exist twice.
+	ReplaceDeleteBeforeCreate OSOKReplaceStrategy = "DeleteBeforeCreate"
+)
+
 const (
 	OSOKAsyncClassPending   OSOKAsyncNormalizedClass = "pending"
 	OSOKAsyncClassSucceeded OSOKAsyncNormalizedClass = "succeeded"
@@ -78,6 +107,21 @@ type OSOKAsyncOperation struct {
 	PercentComplete *float32     `json:"percentComplete,omitempty"`
 	Message         string       `json:"message,omitempty"`
 	UpdatedAt       *metav1.Time `json:"updatedAt"`
+	// Replacement is set while the operation is one step of replacing the OCI
+	// resource after a create-only spec field changed.
+	Replacement *OSOKAsyncReplacement `json:"replacement,omitempty"`
+}
+
+// OSOKAsyncReplacement records an in-progress replacement so that an
+// interrupted one resumes from the step it reached.
+type OSOKAsyncReplacement struct {
+	// Strategy is the replace policy that started the replacement.
+	Strategy OSOKReplaceStrategy `json:"strategy"`
+	// PreviousOcid is the OCI resource being replaced. status.ocid tracks the
+	// replacement once it is created.
+	PreviousOcid OCID `json:"previousOcid"`
+	// Step is the replacement call in progress: create or delete.
+	Step OSOKAsyncPhase `json:"step"`
 }
 
 type OSOKAsyncTracker struct {
@@ -102,6 +146,9 @@ type OSOKStatus struct {
 	UpdatedAt    *metav1.Time `json:"updatedAt,omitempty"`
 	RequestedAt  *metav1.Time `json:"requestedAt,omitempty"`
 	DeletedAt    *metav1.Time `json:"deletedAt,omitempty"`
+	// Region is the OCI region that holds the resource. Calls for a resource
+	// with an OCID go to this region.
+	Region string `json:"region,omitempty"`
 }
 
 type TagResources struct {
@@ -120,3 +167,28 @@ type UsernameSource struct {
 type PasswordSource struct {
 	Secret SecretSource `json:"secret,omitempty"`
 }
+
+// ResourceRef names another OSOK resource whose status.status.ocid is used in
+// place of a raw OCID spec field. An empty Namespace means the namespace of
+// the referencing resource.
+type ResourceRef struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// ConnectionSecretTarget configures the Secret a resource writes its
+// connection details to. Without it OSOK writes the default keys to a Secret
+// named after the resource.
+type ConnectionSecretTarget struct {
+	// Name of the Secret, in the resource's namespace. Defaults to the resource name.
+	// +kubebuilder:validation:Optional
+	Name string `json:"name,omitempty"`
+	// Labels added to the Secret. OSOK ownership labels take precedence.
+	// +kubebuilder:validation:Optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Keys maps each Secret key to a Go template rendered against the default
+	// connection keys, for example `jdbc:mysql://{{ .PrivateIPAddress }}:{{ .MySQLPort }}/app`.
+	// When set, the Secret holds only these keys.
+	// +kubebuilder:validation:Optional
+	Keys map[string]string `json:"keys,omitempty"`
+}
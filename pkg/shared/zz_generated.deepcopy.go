@@ -11,6 +11,35 @@ package shared
 
 import ()
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionSecretTarget) DeepCopyInto(out *ConnectionSecretTarget) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionSecretTarget.
+func (in *ConnectionSecretTarget) DeepCopy() *ConnectionSecretTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionSecretTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JSONValue) DeepCopyInto(out *JSONValue) {
 	*out = *in
@@ -64,6 +93,11 @@ func (in *OSOKAsyncOperation) DeepCopyInto(out *OSOKAsyncOperation) {
 		in, out := &in.UpdatedAt, &out.UpdatedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.Replacement != nil {
+		in, out := &in.Replacement, &out.Replacement
+		*out = new(OSOKAsyncReplacement)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OSOKAsyncOperation.
@@ -76,6 +110,21 @@ func (in *OSOKAsyncOperation) DeepCopy() *OSOKAsyncOperation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OSOKAsyncReplacement) DeepCopyInto(out *OSOKAsyncReplacement) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OSOKAsyncReplacement.
+func (in *OSOKAsyncReplacement) DeepCopy() *OSOKAsyncReplacement {
+	if in == nil {
+		return nil
+	}
+	out := new(OSOKAsyncReplacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OSOKAsyncTracker) DeepCopyInto(out *OSOKAsyncTracker) {
 	*out = *in
@@ -170,6 +219,21 @@ func (in *PasswordSource) DeepCopy() *PasswordSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceRef) DeepCopyInto(out *ResourceRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRef.
+func (in *ResourceRef) DeepCopy() *ResourceRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretSource) DeepCopyInto(out *SecretSource) {
 	*out = *in
@@ -0,0 +1,69 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"reflect"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	attributeOCIService      = attribute.Key("oci.service")
+	attributeOCIOperation    = attribute.Key("oci.operation")
+	attributeOpcRequestID    = attribute.Key("oci.opc_request_id")
+	attributeHTTPStatusCode  = attribute.Key("http.status_code")
+	attributeWorkRequestID   = attribute.Key("oci.work_request_id")
+	opcRequestIDHeader       = "opc-request-id"
+	workRequestOperationName = "GetWorkRequest"
+)
+
+// StartOCICall starts a client span for one OCI API call, named like
+// "queue.CreateQueue".
+func StartOCICall(ctx context.Context, service string, operation string) (context.Context, trace.Span) {
+	return Start(ctx, service+"."+operation,
+		attributeOCIService.String(service),
+		attributeOCIOperation.String(operation))
+}
+
+// EndOCICall records the OCI response or error on span and ends it. The
+// opc-request-id attribute matches the request ID that OCI support asks for.
+func EndOCICall(span trace.Span, response any, err error) {
+	var serviceErr common.ServiceError
+	switch {
+	case errors.As(err, &serviceErr):
+		span.SetAttributes(attributeHTTPStatusCode.Int(serviceErr.GetHTTPStatusCode()))
+		if id := serviceErr.GetOpcRequestID(); id != "" {
+			span.SetAttributes(attributeOpcRequestID.String(id))
+		}
+	case err == nil:
+		if ociResponse, ok := response.(common.OCIResponse); ok && !isNilPointer(ociResponse) {
+			if httpResponse := ociResponse.HTTPResponse(); httpResponse != nil {
+				span.SetAttributes(attributeHTTPStatusCode.Int(httpResponse.StatusCode))
+				if id := httpResponse.Header.Get(opcRequestIDHeader); id != "" {
+					span.SetAttributes(attributeOpcRequestID.String(id))
+				}
+			}
+		}
+	}
+	End(span, err)
+}
+
+// StartWorkRequestPoll starts a span for one poll of an OCI work request.
+func StartWorkRequestPoll(ctx context.Context, service string, workRequestID string) (context.Context, trace.Span) {
+	ctx, span := StartOCICall(ctx, service, workRequestOperationName)
+	span.SetAttributes(attributeWorkRequestID.String(workRequestID))
+	return ctx, span
+}
+
+func isNilPointer(value any) bool {
+	reflected := reflect.ValueOf(value)
+	return reflected.Kind() == reflect.Pointer && reflected.IsNil()
+}
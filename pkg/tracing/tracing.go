@@ -0,0 +1,167 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+// Package tracing emits OpenTelemetry spans for reconciles, service manager
+// calls, OCI API calls, and work request polls. Until Setup installs an
+// exporter every span is a no-op.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	instrumentationName = "github.com/oracle/oci-service-operator"
+	serviceName         = "oci-service-operator"
+	traceParentHeader   = "traceparent"
+)
+
+// Exporter selects where spans are sent.
+type Exporter string
+
+const (
+	// ExporterNone drops every span. It is the default.
+	ExporterNone Exporter = "none"
+	// ExporterOTLP sends spans to an OTLP/HTTP collector.
+	ExporterOTLP Exporter = "otlp"
+	// ExporterStdout writes spans to standard output as JSON.
+	ExporterStdout Exporter = "stdout"
+	// ExporterFile appends spans to a local file as JSON.
+	ExporterFile Exporter = "file"
+)
+
+// Config configures the span exporter.
+type Config struct {
+	Exporter Exporter
+	// Endpoint is the OTLP/HTTP collector host and port. Empty uses the
+	// OTEL_EXPORTER_OTLP_* environment variables.
+	Endpoint string
+	// Insecure sends OTLP over plain HTTP.
+	Insecure bool
+	// Path is the file written by ExporterFile.
+	Path string
+	// SampleRatio is the fraction of new traces that are recorded. Values
+	// outside (0, 1] record every trace.
+	SampleRatio float64
+}
+
+// Setup installs the tracer provider selected by cfg and returns a function
+// that flushes and stops it. ExporterNone leaves tracing disabled.
+func Setup(ctx context.Context, cfg Config) (func(context.Context) error, error) {
+	exporter, closer, err := newExporter(ctx, cfg)
+	if err != nil || exporter == nil {
+		return func(context.Context) error { return nil }, err
+	}
+
+	ratio := cfg.SampleRatio
+	if ratio <= 0 || ratio > 1 {
+		ratio = 1
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", serviceName))),
+	)
+	otel.SetTracerProvider(provider)
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if closer != nil {
+			if closeErr := closer.Close(); err == nil {
+				err = closeErr
+			}
+		}
+		return err
+	}, nil
+}
+
+func newExporter(ctx context.Context, cfg Config) (sdktrace.SpanExporter, io.Closer, error) {
+	switch cfg.Exporter {
+	case "", ExporterNone:
+		return nil, nil, nil
+	case ExporterOTLP:
+		var options []otlptracehttp.Option
+		if cfg.Endpoint != "" {
+			options = append(options, otlptracehttp.WithEndpoint(cfg.Endpoint))
+		}
+		if cfg.Insecure {
+			options = append(options, otlptracehttp.WithInsecure())
+		}
+		exporter, err := otlptracehttp.New(ctx, options...)
+		return exporter, nil, err
+	case ExporterStdout:
+		exporter, err := stdouttrace.New()
+		return exporter, nil, err
+	case ExporterFile:
+		file, err := os.OpenFile(cfg.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			return nil, nil, fmt.Errorf("open trace file: %w", err)
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(file))
+		if err != nil {
+			_ = file.Close()
+			return nil, nil, err
+		}
+		return exporter, file, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown trace exporter %q", cfg.Exporter)
+	}
+}
+
+// Start starts a span named name as a child of the span in ctx.
+func Start(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// StartLinked starts a span like Start and links it to the span encoded in
+// traceParent, typically one recorded by an earlier reconcile.
+func StartLinked(ctx context.Context, name string, traceParent string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	options := []trace.SpanStartOption{trace.WithAttributes(attributes...)}
+	if linked := SpanContextFromTraceParent(traceParent); linked.IsValid() {
+		options = append(options, trace.WithLinks(trace.Link{SpanContext: linked}))
+	}
+	return otel.Tracer(instrumentationName).Start(ctx, name, options...)
+}
+
+// End marks span failed when err is set and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// TraceParent encodes the span in ctx as a W3C traceparent value. It returns
+// "" when ctx holds no recorded span, such as when tracing is disabled.
+func TraceParent(ctx context.Context) string {
+	if !trace.SpanContextFromContext(ctx).IsSampled() {
+		return ""
+	}
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	return carrier[traceParentHeader]
+}
+
+// SpanContextFromTraceParent decodes a W3C traceparent value.
+func SpanContextFromTraceParent(traceParent string) trace.SpanContext {
+	if traceParent == "" {
+		return trace.SpanContext{}
+	}
+	ctx := propagation.TraceContext{}.Extract(context.Background(), propagation.MapCarrier{traceParentHeader: traceParent})
+	return trace.SpanContextFromContext(ctx)
+}
@@ -0,0 +1,78 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/oracle/oci-go-sdk/v65/queue"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestFileExporterRecordsOCICallWithOpcRequestID(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "spans.json")
+	shutdown, err := Setup(context.Background(), Config{Exporter: ExporterFile, Path: path})
+	if err != nil {
+		t.Fatalf("Setup() error = %v", err)
+	}
+
+	ctx, reconcileSpan := Start(context.Background(), "Reconcile Queue")
+	_, span := StartOCICall(ctx, "queue", "GetQueue")
+	response := queue.GetQueueResponse{RawResponse: &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Opc-Request-Id": []string{"opc-request-1"}},
+	}}
+	EndOCICall(span, response, nil)
+	End(reconcileSpan, nil)
+	if err := shutdown(context.Background()); err != nil {
+		t.Fatalf("shutdown() error = %v", err)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	for _, want := range []string{`"Name":"queue.GetQueue"`, `"Name":"Reconcile Queue"`, "opc-request-1"} {
+		if !strings.Contains(string(content), want) {
+			t.Fatalf("exported spans do not contain %s:\n%s", want, content)
+		}
+	}
+}
+
+func TestSetupRejectsUnknownExporter(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Setup(context.Background(), Config{Exporter: "zipkin"}); err == nil {
+		t.Fatal("Setup() error = nil, want unknown exporter failure")
+	}
+}
+
+func TestTraceParentRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	if got := TraceParent(context.Background()); got != "" {
+		t.Fatalf("TraceParent() without a span = %q, want empty", got)
+	}
+
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1, 2, 3},
+		SpanID:     trace.SpanID{4, 5, 6},
+		TraceFlags: trace.FlagsSampled,
+	})
+	traceParent := TraceParent(trace.ContextWithSpanContext(context.Background(), spanContext))
+	if traceParent == "" {
+		t.Fatal("TraceParent() = empty, want a traceparent for a sampled span")
+	}
+	decoded := SpanContextFromTraceParent(traceParent)
+	if decoded.TraceID() != spanContext.TraceID() || decoded.SpanID() != spanContext.SpanID() {
+		t.Fatalf("SpanContextFromTraceParent(%q) = %v, want %v", traceParent, decoded, spanContext)
+	}
+}